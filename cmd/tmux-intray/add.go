@@ -4,7 +4,12 @@ Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
 package main
 
 import (
+	"fmt"
+
 	appcore "github.com/cristianoliveira/tmux-intray/internal/app"
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +18,11 @@ type addClient interface {
 	AddTrayItem(item, session, window, pane, paneCreated string, noAssociate bool, level string) (string, error)
 }
 
+// addSettingsLoader is implemented by clients that can supply the add settings.
+type addSettingsLoader interface {
+	LoadSettings() (*settings.Settings, error)
+}
+
 // NewAddCmd creates the add command with explicit dependencies.
 func NewAddCmd(client addClient) *cobra.Command {
 	if client == nil {
@@ -25,6 +35,10 @@ func NewAddCmd(client addClient) *cobra.Command {
 	var paneCreatedFlag string
 	var noAssociateFlag bool
 	var levelFlag string
+	var strictLevelFlag bool
+	var colorFlag string
+	var priorityFlag int
+	var tagsFlag string
 
 	addCmd := &cobra.Command{
 		Use:   "add [OPTIONS] <message>",
@@ -40,13 +54,21 @@ OPTIONS:
     --pane <id>             Associate with specific pane ID
     --pane-created <time>   Pane creation timestamp (seconds since epoch)
     --no-associate          Do not associate with any pane
-    --level <level>         Notification level: info, warning, error, critical (default: info)
+    --level <level>         Notification level: info, warning, error, critical
+                            (default: default_level from settings, else info)
+    --strict-level          Fail when --level is not given instead of using the default
+    --color <color>         Row color in the TUI: ANSI color number (0-255) or
+                            hex color (#rgb or #rrggbb)
+    --priority <n>          Priority; higher sorts first when sorting by priority
+                            (default: 0)
+    --tags <tags>           Comma-separated tags, e.g. deploy,ci
     -h, --help              Show this help
 
 If no pane association options are provided, automatically associates with
 the current tmux pane (if inside tmux). Use --no-associate to skip.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAddCmd(client, args, sessionFlag, windowFlag, paneFlag, paneCreatedFlag, noAssociateFlag, levelFlag)
+			opts := domain.AddOptions{Color: colorFlag, Priority: priorityFlag, Tags: domain.ParseTags(tagsFlag)}
+			return runAddCmd(client, args, sessionFlag, windowFlag, paneFlag, paneCreatedFlag, noAssociateFlag, levelFlag, strictLevelFlag, opts)
 		},
 	}
 
@@ -55,13 +77,18 @@ the current tmux pane (if inside tmux). Use --no-associate to skip.`,
 	addCmd.Flags().StringVar(&paneFlag, "pane", "", "Associate with specific pane ID")
 	addCmd.Flags().StringVar(&paneCreatedFlag, "pane-created", "", "Pane creation timestamp (seconds since epoch)")
 	addCmd.Flags().BoolVar(&noAssociateFlag, "no-associate", false, "Do not associate with any pane")
-	addCmd.Flags().StringVar(&levelFlag, "level", "info", "Notification level: info, warning, error, critical")
+	addCmd.Flags().StringVar(&levelFlag, "level", "", "Notification level: info, warning, error, critical (default: default_level setting)")
+	addCmd.Flags().BoolVar(&strictLevelFlag, "strict-level", false, "Require --level instead of using the default level")
+	addCmd.Flags().StringVar(&colorFlag, "color", "", "Row color: ANSI color number (0-255) or hex color (#rgb or #rrggbb)")
+	addCmd.Flags().IntVar(&priorityFlag, "priority", 0, "Priority; higher sorts first when sorting by priority")
+	addCmd.Flags().StringVar(&tagsFlag, "tags", "", "Comma-separated tags")
 
 	return addCmd
 }
 
 // runAddCmd executes the add command logic.
-func runAddCmd(client addClient, args []string, sessionFlag, windowFlag, paneFlag, paneCreatedFlag string, noAssociateFlag bool, levelFlag string) error {
+func runAddCmd(client addClient, args []string, sessionFlag, windowFlag, paneFlag, paneCreatedFlag string, noAssociateFlag bool, levelFlag string, strictLevelFlag bool, opts domain.AddOptions) error {
+	loaded := loadAddSettings(client)
 	useCase := appcore.NewAddUseCase(client)
 	return useCase.Execute(appcore.AddInput{
 		Args:        args,
@@ -74,9 +101,29 @@ func runAddCmd(client addClient, args []string, sessionFlag, windowFlag, paneFla
 		AllowTmuxless: func() bool {
 			return allowTmuxlessMode()
 		},
+		DefaultLevel:   loaded.DefaultLevel,
+		StrictLevel:    strictLevelFlag,
+		NotifyMinLevel: loaded.NotifyMinLevel,
+		LevelRouting:   loaded.LevelRouting,
+		Options:        opts,
 	})
 }
 
+// loadAddSettings loads the settings an add uses, once per add. It falls back
+// to the defaults when the client cannot load settings or loading fails.
+func loadAddSettings(client addClient) *settings.Settings {
+	loader, ok := client.(addSettingsLoader)
+	if !ok {
+		return settings.DefaultSettings()
+	}
+	loaded, err := loader.LoadSettings()
+	if err != nil {
+		colors.Warning(fmt.Sprintf("add: using default settings: %v", err))
+		return settings.DefaultSettings()
+	}
+	return loaded
+}
+
 // validateMessage checks message length and emptiness (matches Bash validation)
 func validateMessage(message string) error {
 	return appcore.ValidateAddMessage(message)
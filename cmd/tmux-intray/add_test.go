@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	"github.com/spf13/cobra"
 )
 
@@ -281,3 +283,126 @@ func setFlag(t *testing.T, command *cobra.Command, name, value string) {
 		t.Fatalf("set flag %q: %v", name, err)
 	}
 }
+
+type fakeAddSettingsClient struct {
+	fakeAddClient
+	defaultLevel   string
+	notifyMinLevel string
+	loadErr        error
+	loads          int
+}
+
+func (f *fakeAddSettingsClient) LoadSettings() (*settings.Settings, error) {
+	f.loads++
+	if f.loadErr != nil {
+		return nil, f.loadErr
+	}
+	s := settings.DefaultSettings()
+	s.DefaultLevel = f.defaultLevel
+	s.NotifyMinLevel = f.notifyMinLevel
+	return s, nil
+}
+
+func TestAddRunEUsesDefaultLevelFromSettings(t *testing.T) {
+	client := &fakeAddSettingsClient{defaultLevel: "error"}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+
+	if err := add.RunE(add, []string{"hello"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.captured.level != "error" {
+		t.Fatalf("expected default level error, got %q", client.captured.level)
+	}
+}
+
+func TestAddRunELoadsSettingsOnce(t *testing.T) {
+	client := &fakeAddSettingsClient{defaultLevel: "error", notifyMinLevel: "warning"}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+
+	if err := add.RunE(add, []string{"hello"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.loads != 1 {
+		t.Fatalf("expected settings to load once, got %d loads", client.loads)
+	}
+}
+
+func TestAddRunEFallsBackToDefaultsWhenSettingsFailToLoad(t *testing.T) {
+	client := &fakeAddSettingsClient{loadErr: errors.New("invalid settings: invalid defaultLevel value: loud")}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+
+	if err := add.RunE(add, []string{"hello"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !client.addCalled {
+		t.Fatalf("expected AddTrayItem to be called")
+	}
+	if client.captured.level != "info" {
+		t.Fatalf("expected default level info, got %q", client.captured.level)
+	}
+}
+
+func TestAddRunEStrictLevelRequiresLevel(t *testing.T) {
+	client := &fakeAddSettingsClient{defaultLevel: "error"}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+	setFlag(t, add, "strict-level", "true")
+
+	err := add.RunE(add, []string{"hello"})
+	if err == nil || !strings.Contains(err.Error(), "level is required") {
+		t.Fatalf("expected strict level error, got %v", err)
+	}
+	if client.addCalled {
+		t.Fatalf("expected AddTrayItem not to be called")
+	}
+}
+
+// fakeOptionsAddClient records the options an add was stored with.
+type fakeOptionsAddClient struct {
+	fakeAddClient
+	opts domain.AddOptions
+}
+
+func (f *fakeOptionsAddClient) AddTrayItemWithOptions(item, session, window, pane, paneCreated string, noAssociate bool, level string, opts domain.AddOptions) (string, error) {
+	f.captured.message = item
+	f.captured.level = level
+	f.opts = opts
+	return "", f.addErr
+}
+
+func TestAddRunEPassesColorPriorityAndTags(t *testing.T) {
+	client := &fakeOptionsAddClient{}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+	setFlag(t, add, "color", "#ff8800")
+	setFlag(t, add, "priority", "5")
+	setFlag(t, add, "tags", "deploy, ci")
+
+	if err := add.RunE(add, []string{"deploy failed"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.addCalled {
+		t.Fatalf("expected the add to go through AddTrayItemWithOptions")
+	}
+	if client.opts.Color != "#ff8800" || client.opts.Priority != 5 || strings.Join(client.opts.Tags, ",") != "deploy,ci" {
+		t.Fatalf("unexpected options %+v", client.opts)
+	}
+}
+
+func TestAddRunERejectsOptionsWithoutSupport(t *testing.T) {
+	client := &fakeAddClient{}
+	add := NewAddCmd(client)
+	setFlag(t, add, "no-associate", "true")
+	setFlag(t, add, "tags", "deploy")
+
+	err := add.RunE(add, []string{"deploy failed"})
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported options error, got %v", err)
+	}
+	if client.addCalled {
+		t.Fatalf("expected AddTrayItem not to be called")
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
+*/
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/cristianoliveira/tmux-intray/internal/version"
+	"github.com/spf13/cobra"
+)
+
+// Capabilities describes what this binary supports, so integrators can detect
+// features instead of parsing version strings.
+type Capabilities struct {
+	Version         string   `json:"version"`
+	SchemaFields    int      `json:"schema_fields"`
+	GroupByModes    []string `json:"group_by_modes"`
+	StorageBackends []string `json:"storage_backends"`
+	StorageBackend  string   `json:"storage_backend"`
+	// Features lists what this binary was built with, whatever the
+	// configuration says.
+	Features []string `json:"features"`
+	// Enabled reports whether each optional behavior is switched on in the
+	// loaded configuration.
+	Enabled map[string]bool `json:"enabled"`
+}
+
+// builtinFeatures are the features compiled into this binary, in sorted order.
+// Add a name here when a feature ships.
+var builtinFeatures = []string{
+	"color",
+	"events",
+	"mute",
+	"pane_badges",
+	"priority",
+	"queued_jumps",
+	"replay",
+	"session_snooze",
+	"tags",
+}
+
+// NewCapabilitiesCmd creates the capabilities command. It reports build and
+// configuration facts only, so it needs no client.
+func NewCapabilitiesCmd() *cobra.Command {
+	var jsonFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Short: "Print the features and schema this binary supports",
+		Long: `Print the features and schema this binary supports.
+
+USAGE:
+    tmux-intray capabilities [OPTIONS]
+
+OPTIONS:
+    --json       Print as JSON for scripts and integrations
+    -h, --help   Show this help`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			caps := currentCapabilities()
+			if jsonFlag {
+				return printCapabilitiesJSON(caps, cmd.OutOrStdout())
+			}
+			printCapabilities(caps, cmd.OutOrStdout())
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Print as JSON")
+
+	return cmd
+}
+
+// currentCapabilities collects the capabilities from package constants and the
+// loaded configuration. Features comes from the build, Enabled from the config.
+func currentCapabilities() Capabilities {
+	config.Load()
+	return Capabilities{
+		Version:         version.String(),
+		SchemaFields:    storage.NumFields,
+		GroupByModes:    settings.GroupByModes,
+		StorageBackends: storage.Backends,
+		StorageBackend:  config.Get("storage_backend", storage.BackendSQLite),
+		Features:        builtinFeatures,
+		Enabled: map[string]bool{
+			"escalation":           config.GetDuration("escalate_after", 0) > 0,
+			"message_truncation":   config.GetInt("max_message_length", 0) > 0,
+			"keep_full_message":    config.GetBool("keep_full_message", false),
+			"capture_pane_context": config.GetBool("capture_pane_context", false),
+			"stale_auto_dismiss":   config.GetInt("auto_dismiss_stale_days", 0) > 0,
+			"lower_level_dismiss":  config.GetBool("auto_dismiss_lower_levels", false),
+			"max_active_eviction":  config.GetInt("max_active", 0) > 0,
+			"duplicate_collapse":   config.GetDuration("dedup.collapse_window", 0) > 0,
+		},
+	}
+}
+
+func printCapabilitiesJSON(caps Capabilities, w io.Writer) error {
+	data, err := json.MarshalIndent(caps, "", "  ")
+	if err != nil {
+		return fmt.Errorf("capabilities: failed to marshal: %w", err)
+	}
+	_, _ = fmt.Fprintln(w, string(data))
+	return nil
+}
+
+func printCapabilities(caps Capabilities, w io.Writer) {
+	_, _ = fmt.Fprintf(w, "version: %s\n", caps.Version)
+	_, _ = fmt.Fprintf(w, "schema fields: %d\n", caps.SchemaFields)
+	_, _ = fmt.Fprintf(w, "group-by modes: %s\n", strings.Join(caps.GroupByModes, ", "))
+	_, _ = fmt.Fprintf(w, "storage backends: %s (using %s)\n", strings.Join(caps.StorageBackends, ", "), caps.StorageBackend)
+	_, _ = fmt.Fprintf(w, "features: %s\n", strings.Join(caps.Features, ", "))
+	var enabled []string
+	for name, on := range caps.Enabled {
+		if on {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+	if len(enabled) == 0 {
+		enabled = []string{"none"}
+	}
+	_, _ = fmt.Fprintf(w, "enabled options: %s\n", strings.Join(enabled, ", "))
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilitiesJSONReportsSchemaAndBackends(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", "/nonexistent/config.toml")
+	t.Setenv("TMUX_INTRAY_ESCALATE_AFTER", "10m")
+
+	cmd := NewCapabilitiesCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--json"})
+	require.NoError(t, cmd.Execute())
+
+	var caps Capabilities
+	require.NoError(t, json.Unmarshal(out.Bytes(), &caps))
+	assert.Equal(t, storage.NumFields, caps.SchemaFields)
+	assert.Contains(t, caps.StorageBackends, storage.BackendSQLite)
+	assert.Equal(t, storage.BackendSQLite, caps.StorageBackend)
+	assert.Equal(t, settings.GroupByModes, caps.GroupByModes)
+	assert.Subset(t, caps.Features, []string{"tags", "priority", "session_snooze", "color", "events", "replay"},
+		"built-in features are reported whatever the config says")
+	assert.True(t, caps.Enabled["escalation"])
+	assert.False(t, caps.Enabled["stale_auto_dismiss"])
+	assert.False(t, caps.Enabled["lower_level_dismiss"])
+	assert.False(t, caps.Enabled["max_active_eviction"])
+	assert.False(t, caps.Enabled["duplicate_collapse"])
+}
+
+func TestCapabilitiesPlainOutput(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", "/nonexistent/config.toml")
+
+	cmd := NewCapabilitiesCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, out.String(), fmt.Sprintf("schema fields: %d", storage.NumFields))
+	assert.Contains(t, out.String(), "storage backends: sqlite (using sqlite)")
+	assert.Contains(t, out.String(), "group-by modes: none, session, window, pane")
+	assert.Contains(t, out.String(), "features: color, events, mute, pane_badges, priority, queued_jumps, replay, session_snooze, tags\n")
+	assert.Contains(t, out.String(), "enabled options: none\n")
+}
@@ -31,7 +31,10 @@ func NewCleanupCmd(client cleanupClient) *cobra.Command {
 		Long: `Clean up old dismissed notifications.
 
 Automatically cleans up notifications that have been dismissed and are older
-than the configured auto-cleanup days. This helps prevent storage bloat.`,
+than the configured auto-cleanup days. This helps prevent storage bloat.
+
+When auto_dismiss_stale_days is set, active notifications older than that
+many days are dismissed first, so they can be cleaned up as well.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config.Load()
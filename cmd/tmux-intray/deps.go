@@ -30,9 +30,17 @@ type cliCore interface {
 	MarkNotificationRead(id string) error
 	MarkNotificationUnread(id string) error
 	CleanupOldNotifications(daysThreshold int, dryRun bool) error
+	MuteSession(session string) error
+	UnmuteSession(session string) error
+	ListMutedSessions() ([]string, error)
 	JumpToPane(sessionID, windowID, paneID string) bool
+	RecordJumpEvent(id, sessionID, windowID, paneID string) error
 	ValidatePaneExists(sessionID, windowID, paneID string) bool
 	GetNotificationByID(id string) (string, error)
+	GetFullMessage(id string) (string, error)
+	GetPaneContext(id string) (string, error)
+	QueueJump(id string, noMarkRead bool) error
+	DrainPendingActions() (int, error)
 	GetCurrentTmuxContext() core.TmuxContext
 	GetTmuxVisibility() string
 	SetTmuxVisibility(value string) (bool, error)
@@ -133,10 +141,20 @@ func registerCommands(root *cobra.Command, deps cliDeps) {
 		root.AddCommand(NewClearCmd(deps.coreClient))
 		root.AddCommand(NewDismissCmd(deps.coreClient))
 		root.AddCommand(NewMarkReadCmd(deps.coreClient))
+		root.AddCommand(NewShowCmd(deps.coreClient))
+		root.AddCommand(NewMuteCmd(deps.coreClient))
+		root.AddCommand(NewUnmuteCmd(deps.coreClient))
 		root.AddCommand(NewCleanupCmd(deps.coreClient))
 		root.AddCommand(NewJumpCmd(deps.coreClient))
+		root.AddCommand(NewReplayCmd(deps.coreClient))
+		root.AddCommand(NewEventsCmd())
+		root.AddCommand(NewCapabilitiesCmd())
 		root.AddCommand(NewSettingsCmd(deps.coreClient))
-		root.AddCommand(NewTUICmd(deps.tuiClient))
+		tuiCmd := NewTUICmd(deps.tuiClient)
+		tuiCmd.PreRun = func(*cobra.Command, []string) {
+			drainPendingActions(deps.coreClient)
+		}
+		root.AddCommand(tuiCmd)
 
 		dismissFunc = deps.coreClient.DismissNotification
 		dismissAllFunc = deps.coreClient.DismissAll
@@ -241,3 +259,23 @@ func initCLI() error {
 	registerCommands(cmd.RootCmd, deps)
 	return nil
 }
+
+// pendingActionDrainer runs actions queued while tmux was down.
+type pendingActionDrainer interface {
+	DrainPendingActions() (int, error)
+}
+
+// drainPendingActions runs jumps queued while tmux was down. Only commands the
+// user runs to move around (jump and tui) call it, so background commands such
+// as add or status never switch the client. Failures are only logged so they
+// never block the command being run.
+func drainPendingActions(client pendingActionDrainer) {
+	done, err := client.DrainPendingActions()
+	if err != nil {
+		colors.Debug("failed to drain pending actions: " + err.Error())
+		return
+	}
+	if done > 0 {
+		colors.Debug(fmt.Sprintf("ran %d queued jump(s)", done))
+	}
+}
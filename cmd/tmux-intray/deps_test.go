@@ -52,6 +52,22 @@ func (f *fakeStorage) CleanupOldNotifications(daysThreshold int, dryRun bool) er
 	return nil
 }
 
+func (f *fakeStorage) MuteSession(session string) error {
+	return nil
+}
+
+func (f *fakeStorage) UnmuteSession(session string) error {
+	return nil
+}
+
+func (f *fakeStorage) ListMutedSessions() ([]string, error) {
+	return nil, nil
+}
+
+func (f *fakeStorage) EscalateStale() (int, error) {
+	return 0, nil
+}
+
 func (f *fakeStorage) GetActiveCount() int {
 	return 0
 }
@@ -94,10 +110,26 @@ func (f *fakeCore) CleanupOldNotifications(daysThreshold int, dryRun bool) error
 	return nil
 }
 
+func (f *fakeCore) MuteSession(session string) error {
+	return nil
+}
+
+func (f *fakeCore) UnmuteSession(session string) error {
+	return nil
+}
+
+func (f *fakeCore) ListMutedSessions() ([]string, error) {
+	return nil, nil
+}
+
 func (f *fakeCore) JumpToPane(sessionID, windowID, paneID string) bool {
 	return true
 }
 
+func (f *fakeCore) RecordJumpEvent(id, sessionID, windowID, paneID string) error {
+	return nil
+}
+
 func (f *fakeCore) ValidatePaneExists(sessionID, windowID, paneID string) bool {
 	return true
 }
@@ -106,6 +138,22 @@ func (f *fakeCore) GetNotificationByID(id string) (string, error) {
 	return "", nil
 }
 
+func (f *fakeCore) QueueJump(id string, noMarkRead bool) error {
+	return nil
+}
+
+func (f *fakeCore) DrainPendingActions() (int, error) {
+	return 0, nil
+}
+
+func (f *fakeCore) GetFullMessage(id string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeCore) GetPaneContext(id string) (string, error) {
+	return "", nil
+}
+
 func (f *fakeCore) GetCurrentTmuxContext() core.TmuxContext {
 	return core.TmuxContext{}
 }
@@ -331,7 +379,7 @@ func TestRegisterCommandsAddsCommands(t *testing.T) {
 		commandNames[cmd.Name()] = true
 	}
 
-	expected := []string{"add", "list", "status", "follow", "clear", "dismiss", "mark-read", "cleanup", "jump", "settings", "tui"}
+	expected := []string{"add", "list", "status", "follow", "clear", "dismiss", "mark-read", "show", "mute", "unmute", "cleanup", "jump", "replay", "events", "capabilities", "settings", "tui"}
 	for _, name := range expected {
 		if !commandNames[name] {
 			t.Fatalf("expected command %q to be registered", name)
@@ -0,0 +1,179 @@
+/*
+Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
+*/
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
+	"github.com/spf13/cobra"
+)
+
+var eventTypes = []string{events.TypeAdd, events.TypeDismiss, events.TypeRead, events.TypeJump}
+
+// NewEventsCmd creates the events command. It reads the events stream directly,
+// so it needs no client.
+func NewEventsCmd() *cobra.Command {
+	var (
+		follow   bool
+		types    string
+		interval float64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Print or tail the event stream",
+		Long: `Print the events.jsonl stream, one event per line.
+
+USAGE:
+    tmux-intray events [OPTIONS]
+
+OPTIONS:
+    -f, --follow           Keep printing new events as they are appended
+    --type <types>         Only print these event types (comma-separated: add, dismiss, read, jump)
+    --interval <secs>      Poll interval while following (default: 1)
+    -h, --help             Show this help`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := parseEventTypes(types)
+			if err != nil {
+				return fmt.Errorf("events: %w", err)
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return TailEvents(ctx, EventsOptions{
+				Path:     events.Path(),
+				Types:    filter,
+				Follow:   follow,
+				Interval: time.Duration(interval * float64(time.Second)),
+				Output:   cmd.OutOrStdout(),
+			})
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Keep printing new events as they are appended")
+	cmd.Flags().StringVar(&types, "type", "", "Only print these event types (comma-separated: add, dismiss, read, jump)")
+	cmd.Flags().Float64Var(&interval, "interval", 1.0, "Poll interval in seconds while following (default: 1)")
+
+	return cmd
+}
+
+// EventsOptions holds all parameters for printing the event stream.
+type EventsOptions struct {
+	Path     string              // events.jsonl location
+	Types    map[string]struct{} // event types to print; empty prints all
+	Follow   bool                // keep polling for new events
+	Interval time.Duration       // polling interval (default 1 second)
+	Output   io.Writer           // where to write events (default os.Stdout)
+	TickChan <-chan time.Time    // optional tick channel for testing (if nil, a ticker is created)
+}
+
+// TailEvents prints the events already in the stream. When following, it keeps
+// printing newly appended events until the context is cancelled.
+func TailEvents(ctx context.Context, opts EventsOptions) error {
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+
+	tailer := events.NewTailer(opts.Path)
+	if err := printEventBatch(tailer, opts); err != nil {
+		return fmt.Errorf("events: %w", err)
+	}
+	if !opts.Follow {
+		return nil
+	}
+
+	tickChan, stopTicker := resolveTickChannel(FollowOptions{Interval: opts.Interval, TickChan: opts.TickChan})
+	defer stopTicker()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tickChan:
+			if err := printEventBatch(tailer, opts); err != nil {
+				_, _ = fmt.Fprintf(opts.Output, "events: %v\n", err)
+			}
+		}
+	}
+}
+
+func printEventBatch(tailer *events.Tailer, opts EventsOptions) error {
+	batch, err := tailer.Poll()
+	if err != nil {
+		return err
+	}
+	for _, event := range batch {
+		if len(opts.Types) > 0 {
+			if _, ok := opts.Types[event.Type]; !ok {
+				continue
+			}
+		}
+		printEvent(event, opts.Output)
+	}
+	return nil
+}
+
+// parseEventTypes turns a comma-separated --type value into a set. An empty
+// value selects every type.
+func parseEventTypes(value string) (map[string]struct{}, error) {
+	filter := make(map[string]struct{})
+	for _, raw := range strings.Split(value, ",") {
+		name := strings.ToLower(strings.TrimSpace(raw))
+		if name == "" {
+			continue
+		}
+		if !isEventType(name) {
+			return nil, fmt.Errorf("unknown event type %q (expected %s)", name, strings.Join(eventTypes, ", "))
+		}
+		filter[name] = struct{}{}
+	}
+	return filter, nil
+}
+
+func isEventType(name string) bool {
+	for _, t := range eventTypes {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// printEvent prints one event as "[time] type #id [level] message (session:window.pane)".
+func printEvent(event events.Event, w io.Writer) {
+	parts := []string{fmt.Sprintf("[%s]", formatTimestamp(event.Timestamp)), fmt.Sprintf("%-7s", event.Type)}
+	if event.NotificationID != "" {
+		parts = append(parts, "#"+event.NotificationID)
+	}
+	if event.Level != "" {
+		parts = append(parts, fmt.Sprintf("[%s]", event.Level))
+	}
+	if event.Message != "" {
+		parts = append(parts, event.Message)
+	}
+	if event.Session != "" || event.Window != "" || event.Pane != "" {
+		parts = append(parts, fmt.Sprintf("(%s:%s.%s)", event.Session, event.Window, event.Pane))
+	}
+	line := strings.Join(parts, " ")
+
+	if color := colorForLevel(event.Level); color != "" {
+		_, _ = fmt.Fprintf(w, "%s%s%s\n", color, line, colors.Reset)
+		return
+	}
+	_, _ = fmt.Fprintln(w, line)
+}
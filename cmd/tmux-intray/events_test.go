@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recordedEvents = `{"type":"add","ts":"2026-01-01T10:00:00Z","id":"1","message":"build started","level":"info"}
+{"type":"jump","ts":"2026-01-01T10:00:05Z","id":"1","session":"$1","window":"@1","pane":"%1"}
+{"type":"read","ts":"2026-01-01T10:00:06Z","id":"1"}
+{"type":"dismiss","ts":"2026-01-01T10:00:10Z","id":"1"}
+`
+
+func writeEventsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestTailEventsFiltersByType(t *testing.T) {
+	path := writeEventsFile(t, recordedEvents)
+	types, err := parseEventTypes("add, dismiss")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, TailEvents(context.Background(), EventsOptions{Path: path, Types: types, Output: &buf}))
+
+	out := buf.String()
+	assert.Contains(t, out, "[2026-01-01 10:00:00] add     #1 [info] build started")
+	assert.Contains(t, out, "dismiss #1")
+	assert.NotContains(t, out, "jump")
+	assert.NotContains(t, out, "read")
+}
+
+func TestTailEventsFollowPrintsAppendedEvents(t *testing.T) {
+	path := writeEventsFile(t, recordedEvents)
+	types, err := parseEventTypes("add")
+	require.NoError(t, err)
+
+	tickChan := make(chan time.Time)
+	var buf bytes.Buffer
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- TailEvents(ctx, EventsOptions{Path: path, Types: types, Follow: true, Output: &buf, TickChan: tickChan})
+	}()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"type":"add","ts":"2026-01-01T10:01:00Z","id":"2","message":"deploy failed","level":"error"}` + "\n" +
+		`{"type":"dismiss","ts":"2026-01-01T10:01:05Z","id":"2"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// The second send only completes once the first tick has been handled.
+	tickChan <- time.Now()
+	tickChan <- time.Now()
+	cancel()
+
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("TailEvents did not exit after cancellation")
+	}
+
+	out := buf.String()
+	assert.Contains(t, out, "build started")
+	assert.Contains(t, out, "#2 [error] deploy failed")
+	assert.NotContains(t, out, "dismiss")
+}
+
+func TestParseEventTypesRejectsUnknownType(t *testing.T) {
+	_, err := parseEventTypes("add,snooze")
+	assert.ErrorContains(t, err, `unknown event type "snooze"`)
+}
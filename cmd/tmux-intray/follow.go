@@ -9,7 +9,6 @@ import (
 	"io"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
@@ -23,6 +22,11 @@ type followClient interface {
 	ListNotifications(state, level, session, window, pane, olderThan, newerThan, readFilter string) (string, error)
 }
 
+// staleEscalator is implemented by clients that can run the escalation sweep.
+type staleEscalator interface {
+	EscalateStale() (int, error)
+}
+
 var (
 	followAll       bool
 	followDismissed bool
@@ -187,6 +191,9 @@ func resolveTickChannel(opts FollowOptions) (<-chan time.Time, func()) {
 }
 
 func handleTick(opts FollowOptions, seen map[int]bool) error {
+	escalateStale(opts)
+	wakeSnoozed(opts)
+
 	lines, err := fetchFollowNotifications(opts)
 	if err != nil {
 		return err
@@ -195,11 +202,27 @@ func handleTick(opts FollowOptions, seen map[int]bool) error {
 		return nil
 	}
 
-	notifications := parseNotifications(lines)
+	notifications := notification.ParseNotifications(lines)
 	printNewNotifications(notifications, seen, opts.Output)
 	return nil
 }
 
+// escalateStale runs the escalation sweep on each tick when the client supports it.
+func escalateStale(opts FollowOptions) {
+	escalator, ok := opts.Client.(staleEscalator)
+	if !ok {
+		return
+	}
+	if _, err := escalator.EscalateStale(); err != nil {
+		_, _ = fmt.Fprintf(opts.Output, "follow: escalation sweep failed: %v\n", err)
+	}
+}
+
+// wakeSnoozed runs the session snooze sweep on each tick when the client supports it.
+func wakeSnoozed(opts FollowOptions) {
+	wakeSnoozedFor("follow", opts.Client, opts.Output)
+}
+
 func fetchFollowNotifications(opts FollowOptions) (string, error) {
 	if opts.Client != nil {
 		return opts.Client.ListNotifications(opts.State, opts.Level, opts.Session, opts.Window, opts.Pane, "", "", "")
@@ -210,21 +233,6 @@ func fetchFollowNotifications(opts FollowOptions) (string, error) {
 	return listFunc(opts.State, opts.Level, opts.Session, opts.Window, opts.Pane, "", "", "")
 }
 
-func parseNotifications(lines string) []notification.Notification {
-	var notifications []notification.Notification
-	for _, line := range strings.Split(lines, "\n") {
-		if line == "" {
-			continue
-		}
-		notif, err := notification.ParseNotification(line)
-		if err != nil {
-			continue
-		}
-		notifications = append(notifications, notif)
-	}
-	return notifications
-}
-
 func printNewNotifications(notifications []notification.Notification, seen map[int]bool, output io.Writer) {
 	for _, notif := range notifications {
 		if seen[notif.ID] {
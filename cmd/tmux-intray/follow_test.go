@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -467,3 +468,65 @@ func TestFollowDefaultOutput(t *testing.T) {
 		t.Fatal("Follow did not exit after cancellation")
 	}
 }
+
+type fakeEscalatingFollowClient struct {
+	fakeFollowClient
+	sweeps int
+	err    error
+}
+
+func (f *fakeEscalatingFollowClient) EscalateStale() (int, error) {
+	f.sweeps++
+	return 0, f.err
+}
+
+func TestFollowTickRunsEscalationSweep(t *testing.T) {
+	client := &fakeEscalatingFollowClient{err: errors.New("boom")}
+	var buf bytes.Buffer
+	opts := FollowOptions{Client: client, Output: &buf}
+
+	if err := handleTick(opts, map[int]bool{}); err != nil {
+		t.Fatalf("handleTick returned error: %v", err)
+	}
+
+	if client.sweeps != 1 {
+		t.Fatalf("Expected 1 escalation sweep, got %d", client.sweeps)
+	}
+	if len(client.calls) != 1 {
+		t.Fatalf("Expected listing to continue after a failed sweep, got %d calls", len(client.calls))
+	}
+	if !strings.Contains(buf.String(), "escalation sweep failed: boom") {
+		t.Errorf("Expected sweep failure in output, got %q", buf.String())
+	}
+}
+
+type fakeSnoozingFollowClient struct {
+	fakeFollowClient
+	sweeps int
+	err    error
+}
+
+func (f *fakeSnoozingFollowClient) WakeSnoozedNotifications() (int, error) {
+	f.sweeps++
+	return 0, f.err
+}
+
+func TestFollowTickRunsSnoozeSweep(t *testing.T) {
+	client := &fakeSnoozingFollowClient{err: errors.New("boom")}
+	var buf bytes.Buffer
+	opts := FollowOptions{Client: client, Output: &buf}
+
+	if err := handleTick(opts, map[int]bool{}); err != nil {
+		t.Fatalf("handleTick returned error: %v", err)
+	}
+
+	if client.sweeps != 1 {
+		t.Fatalf("Expected 1 snooze sweep, got %d", client.sweeps)
+	}
+	if len(client.calls) != 1 {
+		t.Fatalf("Expected listing to continue after a failed sweep, got %d calls", len(client.calls))
+	}
+	if !strings.Contains(buf.String(), "snooze sweep failed: boom") {
+		t.Errorf("Expected sweep failure in output, got %q", buf.String())
+	}
+}
@@ -18,6 +18,9 @@ type jumpClient interface {
 	ValidatePaneExists(session, window, pane string) bool
 	JumpToPane(session, window, pane string) bool
 	MarkNotificationRead(id string) error
+	RecordJumpEvent(id, session, window, pane string) error
+	QueueJump(id string, noMarkRead bool) error
+	DrainPendingActions() (int, error)
 }
 
 type jumpDetails struct {
@@ -48,6 +51,11 @@ DESCRIPTION:
     must still exist; if it doesn't, the command falls back to the window.
     By default, a successful jump automatically marks the notification as read.
     Use --no-mark-read to disable this behavior.
+    Each successful jump appends a "jump" event to events.jsonl in the
+    state directory.
+    When tmux is not running, the jump is queued and runs the next time
+    'jump' or 'tui' is invoked with tmux available. Queued jumps older
+    than 10 minutes are dropped.
 
 ARGUMENTS:
     <id>    Notification ID (as shown in 'tmux-intray list --format=table')
@@ -79,8 +87,10 @@ func makeJumpRunE(client jumpClient, noMarkReadFlag *bool) func(cmd *cobra.Comma
 		id := args[0]
 
 		if !client.EnsureTmuxRunning() {
-			return fmt.Errorf("tmux not running")
+			return queueJump(client, id, *noMarkReadFlag)
 		}
+		// Queued jumps run first so this one decides where the client ends up.
+		drainPendingActions(client)
 
 		details, err := loadJumpDetails(client, id)
 		if err != nil {
@@ -97,6 +107,19 @@ func makeJumpRunE(client jumpClient, noMarkReadFlag *bool) func(cmd *cobra.Comma
 	}
 }
 
+// queueJump validates the notification and queues the jump to run once tmux
+// is available again, keeping noMarkRead for when it runs.
+func queueJump(client jumpClient, id string, noMarkRead bool) error {
+	if _, err := loadJumpDetails(client, id); err != nil {
+		return err
+	}
+	if err := client.QueueJump(id, noMarkRead); err != nil {
+		return fmt.Errorf("jump: tmux not running: %w", err)
+	}
+	colors.Info(fmt.Sprintf("tmux not running; jump to notification %s will run once tmux is available", id))
+	return nil
+}
+
 func loadJumpDetails(client jumpClient, id string) (jumpDetails, error) {
 	line, err := client.GetNotificationByID(id)
 	if err != nil {
@@ -157,6 +180,10 @@ func performJump(client jumpClient, id string, details jumpDetails, noMarkRead b
 		return paneExists, fmt.Errorf("jump: failed to jump because pane or window does not exist")
 	}
 
+	if err := client.RecordJumpEvent(id, details.session, details.window, details.pane); err != nil {
+		colors.Debug(fmt.Sprintf("jump: failed to record jump event: %v", err))
+	}
+
 	if !noMarkRead {
 		if err := client.MarkNotificationRead(id); err != nil {
 			return paneExists, fmt.Errorf("jump: failed to mark notification as read: %w", err)
@@ -25,6 +25,10 @@ type fakeJumpClient struct {
 	jumpToPaneResult          bool
 	markNotificationReadCalls []string
 	markNotificationReadErr   error
+	recordJumpEventCalls      []struct{ id, session, window, pane string }
+	queueJumpCalls            []string
+	queueJumpErr              error
+	drainCalls                int
 }
 
 func (f *fakeJumpClient) EnsureTmuxRunning() bool {
@@ -52,6 +56,24 @@ func (f *fakeJumpClient) MarkNotificationRead(id string) error {
 	return f.markNotificationReadErr
 }
 
+func (f *fakeJumpClient) RecordJumpEvent(id, session, window, pane string) error {
+	f.recordJumpEventCalls = append(f.recordJumpEventCalls, struct{ id, session, window, pane string }{id, session, window, pane})
+	return nil
+}
+
+func (f *fakeJumpClient) QueueJump(id string, noMarkRead bool) error {
+	if noMarkRead {
+		id += ":no-mark-read"
+	}
+	f.queueJumpCalls = append(f.queueJumpCalls, id)
+	return f.queueJumpErr
+}
+
+func (f *fakeJumpClient) DrainPendingActions() (int, error) {
+	f.drainCalls++
+	return 0, nil
+}
+
 func TestNewJumpCmdPanicsWhenClientIsNil(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -123,19 +145,47 @@ func TestJumpRunESuccess(t *testing.T) {
 	assert.Equal(t, "$0", client.jumpToPaneCalls[0].session)
 	assert.Equal(t, "%0", client.jumpToPaneCalls[0].window)
 	assert.Equal(t, ":0.0", client.jumpToPaneCalls[0].pane)
+	assert.Equal(t, 1, client.drainCalls, "queued jumps run before this one")
 }
 
-func TestJumpRunETmuxNotRunning(t *testing.T) {
+func TestJumpRunETmuxNotRunningQueuesJump(t *testing.T) {
+	client := &fakeJumpClient{
+		ensureTmuxRunningResult:   false,
+		getNotificationByIDResult: "42\t2025-02-04T10:00:00Z\tactive\t$1\t@2\t%3\thello\t\tinfo",
+	}
+	cmd := NewJumpCmd(client)
+
+	require.NoError(t, cmd.RunE(cmd, []string{"42"}))
+	assert.Equal(t, 1, client.ensureCalls)
+	assert.Equal(t, []string{"42"}, client.queueJumpCalls)
+	assert.Empty(t, client.jumpToPaneCalls)
+	assert.Empty(t, client.markNotificationReadCalls)
+	assert.Zero(t, client.drainCalls)
+}
+
+func TestJumpRunETmuxNotRunningQueuesNoMarkRead(t *testing.T) {
+	client := &fakeJumpClient{
+		ensureTmuxRunningResult:   false,
+		getNotificationByIDResult: "42\t2025-02-04T10:00:00Z\tactive\t$1\t@2\t%3\thello\t\tinfo",
+	}
+	cmd := NewJumpCmd(client)
+	require.NoError(t, cmd.Flags().Set("no-mark-read", "true"))
+
+	require.NoError(t, cmd.RunE(cmd, []string{"42"}))
+	assert.Equal(t, []string{"42:no-mark-read"}, client.queueJumpCalls)
+}
+
+func TestJumpRunETmuxNotRunningDoesNotQueueUnknownNotification(t *testing.T) {
 	client := &fakeJumpClient{
 		ensureTmuxRunningResult: false,
+		getNotificationByIDErr:  errors.New("not found"),
 	}
 	cmd := NewJumpCmd(client)
 
 	err := cmd.RunE(cmd, []string{"42"})
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "tmux not running")
-	assert.Equal(t, 1, client.ensureCalls)
-	assert.Empty(t, client.getNotificationByIDCalls)
+	assert.Contains(t, err.Error(), "jump: not found")
+	assert.Empty(t, client.queueJumpCalls)
 }
 
 func TestJumpRunENotificationNotFound(t *testing.T) {
@@ -241,6 +291,35 @@ func TestJumpRunEDoesNotMarkReadWhenJumpFails(t *testing.T) {
 	assert.Empty(t, client.markNotificationReadCalls)
 }
 
+func TestJumpRunERecordsOneJumpEventOnSuccess(t *testing.T) {
+	client := &fakeJumpClient{
+		ensureTmuxRunningResult:   true,
+		getNotificationByIDResult: "42\t2025-02-04T10:00:00Z\tactive\t$0\t%0\t:0.0\thello\t1234567890\tinfo",
+		validatePaneExistsResult:  true,
+		jumpToPaneResult:          true,
+	}
+	cmd := NewJumpCmd(client)
+
+	err := cmd.RunE(cmd, []string{"42"})
+	require.NoError(t, err)
+	require.Len(t, client.recordJumpEventCalls, 1)
+	assert.Equal(t, struct{ id, session, window, pane string }{"42", "$0", "%0", ":0.0"}, client.recordJumpEventCalls[0])
+}
+
+func TestJumpRunEDoesNotRecordJumpEventWhenJumpFails(t *testing.T) {
+	client := &fakeJumpClient{
+		ensureTmuxRunningResult:   true,
+		getNotificationByIDResult: "42\t2025-02-04T10:00:00Z\tactive\t$0\t%0\t:0.0\thello\t1234567890\tinfo",
+		validatePaneExistsResult:  true,
+		jumpToPaneResult:          false,
+	}
+	cmd := NewJumpCmd(client)
+
+	err := cmd.RunE(cmd, []string{"42"})
+	require.Error(t, err)
+	assert.Empty(t, client.recordJumpEventCalls)
+}
+
 func TestJumpRunENoMarkReadFlagSkipsMarkRead(t *testing.T) {
 	client := &fakeJumpClient{
 		ensureTmuxRunningResult:   true,
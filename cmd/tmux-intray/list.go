@@ -94,6 +94,8 @@ func NewListCmd(client listClient, searchProviderFactory appcore.SearchProviderF
 	var listShowStale bool
 
 	listCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		wakeSnoozedFor("list", client, cmd.ErrOrStderr())
+
 		// Handle --json flag
 		if listJSON {
 			listFormat = "json"
@@ -70,7 +70,7 @@ func printRecents(opts RecentsOptions, w io.Writer) {
 		return
 	}
 
-	notifications := parseTabsNotifications(lines)
+	notifications := notification.ParseNotifications(lines)
 	if len(notifications) == 0 {
 		_, _ = fmt.Fprintln(w, "No recent unread notifications found")
 		return
@@ -123,6 +123,13 @@ func formatRecentsUsingListFormatter(notifs []notification.Notification, ftype f
 			PaneCreated:   n.PaneCreated,
 			Level:         domain.NotificationLevel(n.Level),
 			ReadTimestamp: n.ReadTimestamp,
+			Important:     n.Important,
+			Color:         n.Color,
+			Owner:         n.Owner,
+			SnoozedUntil:  n.SnoozedUntil,
+			Priority:      n.Priority,
+			Tags:          n.Tags,
+			Occurrences:   n.Occurrences,
 		})
 	}
 
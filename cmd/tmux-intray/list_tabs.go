@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strings"
 
 	appcore "github.com/cristianoliveira/tmux-intray/internal/app"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -61,7 +60,7 @@ func printTabs(opts TabsOptions, w io.Writer) {
 		return
 	}
 
-	notifications := parseTabsNotifications(lines)
+	notifications := notification.ParseNotifications(lines)
 	if len(notifications) == 0 {
 		_, _ = fmt.Fprintln(w, "No notifications found")
 		return
@@ -106,22 +105,6 @@ func formatTabsUsingListFormatter(groups []domain.SessionNotification, ftype for
 	_ = formatter.FormatNotifications(notifs, w)
 }
 
-// parseTabsNotifications parses notification lines.
-func parseTabsNotifications(lines string) []notification.Notification {
-	var notifications []notification.Notification
-	for _, line := range strings.Split(lines, "\n") {
-		if line == "" {
-			continue
-		}
-		notif, err := notification.ParseNotification(line)
-		if err != nil {
-			continue
-		}
-		notifications = append(notifications, notif)
-	}
-	return notifications
-}
-
 // groupBySession groups notifications by session, keeping only the most recent.
 func groupBySession(notifications []notification.Notification) []domain.SessionNotification {
 	// Convert to domain notifications
@@ -168,6 +151,13 @@ func domainNotificationToPointer(n *notification.Notification) *domain.Notificat
 		PaneCreated:   n.PaneCreated,
 		Level:         level,
 		ReadTimestamp: n.ReadTimestamp,
+		Important:     n.Important,
+		Color:         n.Color,
+		Owner:         n.Owner,
+		SnoozedUntil:  n.SnoozedUntil,
+		Priority:      n.Priority,
+		Tags:          n.Tags,
+		Occurrences:   n.Occurrences,
 	}
 }
 
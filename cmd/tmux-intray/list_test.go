@@ -1034,3 +1034,29 @@ func TestListCmdTabWithFilters(t *testing.T) {
 		})
 	}
 }
+
+type fakeSnoozingListClient struct {
+	fakeListClient
+	sweeps int
+	err    error
+}
+
+func (f *fakeSnoozingListClient) WakeSnoozedNotifications() (int, error) {
+	f.sweeps++
+	return 0, f.err
+}
+
+func TestListCmdRunsSnoozeSweepBeforeListing(t *testing.T) {
+	client := &fakeSnoozingListClient{err: errors.New("boom")}
+	cmd := NewListCmd(client, defaultListSearchProvider, func() appcore.DisplayNames { return appcore.DisplayNames{} })
+	var stdout, stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+
+	err := cmd.RunE(cmd, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.sweeps)
+	assert.Len(t, client.listNotificationsCalls, 1, "listing continues after a failed sweep")
+	assert.Contains(t, stderr.String(), "list: snooze sweep failed: boom")
+	assert.NotContains(t, stdout.String(), "snooze sweep")
+}
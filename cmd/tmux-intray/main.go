@@ -5,6 +5,7 @@ import (
 
 	"github.com/cristianoliveira/tmux-intray/cmd"
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
 )
 
 func main() {
@@ -12,7 +13,9 @@ func main() {
 		if err := initCLI(); err != nil {
 			return err
 		}
-		return cmd.Execute()
+		err := cmd.Execute()
+		storage.FlushStatus()
+		return err
 	})
 	if exitCode != 0 {
 		os.Exit(exitCode)
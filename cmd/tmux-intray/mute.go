@@ -0,0 +1,119 @@
+/*
+Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
+*/
+package main
+
+import (
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/core"
+	"github.com/spf13/cobra"
+)
+
+type muteClient interface {
+	MuteSession(session string) error
+	UnmuteSession(session string) error
+	ListMutedSessions() ([]string, error)
+	GetCurrentTmuxContext() core.TmuxContext
+}
+
+// NewMuteCmd creates the mute command with explicit dependencies.
+func NewMuteCmd(client muteClient) *cobra.Command {
+	if client == nil {
+		panic("NewMuteCmd: client dependency cannot be nil")
+	}
+
+	var listMuted bool
+
+	muteCmd := &cobra.Command{
+		Use:   "mute [session]",
+		Short: "Mute notifications from a session",
+		Long: `Mute notifications from a tmux session.
+
+Notifications from a muted session are still stored, but they are marked
+as read and do not run add hooks (so no desktop or sound alerts).
+
+USAGE:
+    tmux-intray mute              Mute the current tmux session
+    tmux-intray mute <session>    Mute a session by ID (e.g. '$3')
+    tmux-intray mute --list       List muted sessions
+
+OPTIONS:
+    --list               List muted sessions
+    -h, --help           Show this help`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if listMuted {
+				if len(args) > 0 {
+					return fmt.Errorf("mute: cannot specify both --list and session")
+				}
+				sessions, err := client.ListMutedSessions()
+				if err != nil {
+					return fmt.Errorf("mute: failed to list muted sessions: %w", err)
+				}
+				for _, session := range sessions {
+					fmt.Fprintln(cmd.OutOrStdout(), session)
+				}
+				return nil
+			}
+
+			session, err := resolveMuteSession(client, args)
+			if err != nil {
+				return fmt.Errorf("mute: %w", err)
+			}
+			if err := client.MuteSession(session); err != nil {
+				return fmt.Errorf("mute: %w", err)
+			}
+			colors.Success(fmt.Sprintf("Session %s muted", session))
+			return nil
+		},
+	}
+
+	muteCmd.Flags().BoolVar(&listMuted, "list", false, "List muted sessions")
+	return muteCmd
+}
+
+// NewUnmuteCmd creates the unmute command with explicit dependencies.
+func NewUnmuteCmd(client muteClient) *cobra.Command {
+	if client == nil {
+		panic("NewUnmuteCmd: client dependency cannot be nil")
+	}
+
+	return &cobra.Command{
+		Use:   "unmute [session]",
+		Short: "Unmute notifications from a session",
+		Long: `Restore normal notifications for a muted tmux session.
+
+USAGE:
+    tmux-intray unmute              Unmute the current tmux session
+    tmux-intray unmute <session>    Unmute a session by ID (e.g. '$3')
+
+OPTIONS:
+    -h, --help           Show this help`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := resolveMuteSession(client, args)
+			if err != nil {
+				return fmt.Errorf("unmute: %w", err)
+			}
+			if err := client.UnmuteSession(session); err != nil {
+				return fmt.Errorf("unmute: %w", err)
+			}
+			colors.Success(fmt.Sprintf("Session %s unmuted", session))
+			return nil
+		},
+	}
+}
+
+// resolveMuteSession returns the session argument, or the current tmux session when omitted.
+func resolveMuteSession(client muteClient, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	session := client.GetCurrentTmuxContext().SessionID
+	if session == "" {
+		return "", fmt.Errorf("no session given and not inside tmux")
+	}
+	return session, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMuteClient struct {
+	muted      []string
+	unmuted    []string
+	listed     []string
+	currentCtx core.TmuxContext
+	err        error
+}
+
+func (f *fakeMuteClient) MuteSession(session string) error {
+	f.muted = append(f.muted, session)
+	return f.err
+}
+
+func (f *fakeMuteClient) UnmuteSession(session string) error {
+	f.unmuted = append(f.unmuted, session)
+	return f.err
+}
+
+func (f *fakeMuteClient) ListMutedSessions() ([]string, error) {
+	return f.listed, f.err
+}
+
+func (f *fakeMuteClient) GetCurrentTmuxContext() core.TmuxContext {
+	return f.currentCtx
+}
+
+func TestMuteExplicitSession(t *testing.T) {
+	client := &fakeMuteClient{}
+	cmd := NewMuteCmd(client)
+
+	require.NoError(t, cmd.RunE(cmd, []string{"$3"}))
+	require.Equal(t, []string{"$3"}, client.muted)
+}
+
+func TestMuteDefaultsToCurrentSession(t *testing.T) {
+	client := &fakeMuteClient{currentCtx: core.TmuxContext{SessionID: "$7"}}
+	cmd := NewMuteCmd(client)
+
+	require.NoError(t, cmd.RunE(cmd, []string{}))
+	require.Equal(t, []string{"$7"}, client.muted)
+}
+
+func TestMuteOutsideTmuxWithoutSessionFails(t *testing.T) {
+	client := &fakeMuteClient{}
+	cmd := NewMuteCmd(client)
+
+	err := cmd.RunE(cmd, []string{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not inside tmux")
+	require.Empty(t, client.muted)
+}
+
+func TestMuteList(t *testing.T) {
+	client := &fakeMuteClient{listed: []string{"$1", "$2"}}
+	cmd := NewMuteCmd(client)
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	require.NoError(t, cmd.Flags().Set("list", "true"))
+
+	require.NoError(t, cmd.RunE(cmd, []string{}))
+	require.Equal(t, "$1\n$2\n", stdout.String())
+}
+
+func TestUnmuteError(t *testing.T) {
+	client := &fakeMuteClient{err: errors.New("session not muted")}
+	cmd := NewUnmuteCmd(client)
+
+	err := cmd.RunE(cmd, []string{"$3"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unmute:")
+	require.Contains(t, err.Error(), "session not muted")
+	require.Equal(t, []string{"$3"}, client.unmuted)
+}
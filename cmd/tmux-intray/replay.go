@@ -0,0 +1,160 @@
+/*
+Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+type replayClient interface {
+	AddTrayItem(item, session, window, pane, paneCreated string, noAssociate bool, level string) (string, error)
+	DismissNotification(id string) error
+	MarkNotificationRead(id string) error
+}
+
+// NewReplayCmd creates the replay command with explicit dependencies.
+func NewReplayCmd(client replayClient) *cobra.Command {
+	if client == nil {
+		panic("NewReplayCmd: client dependency cannot be nil")
+	}
+
+	var speed float64
+
+	replayCmd := &cobra.Command{
+		Use:   "replay <file.jsonl>",
+		Short: "Replay a recorded event stream",
+		Long: `Replay a recorded events.jsonl stream.
+
+Feeds recorded events back into the tray, keeping the original spacing
+between events. Useful for demos and for reproducing bug reports.
+
+  add       adds the notification (message, level, session/window/pane)
+  dismiss   dismisses the notification added earlier in the replay
+  read      marks the notification added earlier in the replay as read
+  jump      is printed only; replay never moves the tmux focus
+
+Events naming a notification the replay did not add are skipped and
+reported, so they never touch unrelated notifications in the tray.
+
+USAGE:
+    tmux-intray replay [OPTIONS] <file.jsonl>
+
+OPTIONS:
+    --speed <factor>    Divide recorded delays by this factor (default: 1, 0 = no delays)
+    -h, --help          Show this help`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("replay: requires an events file")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("replay: %w", err)
+			}
+			defer f.Close()
+
+			recorded, err := events.Read(f)
+			if err != nil {
+				return fmt.Errorf("replay: %w", err)
+			}
+
+			r := &replayer{client: client, out: cmd.OutOrStdout(), ids: map[string]string{}}
+			scheduler := events.Scheduler{Speed: speed}
+			if err := scheduler.Run(context.Background(), recorded, r.apply); err != nil {
+				return fmt.Errorf("replay: %w", err)
+			}
+			if r.skipped > 0 {
+				colors.Warning(fmt.Sprintf("Skipped %d events for notifications not added by this replay", r.skipped))
+			}
+			colors.Success(fmt.Sprintf("Replayed %d events", len(recorded)-r.skipped))
+			return nil
+		},
+	}
+
+	replayCmd.Flags().Float64Var(&speed, "speed", 1, "Divide recorded delays by this factor (0 = no delays)")
+	return replayCmd
+}
+
+// replayer applies recorded events, mapping recorded IDs to the IDs of re-added notifications.
+type replayer struct {
+	client  replayClient
+	out     io.Writer
+	ids     map[string]string
+	skipped int
+}
+
+func (r *replayer) apply(event events.Event) error {
+	switch event.Type {
+	case events.TypeAdd:
+		level := event.Level
+		if level == "" {
+			level = "info"
+		}
+		id, err := r.client.AddTrayItem(event.Message, event.Session, event.Window, event.Pane, "", true, level)
+		if err != nil {
+			return err
+		}
+		if event.NotificationID != "" {
+			r.ids[event.NotificationID] = id
+		}
+		fmt.Fprintf(r.out, "add %s: %s\n", id, event.Message)
+	case events.TypeDismiss:
+		id, ok := r.resolveID(event)
+		if !ok {
+			return nil
+		}
+		err := r.client.DismissNotification(id)
+		if errors.Is(err, storage.ErrNotificationAlreadyDismissed) {
+			// Re-adding can dismiss it again through max_active or
+			// auto_dismiss_lower_levels, which recorded this event originally.
+			fmt.Fprintf(r.out, "dismiss %s (already dismissed)\n", id)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(r.out, "dismiss %s\n", id)
+	case events.TypeRead:
+		id, ok := r.resolveID(event)
+		if !ok {
+			return nil
+		}
+		if err := r.client.MarkNotificationRead(id); err != nil {
+			return err
+		}
+		fmt.Fprintf(r.out, "read %s\n", id)
+	case events.TypeJump:
+		id, ok := r.resolveID(event)
+		if !ok {
+			return nil
+		}
+		fmt.Fprintf(r.out, "jump %s -> %s:%s.%s\n", id, event.Session, event.Window, event.Pane)
+	default:
+		colors.Warning(fmt.Sprintf("replay: skipping unknown event type %q", event.Type))
+	}
+	return nil
+}
+
+// resolveID returns the replayed ID for the notification event names. When
+// the replay did not add it, the event is reported as skipped and ok is false:
+// the recorded ID may belong to an unrelated notification in this tray.
+func (r *replayer) resolveID(event events.Event) (string, bool) {
+	if id, ok := r.ids[event.NotificationID]; ok {
+		return id, true
+	}
+	r.skipped++
+	fmt.Fprintf(r.out, "%s %s skipped: not added by this replay\n", event.Type, event.NotificationID)
+	return "", false
+}
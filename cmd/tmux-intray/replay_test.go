@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReplayClient struct {
+	calls      []string
+	nextID     int
+	dismissErr error
+}
+
+func (f *fakeReplayClient) AddTrayItem(item, session, window, pane, paneCreated string, noAssociate bool, level string) (string, error) {
+	f.nextID++
+	f.calls = append(f.calls, "add:"+item+":"+level)
+	return strconv.Itoa(f.nextID), nil
+}
+
+func (f *fakeReplayClient) DismissNotification(id string) error {
+	f.calls = append(f.calls, "dismiss:"+id)
+	return f.dismissErr
+}
+
+func (f *fakeReplayClient) MarkNotificationRead(id string) error {
+	f.calls = append(f.calls, "read:"+id)
+	return nil
+}
+
+func TestNewReplayCmdPanicsWhenClientIsNil(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected panic, got nil")
+		}
+	}()
+	NewReplayCmd(nil)
+}
+
+func TestReplayAppliesEventsInOrderAndMapsIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"type":"add","ts":"2026-01-01T10:00:00Z","id":"41","message":"first","level":"warning"}
+{"type":"add","ts":"2026-01-01T10:00:01Z","id":"42","message":"second"}
+{"type":"read","ts":"2026-01-01T10:00:02Z","id":"42"}
+{"type":"dismiss","ts":"2026-01-01T10:00:03Z","id":"41"}
+`), 0o644))
+
+	client := &fakeReplayClient{}
+	cmd := NewReplayCmd(client)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--speed", "0", path})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []string{"add:first:warning", "add:second:info", "read:2", "dismiss:1"}, client.calls)
+	assert.Contains(t, out.String(), "add 1: first")
+}
+
+func TestReplaySkipsNotificationsAlreadyDismissed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"type":"add","ts":"2026-01-01T10:00:00Z","id":"41","message":"first"}
+{"type":"dismiss","ts":"2026-01-01T10:00:01Z","id":"41"}
+{"type":"read","ts":"2026-01-01T10:00:02Z","id":"41"}
+`), 0o644))
+
+	client := &fakeReplayClient{dismissErr: fmt.Errorf("sqlite storage: dismiss notification: %w: id 1", storage.ErrNotificationAlreadyDismissed)}
+	cmd := NewReplayCmd(client)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--speed", "0", path})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []string{"add:first:info", "dismiss:1", "read:1"}, client.calls)
+	assert.Contains(t, out.String(), "dismiss 1 (already dismissed)")
+}
+
+func TestReplaySkipsEventsForNotificationsItDidNotAdd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"type":"add","ts":"2026-01-01T10:00:00Z","id":"41","message":"first"}
+{"type":"dismiss","ts":"2026-01-01T10:00:01Z","id":"7"}
+{"type":"read","ts":"2026-01-01T10:00:02Z","id":"8"}
+{"type":"read","ts":"2026-01-01T10:00:03Z","id":"41"}
+`), 0o644))
+
+	client := &fakeReplayClient{}
+	cmd := NewReplayCmd(client)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--speed", "0", path})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []string{"add:first:info", "read:1"}, client.calls, "recorded IDs are never used as tray IDs")
+	assert.Contains(t, out.String(), "dismiss 7 skipped: not added by this replay")
+	assert.Contains(t, out.String(), "read 8 skipped: not added by this replay")
+}
+
+func TestReplayRejectsMissingFile(t *testing.T) {
+	cmd := NewReplayCmd(&fakeReplayClient{})
+	cmd.SetArgs([]string{filepath.Join(t.TempDir(), "missing.jsonl")})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "replay:")
+}
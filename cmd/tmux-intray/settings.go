@@ -27,8 +27,9 @@ USAGE:
     tmux-intray settings <subcommand>
 
 SUBCOMMANDS:
-    reset    Reset settings to defaults
-    show     Display current settings
+    reset       Reset settings to defaults
+    show        Display current settings
+    validate    Report invalid values in the settings file
 
 EXAMPLES:
     # Reset settings with confirmation
@@ -38,7 +39,10 @@ EXAMPLES:
     tmux-intray settings reset --force
 
     # Show current settings
-    tmux-intray settings show`
+    tmux-intray settings show
+
+    # Check the settings file for mistakes
+    tmux-intray config validate`
 	resetCommandLong = `Reset TUI settings to defaults by deleting the settings file.
 
 USAGE:
@@ -62,6 +66,27 @@ USAGE:
 EXAMPLES:
     # Show current settings
     tmux-intray settings show`
+	validateCommandLong = `Check the TUI settings file and report every invalid value.
+
+USAGE:
+    tmux-intray settings validate [OPTIONS]
+
+DESCRIPTION:
+    Loading settings stops at the first invalid value. validate reads the
+    whole file and prints one line per problem with its line number: unknown
+    keys, unsupported group_by or view_mode values, out-of-range expand
+    levels, bad glyphs and so on. It exits non-zero when anything is wrong.
+
+OPTIONS:
+    --file <path>    Settings file to check (default: the active tui.toml)
+    -h, --help       Show this help
+
+EXAMPLES:
+    # Check the active settings file
+    tmux-intray config validate
+
+    # Check a file before installing it
+    tmux-intray settings validate --file ./tui.toml`
 )
 
 // NewSettingsCmd creates the settings command with explicit dependencies.
@@ -71,17 +96,20 @@ func NewSettingsCmd(client settingsClient) *cobra.Command {
 	}
 
 	settingsCmd := &cobra.Command{
-		Use:   "settings",
-		Short: "Manage TUI settings",
-		Long:  settingsCommandLong,
+		Use:     "settings",
+		Aliases: []string{"config"},
+		Short:   "Manage TUI settings",
+		Long:    settingsCommandLong,
 	}
 
 	resetCmd := newResetCmd(client)
 	showCmd := newShowCmd(client)
+	validateCmd := newValidateCmd()
 
 	// Add subcommands to parent
 	settingsCmd.AddCommand(resetCmd)
 	settingsCmd.AddCommand(showCmd)
+	settingsCmd.AddCommand(validateCmd)
 
 	return settingsCmd
 }
@@ -113,6 +141,46 @@ func newShowCmd(client settingsClient) *cobra.Command {
 	}
 }
 
+// newValidateCmd creates the validate subcommand.
+func newValidateCmd() *cobra.Command {
+	var file string
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Report invalid values in the settings file",
+		Long:  validateCommandLong,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runValidateCmd(file)
+		},
+	}
+	validateCmd.Flags().StringVar(&file, "file", "", "Settings file to check (default: the active tui.toml)")
+	return validateCmd
+}
+
+// runValidateCmd executes the validate subcommand.
+func runValidateCmd(path string) error {
+	if path == "" {
+		path = settings.Path()
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			colors.Info(fmt.Sprintf("No settings file at %s; defaults are in use", path))
+			return nil
+		}
+	}
+
+	problems, err := settings.CheckFile(path)
+	if err != nil {
+		return fmt.Errorf("settings validate: %w", err)
+	}
+	if len(problems) == 0 {
+		colors.Success(fmt.Sprintf("Settings file %s is valid", path))
+		return nil
+	}
+
+	for _, problem := range problems {
+		colors.Error(problem.String())
+	}
+	return fmt.Errorf("settings validate: %d problem(s) in %s", len(problems), path)
+}
+
 // runResetCmd executes the reset subcommand.
 func runResetCmd(client settingsClient, force bool) error {
 	// Skip confirmation if --force flag is set or running in CI/test environment
@@ -308,3 +308,42 @@ func TestSettingsResetCommandWithBatsTmpdirSkipsConfirmation(t *testing.T) {
 
 // Note: Testing interactive confirmation is complex and relies on stdin.
 // We'll rely on integration tests for that.
+
+func findSettingsSubcommand(t *testing.T, name string) *cobra.Command {
+	t.Helper()
+	cmd := NewSettingsCmd(&fakeSettingsClient{})
+	for _, c := range cmd.Commands() {
+		if c.Name() == name {
+			return c
+		}
+	}
+	t.Fatalf("%s subcommand not found", name)
+	return nil
+}
+
+func TestSettingsCommandHasConfigAlias(t *testing.T) {
+	cmd := NewSettingsCmd(&fakeSettingsClient{})
+	require.Contains(t, cmd.Aliases, "config")
+}
+
+func TestSettingsValidateCommandReportsProblems(t *testing.T) {
+	path := t.TempDir() + "/tui.toml"
+	require.NoError(t, os.WriteFile(path, []byte("group_by = \"galaxy\"\nsort_order = \"sideways\"\n"), 0o644))
+
+	validateCmd := findSettingsSubcommand(t, "validate")
+	require.NoError(t, validateCmd.Flags().Set("file", path))
+
+	err := validateCmd.RunE(validateCmd, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2 problem(s)")
+}
+
+func TestSettingsValidateCommandAcceptsValidFile(t *testing.T) {
+	path := t.TempDir() + "/tui.toml"
+	require.NoError(t, os.WriteFile(path, []byte("group_by = \"session\"\n"), 0o644))
+
+	validateCmd := findSettingsSubcommand(t, "validate")
+	require.NoError(t, validateCmd.Flags().Set("file", path))
+
+	require.NoError(t, validateCmd.RunE(validateCmd, nil))
+}
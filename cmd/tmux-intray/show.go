@@ -0,0 +1,105 @@
+/*
+Copyright © 2026 Cristian Oliveira <license@cristianoliveira.dev>
+*/
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+type showClient interface {
+	GetNotificationByID(id string) (string, error)
+	GetFullMessage(id string) (string, error)
+	GetPaneContext(id string) (string, error)
+}
+
+// NewShowCmd creates the show command with explicit dependencies.
+func NewShowCmd(client showClient) *cobra.Command {
+	if client == nil {
+		panic("NewShowCmd: client dependency cannot be nil")
+	}
+
+	var full bool
+	showCmd := &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show one notification",
+		Long: `Show every field of one notification by ID, then its message. When
+capture_pane_context captured the pane output at add time, it follows the
+message.
+
+Messages longer than max_message_length are stored truncated. With --full,
+the original message is printed instead when keep_full_message kept it.
+
+USAGE:
+    tmux-intray show [OPTIONS] <id>
+
+OPTIONS:
+    --full               Print the untruncated message
+    -h, --help           Show this help`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+			line, err := client.GetNotificationByID(id)
+			if err != nil {
+				return fmt.Errorf("show: %w", err)
+			}
+			notif, err := domain.ParseNotificationLine(line)
+			if err != nil {
+				return fmt.Errorf("show: %w", err)
+			}
+			if full {
+				message, err := client.GetFullMessage(id)
+				if err != nil {
+					return fmt.Errorf("show: %w", err)
+				}
+				notif.Message = message
+			}
+			paneContext, err := client.GetPaneContext(id)
+			if err != nil {
+				return fmt.Errorf("show: %w", err)
+			}
+			writeNotificationDetails(cmd.OutOrStdout(), notif, paneContext)
+			return nil
+		},
+	}
+	showCmd.Flags().BoolVar(&full, "full", false, "Print the untruncated message")
+
+	return showCmd
+}
+
+// writeNotificationDetails prints one "Field: value" line per set field,
+// then a blank line and the message. Captured pane output follows under a
+// "Pane context:" heading.
+func writeNotificationDetails(w io.Writer, n domain.Notification, paneContext string) {
+	fields := [][2]string{
+		{"ID", strconv.Itoa(n.ID)},
+		{"Level", n.Level.String()},
+		{"State", string(n.State)},
+		{"Time", n.Timestamp},
+		{"Session", n.Session},
+		{"Window", n.Window},
+		{"Pane", n.Pane},
+		{"Read", n.ReadTimestamp},
+		{"Owner", n.Owner},
+		{"Tags", strings.Join(n.Tags, ",")},
+	}
+	if n.Priority != 0 {
+		fields = append(fields, [2]string{"Priority", strconv.Itoa(n.Priority)})
+	}
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		fmt.Fprintf(w, "%s: %s\n", field[0], field[1])
+	}
+	fmt.Fprintf(w, "\n%s\n", n.Message)
+	if paneContext != "" {
+		fmt.Fprintf(w, "\nPane context:\n%s\n", strings.TrimRight(paneContext, "\n"))
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeShowClient struct {
+	line        string
+	getErr      error
+	fullMessage string
+	fullErr     error
+	fullCalls   int
+	paneContext string
+}
+
+func (f *fakeShowClient) GetNotificationByID(id string) (string, error) {
+	return f.line, f.getErr
+}
+
+func (f *fakeShowClient) GetFullMessage(id string) (string, error) {
+	f.fullCalls++
+	return f.fullMessage, f.fullErr
+}
+
+func (f *fakeShowClient) GetPaneContext(id string) (string, error) {
+	return f.paneContext, nil
+}
+
+func showTestLine() string {
+	return domain.Notification{
+		ID:        42,
+		Timestamp: "2025-01-01T12:00:00Z",
+		State:     domain.StateActive,
+		Session:   "$1",
+		Window:    "@2",
+		Pane:      "%3",
+		Message:   "build fai…",
+		Level:     domain.LevelError,
+	}.FormatNotificationLine()
+}
+
+func TestShowPrintsNotificationFields(t *testing.T) {
+	client := &fakeShowClient{line: showTestLine()}
+	cmd := NewShowCmd(client)
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	require.NoError(t, cmd.RunE(cmd, []string{"42"}))
+
+	out := stdout.String()
+	require.Contains(t, out, "ID: 42\n")
+	require.Contains(t, out, "Level: error\n")
+	require.Contains(t, out, "Pane: %3\n")
+	require.Contains(t, out, "\nbuild fai…\n")
+	require.NotContains(t, out, "Owner:", "unset fields are left out")
+	require.NotContains(t, out, "Pane context:")
+	require.Zero(t, client.fullCalls)
+}
+
+func TestShowFullPrintsUntruncatedMessage(t *testing.T) {
+	client := &fakeShowClient{line: showTestLine(), fullMessage: "build failed: exit status 2"}
+	cmd := NewShowCmd(client)
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	require.NoError(t, cmd.Flags().Set("full", "true"))
+
+	require.NoError(t, cmd.RunE(cmd, []string{"42"}))
+
+	require.Contains(t, stdout.String(), "\nbuild failed: exit status 2\n")
+	require.NotContains(t, stdout.String(), "build fai…")
+}
+
+func TestShowPrintsCapturedPaneContext(t *testing.T) {
+	client := &fakeShowClient{line: showTestLine(), paneContext: "$ make test\nFAIL\n"}
+	cmd := NewShowCmd(client)
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	require.NoError(t, cmd.RunE(cmd, []string{"42"}))
+
+	require.Contains(t, stdout.String(), "build fai…\n\nPane context:\n$ make test\nFAIL\n")
+}
+
+func TestShowReturnsErrors(t *testing.T) {
+	cmd := NewShowCmd(&fakeShowClient{getErr: errors.New("notification not found")})
+	err := cmd.RunE(cmd, []string{"99"})
+	require.ErrorContains(t, err, "show: notification not found")
+
+	cmd = NewShowCmd(&fakeShowClient{line: showTestLine(), fullErr: errors.New("storage down")})
+	require.NoError(t, cmd.Flags().Set("full", "true"))
+	err = cmd.RunE(cmd, []string{"42"})
+	require.ErrorContains(t, err, "show: storage down")
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// snoozeWaker is implemented by clients that can resurface notifications
+// snoozed until their session became active.
+type snoozeWaker interface {
+	WakeSnoozedNotifications() (int, error)
+}
+
+// wakeSnoozedFor runs the session snooze sweep before command reads
+// notifications, so a snooze whose session is now attached shows up again.
+// Clients without the sweep are skipped, and a failed sweep is reported on w
+// without failing the command.
+func wakeSnoozedFor(command string, client any, w io.Writer) {
+	waker, ok := client.(snoozeWaker)
+	if !ok {
+		return
+	}
+	if _, err := waker.WakeSnoozedNotifications(); err != nil {
+		_, _ = fmt.Fprintf(w, "%s: snooze sweep failed: %v\n", command, err)
+	}
+}
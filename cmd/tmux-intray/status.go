@@ -80,6 +80,7 @@ EXAMPLES:
 See docs/status-guide.md for detailed documentation and more examples.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			wakeSnoozedFor("status", client, cmd.ErrOrStderr())
 			format := determineStatusFormat(cmd, formatFlag)
 			w := cmd.OutOrStdout()
 			return runStatusCommandWithFormat(client, format, w, presetLookup)
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -382,3 +383,34 @@ func TestStatusMockLinesTimestampsAreISO8601(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+type fakeSnoozingStatusClient struct {
+	fakeStatusClient
+	sweeps int
+	err    error
+}
+
+func (f *fakeSnoozingStatusClient) WakeSnoozedNotifications() (int, error) {
+	f.sweeps++
+	return 0, f.err
+}
+
+func TestStatusRunERunsSnoozeSweepWithoutTouchingStatusLine(t *testing.T) {
+	client := &fakeSnoozingStatusClient{
+		fakeStatusClient: fakeStatusClient{
+			ensureTmuxRunningResult: true,
+			listNotificationsResult: statusMockLines(),
+		},
+		err: errors.New("boom"),
+	}
+	cmd := NewStatusCmd(client, defaultStatusPresetLookup)
+	var stdout, stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+
+	err := cmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.sweeps)
+	assert.Equal(t, "[4] message one\n", stdout.String())
+	assert.Contains(t, stderr.String(), "status: snooze sweep failed: boom")
+}
@@ -27,6 +27,7 @@ func NewTUICmd(client tuiClient) *cobra.Command {
 	}
 
 	var showStale bool
+	var peek bool
 
 	cmd := &cobra.Command{
 		Use:   "tui",
@@ -38,22 +39,26 @@ USAGE:
 
 KEY BINDINGS:
     j/k         Move up/down in the list
+    ]e/[e       Move to next/previous error or critical notification
     r/a         Switch to Recents / All tabs
     Ctrl+s      Switch to Sessions tab
     /           Enter search mode
-    Ctrl+v      Cycle view mode (detailed/grouped/search)
+    Ctrl+v      Cycle view mode (detailed/grouped/search/summary)
     ESC         Exit search mode, or quit TUI
     d           Dismiss selected notification
     R           Mark selected notification as read
     u           Mark selected notification as unread
+    Ctrl+z      Undo last dismiss/read/unread action
+    f           Toggle focus mode (active unread only, no header/footer)
     Enter       Jump to pane/window target
     q           Quit TUI
 
 OPTIONS:
     --show-stale Include notifications whose tmux session/window/pane no longer exists
+    --peek       Browse without changing anything: no dismiss/read changes, no auto-read, no settings saved
 
 NOTES:
-    - Settings are saved automatically on quit.
+    - Settings are saved automatically on quit, except with --peek.
     - Up/Down arrows are supported in search contexts.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,6 +80,7 @@ NOTES:
 			// Store loaded settings reference
 			model.SetLoadedSettings(loadedSettings)
 			model.SetShowStale(showStale)
+			model.SetPeek(peek)
 
 			// Apply loaded settings to model
 			st := settings.FromSettings(loadedSettings)
@@ -103,5 +109,6 @@ NOTES:
 	}
 
 	cmd.Flags().BoolVar(&showStale, "show-stale", false, "Include notifications whose tmux session/window/pane no longer exists")
+	cmd.Flags().BoolVar(&peek, "peek", false, "Browse without changing notifications or saving settings")
 	return cmd
 }
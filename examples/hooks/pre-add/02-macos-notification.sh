@@ -1,7 +1,8 @@
 #!/usr/bin/env bash
 # Example pre-add hook: macOS UI notification (visual only)
 # Environment variables available:
-#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED
+#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED,
+#   CHANNELS
 #
 # This hook triggers a macOS notification when a notification is added.
 # It displays a visual notification without any sound.
@@ -9,5 +10,12 @@
 
 set -euo pipefail
 
+# Skip levels that level_routing in tui.toml does not send to the desktop channel.
+# Versions without level routing leave CHANNELS unset, so deliver then.
+case ",${CHANNELS-desktop}," in
+*,desktop,*) ;;
+*) exit 0 ;;
+esac
+
 # Display notification using osascript (no sound)
 osascript -e "display notification \"Message: $MESSAGE\" with title \"tmux-intray\"" 2>/dev/null || true
@@ -1,7 +1,8 @@
 #!/usr/bin/env bash
 # Example pre-add hook: Linux desktop notification (visual only)
 # Environment variables available:
-#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED
+#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED,
+#   CHANNELS
 #
 # This hook triggers a Linux desktop notification when a notification is added.
 # It displays a visual notification without any sound.
@@ -9,6 +10,13 @@
 
 set -euo pipefail
 
+# Skip levels that level_routing in tui.toml does not send to the desktop channel.
+# Versions without level routing leave CHANNELS unset, so deliver then.
+case ",${CHANNELS-desktop}," in
+*,desktop,*) ;;
+*) exit 0 ;;
+esac
+
 # Display desktop notification using notify-send (no sound)
 notify-send "tmux-intray" "$MESSAGE" \
     --icon=dialog-information \
@@ -1,13 +1,21 @@
 #!/usr/bin/env bash
 # Example pre-add hook: macOS sound notification
 # Environment variables available:
-#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED
+#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED,
+#   CHANNELS
 #
 # This hook plays a sound when a notification is added, without displaying any
 # UI notification. It uses afplay to play system sounds or custom audio files.
 
 set -euo pipefail
 
+# Skip levels that level_routing in tui.toml does not send to the sound channel.
+# Versions without level routing leave CHANNELS unset, so deliver then.
+case ",${CHANNELS-sound}," in
+*,sound,*) ;;
+*) exit 0 ;;
+esac
+
 # Sound file path for notification (default: macOS Ping system sound)
 # You can specify a custom file path like: /path/to/your/sound.mp3
 # Or use a system sound name like: Ping, Glass, Purr, Sosumi, etc.
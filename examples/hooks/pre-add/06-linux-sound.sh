@@ -1,13 +1,21 @@
 #!/usr/bin/env bash
 # Example pre-add hook: Linux sound notification
 # Environment variables available:
-#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED
+#   NOTIFICATION_ID, LEVEL, MESSAGE, TIMESTAMP, SESSION, WINDOW, PANE, PANE_CREATED,
+#   CHANNELS
 #
 # This hook plays a sound when a notification is added, without displaying any
 # UI notification. It uses paplay (PulseAudio) or aplay (ALSA) to play audio.
 
 set -euo pipefail
 
+# Skip levels that level_routing in tui.toml does not send to the sound channel.
+# Versions without level routing leave CHANNELS unset, so deliver then.
+case ",${CHANNELS-sound}," in
+*,sound,*) ;;
+*) exit 0 ;;
+esac
+
 # Sound file path for notification
 # Default: freedesktop message sound
 SOUND_FILE="${LINUX_SOUND_FILE:-/usr/share/sounds/freedesktop/stereo/message.oga}"
@@ -1,10 +1,13 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
 )
 
 // AddClient defines dependencies required to add notifications.
@@ -13,6 +16,13 @@ type AddClient interface {
 	AddTrayItem(item, session, window, pane, paneCreated string, noAssociate bool, level string) (string, error)
 }
 
+// OptionsAddClient is implemented by clients that can store a color, priority
+// and tags with a notification, or store it without delivering it through the
+// post-add hooks.
+type OptionsAddClient interface {
+	AddTrayItemWithOptions(item, session, window, pane, paneCreated string, noAssociate bool, level string, opts domain.AddOptions) (string, error)
+}
+
 // AddInput represents add command inputs after flag parsing.
 type AddInput struct {
 	Args          []string
@@ -23,6 +33,20 @@ type AddInput struct {
 	NoAssociate   bool
 	Level         string
 	AllowTmuxless func() bool
+	// DefaultLevel is the level used when Level is empty. Empty means "info".
+	DefaultLevel string
+	// StrictLevel rejects an empty Level instead of applying the default.
+	StrictLevel bool
+	// NotifyMinLevel is the lowest level that is delivered. Adds below it are
+	// stored silently. Empty delivers every level.
+	NotifyMinLevel string
+	// LevelRouting maps levels to the delivery channels their adds use, as in
+	// settings.LevelRouting. Empty routes every level to every channel.
+	LevelRouting map[string][]string
+	// Options holds the color, priority and tags to store with the
+	// notification. Options.Silent is set from NotifyMinLevel and
+	// Options.Channels from LevelRouting.
+	Options domain.AddOptions
 }
 
 // AddUseCase coordinates add notification behavior.
@@ -60,12 +84,33 @@ func (u *AddUseCase) Execute(input AddInput) error {
 		return err
 	}
 
-	level := input.Level
-	if level == "" {
-		level = "info"
+	level, err := resolveAddLevel(input)
+	if err != nil {
+		return err
 	}
 
-	_, err := u.client.AddTrayItem(message, session, window, pane, input.PaneCreated, noAssociate, level)
+	opts := input.Options
+	opts.Silent = belowNotifyMinLevel(level, input.NotifyMinLevel)
+	switch {
+	case opts.Silent:
+		// An empty, non-nil list tells pre-add delivery hooks to skip this add.
+		opts.Channels = []string{}
+	case len(input.LevelRouting) > 0:
+		opts.Channels = settings.ChannelsForLevel(input.LevelRouting, level)
+	}
+	optionsClient, ok := u.client.(OptionsAddClient)
+	switch {
+	case ok && (opts.Silent || opts.HasFields() || opts.Channels != nil):
+		_, err = optionsClient.AddTrayItemWithOptions(message, session, window, pane, input.PaneCreated, noAssociate, level, opts)
+	case opts.HasFields():
+		return fmt.Errorf("add: color, priority and tags are not supported by this client")
+	default:
+		_, err = u.client.AddTrayItem(message, session, window, pane, input.PaneCreated, noAssociate, level)
+	}
+	if errors.Is(err, domain.ErrSuppressedAfterDismiss) {
+		colors.Info("suppressed: same notification was dismissed recently")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("add: failed to add tray item: %w", err)
 	}
@@ -74,6 +119,32 @@ func (u *AddUseCase) Execute(input AddInput) error {
 	return nil
 }
 
+// resolveAddLevel returns the explicit level, or the configured default when it is empty.
+func resolveAddLevel(input AddInput) (string, error) {
+	if input.Level != "" {
+		return input.Level, nil
+	}
+	if input.StrictLevel {
+		return "", fmt.Errorf("add: level is required (--strict-level)")
+	}
+	if input.DefaultLevel == "" {
+		return "info", nil
+	}
+	return input.DefaultLevel, nil
+}
+
+// levelRanks orders levels from least to most severe.
+var levelRanks = map[string]int{"info": 0, "warning": 1, "error": 2, "critical": 3}
+
+// belowNotifyMinLevel reports whether level is less severe than minLevel,
+// meaning the add should not be delivered. An empty minLevel delivers all.
+func belowNotifyMinLevel(level, minLevel string) bool {
+	if minLevel == "" {
+		return false
+	}
+	return levelRanks[level] < levelRanks[minLevel]
+}
+
 // ValidateAddMessage checks message length and emptiness.
 func ValidateAddMessage(message string) error {
 	if len(message) > 1000 {
@@ -2,8 +2,11 @@ package app
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
 )
 
 type fakeAddClient struct {
@@ -181,6 +184,22 @@ func TestAddUseCaseExecuteSkipsTmuxForNoAssociateAndWrapsError(t *testing.T) {
 	}
 }
 
+func TestAddUseCaseExecuteTreatsSuppressedAddAsSuccess(t *testing.T) {
+	client := &fakeAddClient{addErr: fmt.Errorf("add tray item: %w", domain.ErrSuppressedAfterDismiss)}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:        []string{"hello"},
+		NoAssociate: true,
+	})
+	if err != nil {
+		t.Fatalf("expected suppressed add to succeed, got %v", err)
+	}
+	if !client.addCalled {
+		t.Fatalf("expected add to be attempted")
+	}
+}
+
 func TestAddUseCaseExecuteUsesExplicitLevel(t *testing.T) {
 	client := &fakeAddClient{ensureTmuxRunningResult: true}
 	useCase := NewAddUseCase(client)
@@ -196,3 +215,203 @@ func TestAddUseCaseExecuteUsesExplicitLevel(t *testing.T) {
 		t.Fatalf("expected warning level, got %q", client.captured.level)
 	}
 }
+
+func TestAddUseCaseExecuteUsesConfiguredDefaultLevel(t *testing.T) {
+	client := &fakeAddClient{ensureTmuxRunningResult: true}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:         []string{"hello"},
+		DefaultLevel: "warning",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.captured.level != "warning" {
+		t.Fatalf("expected configured default level warning, got %q", client.captured.level)
+	}
+}
+
+func TestAddUseCaseExecuteStrictLevelRejectsEmptyLevel(t *testing.T) {
+	client := &fakeAddClient{ensureTmuxRunningResult: true}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:         []string{"hello"},
+		StrictLevel:  true,
+		DefaultLevel: "warning",
+	})
+	if err == nil || !strings.Contains(err.Error(), "level is required") {
+		t.Fatalf("expected strict level error, got %v", err)
+	}
+	if client.addCalled {
+		t.Fatalf("expected AddTrayItem not to be called")
+	}
+}
+
+// fakeOptionsAddClient records the options an add was stored with.
+type fakeOptionsAddClient struct {
+	fakeAddClient
+	optionsCalled bool
+	opts          domain.AddOptions
+}
+
+func (f *fakeOptionsAddClient) AddTrayItemWithOptions(item, session, window, pane, paneCreated string, noAssociate bool, level string, opts domain.AddOptions) (string, error) {
+	f.optionsCalled = true
+	f.opts = opts
+	f.captured.level = level
+	return "", f.addErr
+}
+
+func TestAddUseCaseExecuteGatesDeliveryByNotifyMinLevel(t *testing.T) {
+	tests := []struct {
+		level      string
+		wantSilent bool
+	}{
+		{"info", true},
+		{"warning", false},
+		{"error", false},
+		{"critical", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+			useCase := NewAddUseCase(client)
+
+			err := useCase.Execute(AddInput{
+				Args:           []string{"hello"},
+				Level:          tt.level,
+				NotifyMinLevel: "warning",
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if client.opts.Silent != tt.wantSilent || client.addCalled == tt.wantSilent {
+				t.Fatalf("level %s: silent=%v delivered=%v, want silent=%v", tt.level, client.opts.Silent, client.addCalled, tt.wantSilent)
+			}
+			if client.captured.level != tt.level {
+				t.Fatalf("expected level %s to be stored, got %q", tt.level, client.captured.level)
+			}
+		})
+	}
+}
+
+func TestAddUseCaseExecuteDeliversEveryLevelWithoutNotifyMinLevel(t *testing.T) {
+	client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:           []string{"hello"},
+		Level:          "info",
+		NotifyMinLevel: "",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.optionsCalled || !client.addCalled {
+		t.Fatalf("expected info add to be delivered when notify_min_level is unset")
+	}
+}
+
+func TestAddUseCaseExecutePassesOptions(t *testing.T) {
+	client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:           []string{"deploy failed"},
+		Level:          "info",
+		NotifyMinLevel: "warning",
+		Options:        domain.AddOptions{Color: "#ff8800", Priority: 5, Tags: []string{"deploy"}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := domain.AddOptions{Color: "#ff8800", Priority: 5, Tags: []string{"deploy"}, Silent: true}
+	if !client.optionsCalled || client.addCalled {
+		t.Fatalf("expected the add to go through AddTrayItemWithOptions")
+	}
+	if fmt.Sprint(client.opts) != fmt.Sprint(want) {
+		t.Fatalf("expected options %+v, got %+v", want, client.opts)
+	}
+}
+
+func TestAddUseCaseExecuteRejectsOptionsWithoutSupport(t *testing.T) {
+	client := &fakeAddClient{ensureTmuxRunningResult: true}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:    []string{"deploy failed"},
+		Level:   "info",
+		Options: domain.AddOptions{Priority: 5},
+	})
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("expected unsupported options error, got %v", err)
+	}
+	if client.addCalled {
+		t.Fatalf("expected AddTrayItem not to be called")
+	}
+}
+
+func TestAddUseCaseExecuteRoutesEachLevelToItsChannels(t *testing.T) {
+	routing := map[string][]string{
+		"info":     {"none"},
+		"warning":  {"sound"},
+		"error":    {"sound", "desktop"},
+		"critical": {"sound", "desktop", "webhook"},
+	}
+	for level, want := range map[string]string{
+		"info":     "",
+		"warning":  "sound",
+		"error":    "sound,desktop",
+		"critical": "sound,desktop,webhook",
+	} {
+		t.Run(level, func(t *testing.T) {
+			client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+			useCase := NewAddUseCase(client)
+
+			err := useCase.Execute(AddInput{Args: []string{"hello"}, Level: level, LevelRouting: routing})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if client.opts.Channels == nil {
+				t.Fatalf("expected routed channels, got nil")
+			}
+			if got := strings.Join(client.opts.Channels, ","); got != want {
+				t.Fatalf("level %s: expected channels %q, got %q", level, want, got)
+			}
+		})
+	}
+}
+
+func TestAddUseCaseExecuteBelowNotifyMinLevelClearsRoutedChannels(t *testing.T) {
+	client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+	useCase := NewAddUseCase(client)
+
+	err := useCase.Execute(AddInput{
+		Args:           []string{"hello"},
+		Level:          "info",
+		NotifyMinLevel: "warning",
+		LevelRouting:   map[string][]string{"info": {"sound", "desktop"}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !client.opts.Silent {
+		t.Fatalf("expected a silent add below notify_min_level")
+	}
+	if client.opts.Channels == nil || len(client.opts.Channels) != 0 {
+		t.Fatalf("expected empty channels so pre-add delivery hooks skip, got %#v", client.opts.Channels)
+	}
+}
+
+func TestAddUseCaseExecuteLeavesChannelsUnsetWithoutRouting(t *testing.T) {
+	client := &fakeOptionsAddClient{fakeAddClient: fakeAddClient{ensureTmuxRunningResult: true}}
+	useCase := NewAddUseCase(client)
+
+	if err := useCase.Execute(AddInput{Args: []string{"hello"}, Level: "info"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if client.optionsCalled || !client.addCalled {
+		t.Fatalf("expected a plain add, which delivers on every channel")
+	}
+}
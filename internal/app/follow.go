@@ -6,7 +6,6 @@ import (
 	"io"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
@@ -91,7 +90,7 @@ func (u *FollowUseCase) handleFollowTick(opts FollowOptions, seen map[int]bool)
 		return
 	}
 
-	notifications := parseFollowNotifications(lines)
+	notifications := notification.ParseNotifications(lines)
 	printNewFollowNotifications(notifications, seen, opts.Output)
 }
 
@@ -106,24 +105,6 @@ func (u *FollowUseCase) fetchFollowNotifications(opts FollowOptions) string {
 	return ""
 }
 
-func parseFollowNotifications(lines string) []notification.Notification {
-	notifications := make([]notification.Notification, 0)
-	for _, line := range strings.Split(lines, "\n") {
-		if line == "" {
-			continue
-		}
-
-		notif, err := notification.ParseNotification(line)
-		if err != nil {
-			continue
-		}
-
-		notifications = append(notifications, notif)
-	}
-
-	return notifications
-}
-
 func printNewFollowNotifications(notifications []notification.Notification, seen map[int]bool, output io.Writer) {
 	for _, notif := range notifications {
 		if seen[notif.ID] {
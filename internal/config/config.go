@@ -87,6 +87,8 @@ func setDefaults() {
 	setDefault("storage_backend", "sqlite")
 	setDefault("hooks_dir", hooksDir)
 	setDefault("auto_cleanup_days", "30")
+	setDefault("auto_dismiss_stale_days", "0")
+	setDefault("auto_dismiss_lower_levels", "false")
 	setDefault("debug", "false")
 	setDefault("quiet", "false")
 	setDefault("logging_enabled", "false")
@@ -94,6 +96,15 @@ func setDefaults() {
 	setDefault("logging_max_files", "10")
 	setDefault("log_file", "")
 	setDefault("recents_time_window", "1h")
+	setDefault("escalate_after", "")
+	setDefault("max_message_length", "0")
+	setDefault("keep_full_message", "false")
+	setDefault("max_active", "0")
+	setDefault("capture_pane_context", "false")
+	setDefault("capture_pane_lines", "10")
+	setDefault("tmux_option_retries", "3")
+	setDefault("tmux_option_retry_interval", "20ms")
+	setDefault("status_zero_grace", "0s")
 	setDedupDefaults()
 }
 
@@ -28,6 +28,8 @@ func TestDefaultConfig(t *testing.T) {
 
 	// Check some default values.
 	require.Equal(t, "30", Get("auto_cleanup_days", ""))
+	require.Equal(t, "0", Get("auto_dismiss_stale_days", ""))
+	require.Equal(t, "false", Get("auto_dismiss_lower_levels", ""))
 	require.Equal(t, "sqlite", Get("storage_backend", ""))
 	require.Equal(t, "message", Get("dedup.criteria", ""))
 	require.Equal(t, "", Get("dedup.window", ""))
@@ -435,6 +437,11 @@ func TestInitValidators(t *testing.T) {
 	reset()
 	// Reinitialize validators (init() already ran, but we can test the registry)
 	require.NotNil(t, getValidator("auto_cleanup_days"))
+	require.NotNil(t, getValidator("auto_dismiss_stale_days"))
+	require.NotNil(t, getValidator("auto_dismiss_lower_levels"))
+	require.NotNil(t, getValidator("tmux_option_retries"))
+	require.NotNil(t, getValidator("tmux_option_retry_interval"))
+	require.NotNil(t, getValidator("status_zero_grace"))
 
 	// Enum validators (1 key)
 	require.NotNil(t, getValidator("storage_backend"))
@@ -495,6 +502,16 @@ func TestPositiveIntValidatorFloat(t *testing.T) {
 	require.Equal(t, "100", result)
 }
 
+// TestNonNegativeIntValidator tests that zero is accepted and negatives are rejected.
+func TestNonNegativeIntValidator(t *testing.T) {
+	validator := NonNegativeIntValidator()
+	for input, want := range map[string]string{"": "10", "0": "0", "42": "42", "-1": "10", "abc": "10"} {
+		result, err := validator("test_key", input, "10")
+		require.NoError(t, err)
+		require.Equal(t, want, result, "input %q", input)
+	}
+}
+
 // TestEnumValidatorEmpty tests that empty value returns default.
 func TestEnumValidatorEmpty(t *testing.T) {
 	validator := EnumValidator(map[string]bool{"option1": true, "option2": true})
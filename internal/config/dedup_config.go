@@ -4,6 +4,8 @@ package config
 func setDedupDefaults() {
 	setDefault("dedup.criteria", "message")
 	setDefault("dedup.window", "")
+	setDefault("dedup.suppress_after_dismiss", "")
+	setDefault("dedup.collapse_window", "")
 }
 
 // registerDedupValidators registers validators for deduplication settings.
@@ -15,4 +17,6 @@ func registerDedupValidators() {
 		"exact":          true,
 	}))
 	RegisterValidator("dedup.window", DurationValidator(true))
+	RegisterValidator("dedup.suppress_after_dismiss", DurationValidator(true))
+	RegisterValidator("dedup.collapse_window", DurationValidator(true))
 }
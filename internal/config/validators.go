@@ -59,6 +59,22 @@ func PositiveIntValidator() Validator {
 	}
 }
 
+// NonNegativeIntValidator returns a validator that ensures a value is an integer >= 0.
+// Zero is typically used to disable a limit.
+func NonNegativeIntValidator() Validator {
+	return func(key, value, defaultValue string) (string, error) {
+		if value == "" {
+			return defaultValue, nil
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			colors.Warning(fmt.Sprintf("invalid %s value '%s': must be a non-negative integer, using default: %s", key, value, defaultValue))
+			return defaultValue, nil
+		}
+		return value, nil
+	}
+}
+
 // EnumValidator returns a validator that ensures a value is one of the allowed enum values.
 func EnumValidator(allowed map[string]bool) Validator {
 	return func(key, value, defaultValue string) (string, error) {
@@ -114,6 +130,8 @@ func initValidators() {
 	// Positive integer validators (1 key)
 	positiveIntValidator := PositiveIntValidator()
 	RegisterValidator("auto_cleanup_days", positiveIntValidator)
+	// Stale active notifications are dismissed during cleanup; 0 disables it
+	RegisterValidator("auto_dismiss_stale_days", NonNegativeIntValidator())
 
 	// Enum validators (1 key)
 	RegisterValidator("storage_backend", EnumValidator(map[string]bool{"sqlite": true}))
@@ -146,6 +164,30 @@ func initValidators() {
 		"24h": true,
 	}))
 
+	// Escalation of unread criticals; empty disables it
+	RegisterValidator("escalate_after", DurationValidator(true))
+
+	// Message length cap; 0 disables truncation
+	RegisterValidator("max_message_length", NonNegativeIntValidator())
+	RegisterValidator("keep_full_message", boolValidator)
+
+	// Active notification cap; the oldest are dismissed first, 0 disables it
+	RegisterValidator("max_active", NonNegativeIntValidator())
+
+	// A new notification dismisses lower levels already active for its pane
+	RegisterValidator("auto_dismiss_lower_levels", boolValidator)
+
+	// Pane output snapshot on add; off by default because it stores terminal contents
+	RegisterValidator("capture_pane_context", boolValidator)
+	RegisterValidator("capture_pane_lines", PositiveIntValidator())
+
+	// Retries for tmux status option writes on busy servers
+	RegisterValidator("tmux_option_retries", PositiveIntValidator())
+	RegisterValidator("tmux_option_retry_interval", DurationValidator(false))
+
+	// Delay before a zero active count reaches the tmux status
+	RegisterValidator("status_zero_grace", DurationValidator(false))
+
 	registerDedupValidators()
 }
 
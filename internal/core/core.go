@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/config"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/notification"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
@@ -49,14 +52,7 @@ func (c *Core) GetTrayItems(stateFilter string) (string, error) {
 		return "", nil
 	}
 	var messages []string
-	for _, line := range strings.Split(lines, "\n") {
-		if line == "" {
-			continue
-		}
-		notif, err := notification.ParseNotification(line)
-		if err != nil {
-			continue
-		}
+	for _, notif := range notification.ParseNotifications(lines) {
 		messages = append(messages, notif.Message)
 	}
 	return strings.Join(messages, "\n"), nil
@@ -66,6 +62,19 @@ func (c *Core) GetTrayItems(stateFilter string) (string, error) {
 // If session, window, pane are empty and noAuto is false, current tmux context is used.
 // Returns the notification ID or an error if validation fails.
 func (c *Core) AddTrayItem(item, session, window, pane, paneCreated string, noAuto bool, level string) (string, error) {
+	return c.AddTrayItemWithOptions(item, session, window, pane, paneCreated, noAuto, level, domain.AddOptions{})
+}
+
+// AddTrayItemSilently adds a tray item like AddTrayItem but skips desktop and
+// sound delivery. Backends without silent adds deliver as usual.
+func (c *Core) AddTrayItemSilently(item, session, window, pane, paneCreated string, noAuto bool, level string) (string, error) {
+	return c.AddTrayItemWithOptions(item, session, window, pane, paneCreated, noAuto, level, domain.AddOptions{Silent: true, Channels: []string{}})
+}
+
+// AddTrayItemWithOptions adds a tray item like AddTrayItem, storing the color,
+// priority and tags in opts and skipping delivery when opts.Silent is set.
+// Backends that cannot store options fail the add when opts sets any.
+func (c *Core) AddTrayItemWithOptions(item, session, window, pane, paneCreated string, noAuto bool, level string, opts domain.AddOptions) (string, error) {
 	// Treat empty/whitespace context same as not provided for resilience
 	item = strings.TrimSpace(item)
 	if item == "" {
@@ -89,20 +98,121 @@ func (c *Core) AddTrayItem(item, session, window, pane, paneCreated string, noAu
 		}
 	}
 
+	if c.suppressedAfterDismiss(item, session, window, pane, level) {
+		return "", fmt.Errorf("add tray item: %w", domain.ErrSuppressedAfterDismiss)
+	}
+
 	// Add notification with empty timestamp (auto-generated)
-	id, err := c.storage.AddNotification(item, "", session, window, pane, paneCreated, level)
+	id, err := c.addNotification(item, "", session, window, pane, paneCreated, level, opts)
 	if err != nil {
 		return "", fmt.Errorf("add tray item: failed to add notification: %w", err)
 	}
+	c.capturePaneContext(id, pane)
 	return id, nil
 }
 
+// optionsAddStore is implemented by storage backends that can store add
+// options with a notification and report the notifications evicted by the
+// max_active cap.
+type optionsAddStore interface {
+	AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (domain.AddNotificationResult, error)
+}
+
+// addNotification adds a notification with opts and logs the IDs of any active
+// notifications dismissed to stay within max_active. Backends without options
+// add as usual, unless opts sets a color, priority or tags.
+func (c *Core) addNotification(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (string, error) {
+	store, ok := c.storage.(optionsAddStore)
+	if !ok {
+		if opts.HasFields() {
+			return "", errors.New("storage does not support color, priority or tags")
+		}
+		return c.storage.AddNotification(message, timestamp, session, window, pane, paneCreated, level)
+	}
+	result, err := store.AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level, opts)
+	if len(result.Evicted) > 0 {
+		colors.Debug(fmt.Sprintf("add tray item: max_active reached, dismissed %s", strings.Join(result.Evicted, ", ")))
+	}
+	return result.ID, err
+}
+
+// dismissSuppressionStore is implemented by storage backends that can tell
+// whether a notification was dismissed recently.
+type dismissSuppressionStore interface {
+	RecentlyDismissed(message, session, window, pane, level string, within time.Duration) (bool, error)
+}
+
+// suppressedAfterDismiss reports whether an add should be dropped because the
+// same notification, by dedup key, was dismissed within
+// dedup.suppress_after_dismiss. Lookup failures never drop the add.
+func (c *Core) suppressedAfterDismiss(message, session, window, pane, level string) bool {
+	config.Load()
+	within := config.GetDuration("dedup.suppress_after_dismiss", 0)
+	if within <= 0 {
+		return false
+	}
+	store, ok := c.storage.(dismissSuppressionStore)
+	if !ok {
+		return false
+	}
+	suppressed, err := store.RecentlyDismissed(message, session, window, pane, level, within)
+	if err != nil {
+		colors.Debug(fmt.Sprintf("add tray item: failed to check recent dismissals: %v", err))
+		return false
+	}
+	return suppressed
+}
+
+// paneContextStore is implemented by storage backends that can keep a snapshot
+// of pane output alongside a notification.
+type paneContextStore interface {
+	SetPaneContext(id, content string) error
+}
+
+// capturePaneContext stores the recent output of pane with the notification when
+// capture_pane_context is enabled. Capture failures never fail the add.
+func (c *Core) capturePaneContext(id, pane string) {
+	if pane == "" {
+		return
+	}
+	config.Load()
+	if !config.GetBool("capture_pane_context", false) {
+		return
+	}
+	store, ok := c.storage.(paneContextStore)
+	if !ok {
+		return
+	}
+	content, err := c.client.CapturePane(pane, config.GetInt("capture_pane_lines", 10))
+	if err != nil {
+		colors.Debug(fmt.Sprintf("add tray item: failed to capture pane %s: %v", pane, err))
+		return
+	}
+	if content == "" {
+		return
+	}
+	if err := store.SetPaneContext(id, content); err != nil {
+		colors.Debug(fmt.Sprintf("add tray item: failed to store pane context: %v", err))
+	}
+}
+
 // AddTrayItem adds a tray item using the default core instance.
 // Returns the notification ID or an error if validation fails.
 func AddTrayItem(item, session, window, pane, paneCreated string, noAuto bool, level string) (string, error) {
 	return defaultCore.AddTrayItem(item, session, window, pane, paneCreated, noAuto, level)
 }
 
+// AddTrayItemSilently adds a tray item without delivery using the default client.
+func AddTrayItemSilently(item, session, window, pane, paneCreated string, noAuto bool, level string) (string, error) {
+	return defaultCore.AddTrayItemSilently(item, session, window, pane, paneCreated, noAuto, level)
+}
+
+// AddTrayItemWithOptions adds a tray item with add options using the default
+// core instance.
+func AddTrayItemWithOptions(item, session, window, pane, paneCreated string, noAuto bool, level string, opts domain.AddOptions) (string, error) {
+	return defaultCore.AddTrayItemWithOptions(item, session, window, pane, paneCreated, noAuto, level, opts)
+}
+
 // ClearTrayItems dismisses all active tray items.
 func ClearTrayItems() error {
 	return defaultCore.ClearTrayItems()
@@ -143,6 +253,46 @@ func (c *Core) MarkNotificationUnread(id string) error {
 	return c.storage.MarkNotificationUnread(id)
 }
 
+// MuteSession mutes notifications for a session.
+func MuteSession(session string) error {
+	return defaultCore.MuteSession(session)
+}
+
+// MuteSession mutes notifications for a session using this Core instance.
+func (c *Core) MuteSession(session string) error {
+	return c.storage.MuteSession(session)
+}
+
+// UnmuteSession restores notifications for a session.
+func UnmuteSession(session string) error {
+	return defaultCore.UnmuteSession(session)
+}
+
+// UnmuteSession restores notifications for a session using this Core instance.
+func (c *Core) UnmuteSession(session string) error {
+	return c.storage.UnmuteSession(session)
+}
+
+// ListMutedSessions returns the muted sessions.
+func ListMutedSessions() ([]string, error) {
+	return defaultCore.ListMutedSessions()
+}
+
+// ListMutedSessions returns the muted sessions using this Core instance.
+func (c *Core) ListMutedSessions() ([]string, error) {
+	return c.storage.ListMutedSessions()
+}
+
+// EscalateStale escalates critical notifications left unread past the configured threshold.
+func EscalateStale() (int, error) {
+	return defaultCore.EscalateStale()
+}
+
+// EscalateStale escalates stale unread critical notifications using this Core instance.
+func (c *Core) EscalateStale() (int, error) {
+	return c.storage.EscalateStale()
+}
+
 // GetNotificationByID retrieves a notification by its ID.
 func GetNotificationByID(id string) (string, error) {
 	return defaultCore.GetNotificationByID(id)
@@ -153,6 +303,39 @@ func (c *Core) GetNotificationByID(id string) (string, error) {
 	return c.storage.GetNotificationByID(id)
 }
 
+// fullMessageStore is implemented by storage backends that keep the full text
+// of messages truncated at max_message_length.
+type fullMessageStore interface {
+	GetFullMessage(id string) (string, error)
+}
+
+// GetFullMessage returns the untruncated message of a notification using this
+// Core instance. It is the stored message unless keep_full_message preserved
+// a longer original.
+func (c *Core) GetFullMessage(id string) (string, error) {
+	store, ok := c.storage.(fullMessageStore)
+	if !ok {
+		return "", fmt.Errorf("full message: storage does not support full messages")
+	}
+	return store.GetFullMessage(id)
+}
+
+// paneContextReader is implemented by storage backends that return the pane
+// output captured with a notification.
+type paneContextReader interface {
+	GetPaneContext(id string) (string, error)
+}
+
+// GetPaneContext returns the pane output captured when a notification was
+// added, using this Core instance. It is empty when nothing was captured.
+func (c *Core) GetPaneContext(id string) (string, error) {
+	store, ok := c.storage.(paneContextReader)
+	if !ok {
+		return "", fmt.Errorf("pane context: storage does not support pane context")
+	}
+	return store.GetPaneContext(id)
+}
+
 // GetActiveCount returns the number of active notifications.
 func GetActiveCount() int {
 	return defaultCore.GetActiveCount()
@@ -210,14 +393,7 @@ func (c *Core) ListDomainNotifications(stateFilter, levelFilter, sessionFilter,
 	}
 
 	var notifications []*domain.Notification
-	for _, line := range strings.Split(lines, "\n") {
-		if line == "" {
-			continue
-		}
-		notif, err := notification.ParseNotification(line)
-		if err != nil {
-			continue
-		}
+	for _, notif := range notification.ParseNotifications(lines) {
 		notifications = append(notifications, notification.ToDomainUnsafe(notif))
 	}
 	return notifications, nil
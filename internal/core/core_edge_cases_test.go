@@ -39,7 +39,7 @@ func TestCore_DismissNotification(t *testing.T) {
 	t.Run("dismiss_existing_notification", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
@@ -62,7 +62,7 @@ func TestCore_DismissNotification(t *testing.T) {
 	t.Run("dismiss_nonexistent_notification", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
@@ -80,7 +80,7 @@ func TestCore_DismissAll(t *testing.T) {
 	t.Run("dismiss_all_notifications", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
@@ -110,7 +110,7 @@ func TestCore_DismissAll(t *testing.T) {
 	t.Run("dismiss_all_when_empty", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
@@ -132,7 +132,7 @@ func TestCore_GetTrayItems_EdgeCases(t *testing.T) {
 	t.Run("empty_result", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
@@ -147,7 +147,7 @@ func TestCore_GetTrayItems_EdgeCases(t *testing.T) {
 	t.Run("filter_by_dismissed_state", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		dbPath := tmpDir + "/notifications.db"
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 		require.NoError(t, err)
 		defer sqliteStorage.Close()
 
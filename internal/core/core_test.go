@@ -1,16 +1,22 @@
 package core
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
+	"github.com/cristianoliveira/tmux-intray/internal/ports"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	"github.com/cristianoliveira/tmux-intray/internal/storage"
 	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite"
 	"github.com/cristianoliveira/tmux-intray/internal/tmux"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,6 +49,17 @@ func (s *stubSettingsStore) ResetSettings() (any, error) {
 	return s.resetResult, s.resetErr
 }
 
+// claimRacingStore lists actions as they were before another drain claimed
+// them, as a drain that started at the same time would.
+type claimRacingStore struct {
+	*sqlite.SQLiteStorage
+	listed []ports.PendingAction
+}
+
+func (s *claimRacingStore) ListPendingActions() ([]ports.PendingAction, error) {
+	return s.listed, nil
+}
+
 func TestCore(t *testing.T) {
 	// Set up test environment
 	tmpDir := t.TempDir()
@@ -54,7 +71,7 @@ func TestCore(t *testing.T) {
 
 	// Create a SQLite storage instance for tests
 	dbPath := filepath.Join(tmpDir, "notifications.db")
-	sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+	sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.Options{})
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		_ = sqliteStorage.Close()
@@ -126,6 +143,78 @@ func TestCore(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 
+	t.Run("AddTrayItemCapturesPaneContext", func(t *testing.T) {
+		clearNotifications()
+		t.Setenv("TMUX_INTRAY_CAPTURE_PANE_CONTEXT", "true")
+		t.Setenv("TMUX_INTRAY_CAPTURE_PANE_LINES", "3")
+		mockClient := new(tmux.MockClient)
+		mockClient.On("CapturePane", "%7", 3).Return("go test ./...\nFAIL\nexit status 1", nil).Once()
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("tests failed", "$1", "@1", "%7", "123", true, "error")
+		require.NoError(t, err)
+		content, err := c.GetPaneContext(id)
+		require.NoError(t, err)
+		require.Equal(t, "go test ./...\nFAIL\nexit status 1", content)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("AddTrayItemSkipsPaneContextByDefault", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("tests failed", "$1", "@1", "%7", "123", true, "error")
+		require.NoError(t, err)
+		content, err := sqliteStorage.GetPaneContext(id)
+		require.NoError(t, err)
+		require.Empty(t, content)
+		mockClient.AssertNotCalled(t, "CapturePane", mock.Anything, mock.Anything)
+	})
+
+	t.Run("AddTrayItemSuppressesReaddAfterDismiss", func(t *testing.T) {
+		clearNotifications()
+		t.Setenv("TMUX_INTRAY_DEDUP__SUPPRESS_AFTER_DISMISS", "1m")
+		c := NewCore(nil, sqliteStorage)
+
+		id, err := c.AddTrayItem("nightly sync failed", "$1", "@1", "%1", "", true, "error")
+		require.NoError(t, err)
+		require.NoError(t, c.DismissNotification(id))
+
+		_, err = c.AddTrayItem("nightly sync failed", "$1", "@1", "%1", "", true, "error")
+		require.ErrorIs(t, err, domain.ErrSuppressedAfterDismiss)
+		items, _ := c.GetTrayItems("active")
+		require.NotContains(t, items, "nightly sync failed")
+
+		_, err = c.AddTrayItem("nightly sync recovered", "$1", "@1", "%1", "", true, "info")
+		require.NoError(t, err, "other notifications are still accepted")
+	})
+
+	t.Run("AddTrayItemAcceptsReaddWithoutSuppression", func(t *testing.T) {
+		clearNotifications()
+		c := NewCore(nil, sqliteStorage)
+
+		id, err := c.AddTrayItem("weekly report ready", "$1", "@1", "%1", "", true, "info")
+		require.NoError(t, err)
+		require.NoError(t, c.DismissNotification(id))
+
+		_, err = c.AddTrayItem("weekly report ready", "$1", "@1", "%1", "", true, "info")
+		require.NoError(t, err)
+	})
+
+	t.Run("AddTrayItemIgnoresCaptureFailure", func(t *testing.T) {
+		clearNotifications()
+		t.Setenv("TMUX_INTRAY_CAPTURE_PANE_CONTEXT", "true")
+		mockClient := new(tmux.MockClient)
+		mockClient.On("CapturePane", "%7", 10).Return("", errors.New("no such pane")).Once()
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("tests failed", "$1", "@1", "%7", "123", true, "error")
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+		mockClient.AssertExpectations(t)
+	})
+
 	t.Run("AddTrayItemNoAuto", func(t *testing.T) {
 		clearNotifications()
 		// tmux client will fail, but noAuto true means we don't call it
@@ -362,4 +451,223 @@ func TestCore(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, GetActiveCount())
 	})
+
+	t.Run("QueuedJumpRunsOnceTmuxIsAvailable", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("deploy failed", "$1", "@2", "%3", "", true, "error")
+		require.NoError(t, err)
+		require.NoError(t, c.QueueJump(id, false))
+
+		// tmux is still down: nothing runs and the jump stays queued.
+		mockClient.On("HasSession").Return(false, nil).Once()
+		done, err := c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 0, done)
+		mockClient.AssertNotCalled(t, "JumpToPane", mock.Anything, mock.Anything, mock.Anything)
+
+		// tmux is back: the queued jump runs and the queue is emptied.
+		mockClient.On("HasSession").Return(true, nil)
+		mockClient.On("ValidatePaneExists", "$1", "@2", "%3").Return(true, nil).Once()
+		mockClient.On("JumpToPane", "$1", "@2", "%3").Return(true, nil).Once()
+		done, err = c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 1, done)
+		mockClient.AssertExpectations(t)
+
+		line, err := c.GetNotificationByID(id)
+		require.NoError(t, err)
+		require.NotEmpty(t, strings.Split(line, "\t")[storage.FieldReadTimestamp], "queued jump marks the notification read")
+
+		done, err = c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 0, done)
+		mockClient.AssertNumberOfCalls(t, "JumpToPane", 1)
+	})
+
+	t.Run("QueuedJumpKeepsNoMarkRead", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("deploy failed", "$1", "@2", "%3", "", true, "error")
+		require.NoError(t, err)
+		require.NoError(t, c.QueueJump(id, true))
+
+		mockClient.On("HasSession").Return(true, nil)
+		mockClient.On("ValidatePaneExists", "$1", "@2", "%3").Return(true, nil).Once()
+		mockClient.On("JumpToPane", "$1", "@2", "%3").Return(true, nil).Once()
+		done, err := c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 1, done)
+
+		line, err := c.GetNotificationByID(id)
+		require.NoError(t, err)
+		require.Empty(t, strings.Split(line, "\t")[storage.FieldReadTimestamp], "--no-mark-read survives the queue")
+	})
+
+	t.Run("QueuedJumpClaimedByAnotherDrainIsSkipped", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("deploy failed", "$1", "@2", "%3", "", true, "error")
+		require.NoError(t, err)
+		require.NoError(t, c.QueueJump(id, false))
+		actions, err := sqliteStorage.ListPendingActions()
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+
+		// Another drain lists the same action and claims it first.
+		c.storage = &claimRacingStore{SQLiteStorage: sqliteStorage, listed: actions}
+		claimed, err := sqliteStorage.ClaimPendingAction(actions[0].ID)
+		require.NoError(t, err)
+		require.True(t, claimed)
+
+		mockClient.On("HasSession").Return(true, nil)
+		done, err := c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 0, done)
+		mockClient.AssertNotCalled(t, "JumpToPane", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ExpiredQueuedJumpIsDroppedUnrun", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("deploy failed", "$1", "@2", "%3", "", true, "error")
+		require.NoError(t, err)
+		require.NoError(t, c.QueueJump(id, false))
+
+		pendingNow = func() time.Time { return time.Now().Add(pendingActionTTL + time.Minute) }
+		t.Cleanup(func() { pendingNow = time.Now })
+
+		mockClient.On("HasSession").Return(true, nil)
+		done, err := c.DrainPendingActions()
+		require.NoError(t, err)
+		require.Equal(t, 0, done)
+		mockClient.AssertNotCalled(t, "JumpToPane", mock.Anything, mock.Anything, mock.Anything)
+
+		actions, err := sqliteStorage.ListPendingActions()
+		require.NoError(t, err)
+		require.Empty(t, actions, "an expired jump leaves the queue")
+	})
+
+	t.Run("SnoozedNotificationResurfacesWhenSessionActive", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("review requested", "$1", "@2", "%3", "", true, "info")
+		require.NoError(t, err)
+		require.NoError(t, c.SnoozeUntilSessionActive(id, "$1"))
+
+		list, err := c.ListNotifications("active", "", "", "", "", "", "", "")
+		require.NoError(t, err)
+		require.NotContains(t, list, "review requested")
+
+		// The session exists but no client is attached: it stays hidden.
+		mockClient.On("HasSession").Return(true, nil)
+		mockClient.On("ListAttachedSessions").Return(map[string]string{"$4": "other"}, nil).Once()
+		woken, err := c.WakeSnoozedNotifications()
+		require.NoError(t, err)
+		require.Equal(t, 0, woken)
+
+		// A client attaches to the session: the sweep resurfaces it.
+		mockClient.On("ListAttachedSessions").Return(map[string]string{"$1": "work"}, nil).Once()
+		woken, err = c.WakeSnoozedNotifications()
+		require.NoError(t, err)
+		require.Equal(t, 1, woken)
+		mockClient.AssertExpectations(t)
+
+		list, err = c.ListNotifications("active", "", "", "", "", "", "", "")
+		require.NoError(t, err)
+		require.Contains(t, list, "review requested")
+
+		// With nothing snoozed, the sweep does not query tmux.
+		woken, err = c.WakeSnoozedNotifications()
+		require.NoError(t, err)
+		require.Equal(t, 0, woken)
+		mockClient.AssertNumberOfCalls(t, "ListAttachedSessions", 2)
+	})
+
+	t.Run("SnoozeBySessionName", func(t *testing.T) {
+		clearNotifications()
+		mockClient := new(tmux.MockClient)
+		c := NewCore(mockClient, sqliteStorage)
+
+		id, err := c.AddTrayItem("tests passed", "$1", "@2", "%3", "", true, "info")
+		require.NoError(t, err)
+		require.NoError(t, c.SnoozeUntilSessionActive(id, "work"))
+
+		mockClient.On("HasSession").Return(true, nil)
+		mockClient.On("ListAttachedSessions").Return(map[string]string{"$1": "work"}, nil).Once()
+		woken, err := c.WakeSnoozedNotifications()
+		require.NoError(t, err)
+		require.Equal(t, 1, woken)
+	})
+}
+
+func TestStorageChangesReachEventStream(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+	stor, err := sqlite.NewSQLiteStorage(filepath.Join(stateDir, "notifications.db"), sqlite.Options{EventsPath: events.Path()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = stor.Close() })
+	c := NewCore(nil, stor)
+
+	first, err := c.AddTrayItem("build failed", "$1", "@2", "%3", "", true, "error")
+	require.NoError(t, err)
+	second, err := c.AddTrayItem("tests passed", "$1", "@2", "%4", "", true, "info")
+	require.NoError(t, err)
+	require.NoError(t, c.MarkNotificationRead(second))
+	require.NoError(t, c.DismissNotification(first))
+
+	f, err := os.Open(events.Path())
+	require.NoError(t, err)
+	defer f.Close()
+	recorded, err := events.Read(f)
+	require.NoError(t, err)
+
+	var got []string
+	for _, event := range recorded {
+		got = append(got, event.Type+":"+event.NotificationID)
+	}
+	require.Equal(t, []string{"add:" + first, "add:" + second, "read:" + second, "dismiss:" + first}, got)
+	require.Equal(t, events.Event{
+		Type:           events.TypeAdd,
+		Timestamp:      recorded[0].Timestamp,
+		NotificationID: first,
+		Session:        "$1",
+		Window:         "@2",
+		Pane:           "%3",
+		Message:        "build failed",
+		Level:          "error",
+	}, recorded[0])
+}
+
+func TestGetFullMessageReturnsKeptOriginal(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+	options := sqlite.Options{MaxMessageLength: 5, KeepFullMessage: true}
+	stor, err := sqlite.NewSQLiteStorage(filepath.Join(stateDir, "notifications.db"), options)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = stor.Close() })
+	c := NewCore(nil, stor)
+
+	id, err := c.AddTrayItem("build failed", "$1", "@2", "%3", "", true, "error")
+	require.NoError(t, err)
+
+	line, err := c.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Contains(t, line, "\tbuild…\t")
+
+	full, err := c.GetFullMessage(id)
+	require.NoError(t, err)
+	require.Equal(t, "build failed", full)
 }
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/ports"
+)
+
+// pendingActionJump is the queued action that jumps to a notification's pane
+// and marks it read. pendingActionJumpNoMarkRead jumps without marking it
+// read, for jump --no-mark-read.
+const (
+	pendingActionJump           = "jump"
+	pendingActionJumpNoMarkRead = "jump-no-mark-read"
+)
+
+// pendingActionTTL is how long a queued action stays worth running. Older
+// actions are dropped unrun, since switching the client long after the request
+// would surprise the user.
+const pendingActionTTL = 10 * time.Minute
+
+// pendingNow is the clock used to expire queued actions; tests replace it.
+var pendingNow = time.Now
+
+// pendingActionStore is implemented by storage backends that can hold actions
+// requested while tmux was not running.
+type pendingActionStore interface {
+	QueuePendingAction(action, id string) error
+	ListPendingActions() ([]ports.PendingAction, error)
+	ClaimPendingAction(actionID int64) (bool, error)
+}
+
+// QueueJump records a jump to the notification with id, to run once tmux is
+// available again. With noMarkRead the jump leaves the notification unread.
+func (c *Core) QueueJump(id string, noMarkRead bool) error {
+	store, ok := c.storage.(pendingActionStore)
+	if !ok {
+		return fmt.Errorf("queue jump: storage does not support pending actions")
+	}
+	action := pendingActionJump
+	if noMarkRead {
+		action = pendingActionJumpNoMarkRead
+	}
+	if err := store.QueuePendingAction(action, id); err != nil {
+		return fmt.Errorf("queue jump: %w", err)
+	}
+	return nil
+}
+
+// QueueJump records a jump using the default client.
+func QueueJump(id string, noMarkRead bool) error {
+	return defaultCore.QueueJump(id, noMarkRead)
+}
+
+// DrainPendingActions runs queued jumps, oldest first, when tmux is running.
+// Each action is claimed, which removes it, before it runs: a broken target
+// cannot block the queue, and a concurrent drain that loses the claim skips
+// the action instead of running it again. Actions queued more than
+// pendingActionTTL ago are removed without running. It returns the number of
+// jumps that succeeded.
+func (c *Core) DrainPendingActions() (int, error) {
+	store, ok := c.storage.(pendingActionStore)
+	if !ok {
+		return 0, nil
+	}
+	actions, err := store.ListPendingActions()
+	if err != nil {
+		return 0, fmt.Errorf("drain pending actions: %w", err)
+	}
+	if len(actions) == 0 || !c.EnsureTmuxRunning() {
+		return 0, nil
+	}
+
+	jumps := NewJumpServiceWithDeps(c.client, c.storage)
+	cutoff := pendingNow().Add(-pendingActionTTL)
+	done := 0
+	for _, action := range actions {
+		claimed, err := store.ClaimPendingAction(action.ID)
+		if err != nil {
+			return done, fmt.Errorf("drain pending actions: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+		if pendingActionExpired(action, cutoff) {
+			colors.Debug(fmt.Sprintf("drain pending actions: dropped %s to %s queued at %s", action.Action, action.NotificationID, action.QueuedAt))
+			continue
+		}
+		switch action.Action {
+		case pendingActionJump, pendingActionJumpNoMarkRead:
+			if c.runQueuedJump(jumps, action.NotificationID, action.Action == pendingActionJump) {
+				done++
+			}
+		}
+	}
+	return done, nil
+}
+
+// DrainPendingActions runs queued actions using the default client.
+func DrainPendingActions() (int, error) {
+	return defaultCore.DrainPendingActions()
+}
+
+// pendingActionExpired reports whether action was queued before cutoff. An
+// unreadable queue time counts as expired.
+func pendingActionExpired(action ports.PendingAction, cutoff time.Time) bool {
+	queuedAt, err := time.Parse(time.RFC3339, action.QueuedAt)
+	return err != nil || queuedAt.Before(cutoff)
+}
+
+func (c *Core) runQueuedJump(jumps *JumpService, id string, markRead bool) bool {
+	result, err := jumps.JumpToNotification(id)
+	if err != nil {
+		colors.Debug(fmt.Sprintf("drain pending actions: jump to %s failed: %v", id, err))
+		return false
+	}
+	if !result.Success {
+		colors.Debug(fmt.Sprintf("drain pending actions: jump to %s skipped: %s", id, result.Message))
+		return false
+	}
+	if err := c.RecordJumpEvent(id, result.Session, result.Window, result.Pane); err != nil {
+		colors.Debug(fmt.Sprintf("drain pending actions: failed to record jump event: %v", err))
+	}
+	if !markRead {
+		return true
+	}
+	if err := c.MarkNotificationRead(id); err != nil {
+		colors.Debug(fmt.Sprintf("drain pending actions: failed to mark %s read: %v", id, err))
+	}
+	return true
+}
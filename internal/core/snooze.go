@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/ports"
+)
+
+// sessionSnoozeStore is implemented by storage backends that can hide
+// notifications until a tmux session becomes active.
+type sessionSnoozeStore interface {
+	SnoozeUntilSessionActive(id, session string) error
+	ListSessionSnoozes() ([]ports.SessionSnooze, error)
+	WakeSessionSnooze(id string) error
+}
+
+// SnoozeUntilSessionActive hides the notification with id until session has
+// an attached client. session may be a session ID ("$1") or a session name.
+func (c *Core) SnoozeUntilSessionActive(id, session string) error {
+	store, ok := c.storage.(sessionSnoozeStore)
+	if !ok {
+		return fmt.Errorf("snooze until session active: storage does not support session snoozes")
+	}
+	if err := store.SnoozeUntilSessionActive(id, session); err != nil {
+		return fmt.Errorf("snooze until session active: %w", err)
+	}
+	return nil
+}
+
+// SnoozeUntilSessionActive snoozes a notification using the default client.
+func SnoozeUntilSessionActive(id, session string) error {
+	return defaultCore.SnoozeUntilSessionActive(id, session)
+}
+
+// WakeSnoozedNotifications resurfaces every notification whose snooze session
+// is now attached. It is the sweep for SnoozeUntilSessionActive and returns the
+// number of notifications that became visible again.
+func (c *Core) WakeSnoozedNotifications() (int, error) {
+	store, ok := c.storage.(sessionSnoozeStore)
+	if !ok {
+		return 0, nil
+	}
+	snoozes, err := store.ListSessionSnoozes()
+	if err != nil {
+		return 0, fmt.Errorf("wake snoozed notifications: %w", err)
+	}
+	if len(snoozes) == 0 || !c.EnsureTmuxRunning() {
+		return 0, nil
+	}
+
+	attached, err := c.client.ListAttachedSessions()
+	if err != nil {
+		return 0, fmt.Errorf("wake snoozed notifications: %w", err)
+	}
+	woken := 0
+	for _, snooze := range snoozes {
+		if !sessionAttached(attached, snooze.Session) {
+			continue
+		}
+		if err := store.WakeSessionSnooze(snooze.NotificationID); err != nil {
+			return woken, fmt.Errorf("wake snoozed notifications: %w", err)
+		}
+		woken++
+	}
+	return woken, nil
+}
+
+// WakeSnoozedNotifications runs the snooze sweep using the default client.
+func WakeSnoozedNotifications() (int, error) {
+	return defaultCore.WakeSnoozedNotifications()
+}
+
+// sessionAttached reports whether session, given by ID or name, is in attached.
+func sessionAttached(attached map[string]string, session string) bool {
+	if _, ok := attached[session]; ok {
+		return true
+	}
+	for _, name := range attached {
+		if name == session {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cristianoliveira/tmux-intray/internal/errors"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
 	"github.com/cristianoliveira/tmux-intray/internal/ports"
 	"github.com/cristianoliveira/tmux-intray/internal/storage"
 	"github.com/cristianoliveira/tmux-intray/internal/tmux"
@@ -111,6 +112,16 @@ func JumpToPane(sessionID, windowID, paneID string) bool {
 	return defaultCore.JumpToPane(sessionID, windowID, paneID)
 }
 
+// RecordJumpEvent appends a jump event for a notification to the events.jsonl stream.
+func (c *Core) RecordJumpEvent(id, sessionID, windowID, paneID string) error {
+	return events.RecordJump(id, sessionID, windowID, paneID)
+}
+
+// RecordJumpEvent appends a jump event using the default client.
+func RecordJumpEvent(id, sessionID, windowID, paneID string) error {
+	return defaultCore.RecordJumpEvent(id, sessionID, windowID, paneID)
+}
+
 // JumpToPaneWithHandler jumps to a specific pane using the default client with a custom error handler.
 // This allows TUI and other UIs to handle errors differently than the CLI.
 func JumpToPaneWithHandler(sessionID, windowID, paneID string, handler errors.ErrorHandler) bool {
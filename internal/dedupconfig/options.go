@@ -6,9 +6,15 @@ import (
 	"github.com/cristianoliveira/tmux-intray/internal/dedup"
 )
 
-// Load returns deduplication options using current configuration values.
+// Load loads the configuration and returns its deduplication options.
 func Load() dedup.Options {
 	config.Load()
+	return Current()
+}
+
+// Current returns deduplication options from the configuration already loaded,
+// without reading the config file again.
+func Current() dedup.Options {
 	criteria := dedup.ParseCriteria(config.Get("dedup.criteria", string(dedup.CriteriaMessage)))
 	window := config.GetDuration("dedup.window", 0)
 	return dedup.Options{Criteria: criteria, Window: window}
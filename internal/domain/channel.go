@@ -0,0 +1,24 @@
+// Package domain provides the domain layer for notifications.
+// It contains business logic, value objects, and domain services.
+package domain
+
+import "slices"
+
+// Delivery channel constants. Delivery itself happens in add hooks; an add
+// tells them which channels to use through $CHANNELS.
+const (
+	ChannelSound   = "sound"
+	ChannelDesktop = "desktop"
+	ChannelWebhook = "webhook"
+	// ChannelNone routes a level to no channel.
+	ChannelNone = "none"
+)
+
+// AllChannels lists every delivery channel.
+var AllChannels = []string{ChannelSound, ChannelDesktop, ChannelWebhook}
+
+// IsValidChannel reports whether name is a delivery channel. ChannelNone is
+// not a channel.
+func IsValidChannel(name string) bool {
+	return slices.Contains(AllChannels, name)
+}
@@ -4,6 +4,9 @@ package domain
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -20,6 +23,26 @@ type Notification struct {
 	PaneCreated   string
 	Level         NotificationLevel
 	ReadTimestamp string
+	// Important is a user-set curation flag; it does not affect sorting.
+	Important bool
+	// Color overrides the level color of the notification's TUI row. Empty
+	// uses the level color. See IsValidColor for accepted values.
+	Color string
+	// Owner is who claimed the notification in a shared tray. Empty means
+	// unassigned.
+	Owner string
+	// SnoozedUntil is the RFC3339 time a snoozed notification comes back.
+	// Empty means not snoozed.
+	SnoozedUntil string
+	// Priority orders notifications when sorting by priority; higher comes
+	// first. 0 is the default.
+	Priority int
+	// Tags are free-form lowercase labels such as "deploy" or "ci". Nil means
+	// untagged.
+	Tags []string
+	// Occurrences is how many adds were collapsed into the notification by
+	// dedup.collapse_window. 0 means it was added once.
+	Occurrences int
 }
 
 // NotificationState represents the state of a notification.
@@ -196,6 +219,99 @@ func NewNotification(
 	return notif, nil
 }
 
+// AddNotificationResult describes a stored notification. Evicted lists the
+// IDs of the oldest active notifications dismissed to stay within the
+// max_active cap; it is empty when the cap is off or was not reached.
+type AddNotificationResult struct {
+	ID      string
+	Evicted []string
+}
+
+// AddOptions holds what an add stores beside the notification itself. The
+// zero value adds a plain notification that is delivered as usual.
+type AddOptions struct {
+	// Color draws the TUI row in this color instead of the level color: an
+	// ANSI color number (0-255) or a hex color (#rgb or #rrggbb).
+	Color string
+	// Priority orders notifications when the TUI sorts by priority, highest
+	// first. 0 is the default.
+	Priority int
+	// Tags label the notification. They are lowercased and deduplicated, and
+	// cannot contain commas or whitespace.
+	Tags []string
+	// Silent skips the post-add hooks. Pre-add hooks still run, so a silent
+	// add should also pass empty Channels for delivery hooks to skip it.
+	Silent bool
+	// Channels lists the delivery channels add hooks should use, passed to
+	// them as $CHANNELS. Nil means every channel; empty means none.
+	Channels []string
+}
+
+// HasFields reports whether o stores a color, priority or tags.
+func (o AddOptions) HasFields() bool {
+	return o.Color != "" || o.Priority != 0 || len(o.Tags) > 0
+}
+
+// IsValidColor reports whether color is a usable row color: an ANSI color
+// number (0-255) or a hex color (#rgb or #rrggbb).
+func IsValidColor(color string) bool {
+	if strings.HasPrefix(color, "#") {
+		hex := color[1:]
+		if len(hex) != 3 && len(hex) != 6 {
+			return false
+		}
+		_, err := strconv.ParseUint(hex, 16, 32)
+		return err == nil
+	}
+	n, err := strconv.Atoi(color)
+	return err == nil && n >= 0 && n <= 255 && strconv.Itoa(n) == color
+}
+
+// NormalizeTags trims and lowercases tags, drops empty and repeated ones, and
+// sorts the rest. A tag cannot contain a comma or whitespace, since tags are
+// stored as one comma-separated TSV field.
+func NormalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if strings.ContainsRune(tag, ',') || len(strings.Fields(tag)) != 1 {
+			return nil, fmt.Errorf("invalid tag %q: tags cannot contain commas or whitespace", tag)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// ParseTags splits a comma-separated tags field. An empty field has no tags.
+func ParseTags(field string) []string {
+	var tags []string
+	for _, tag := range strings.Split(field, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// HasAnyTag reports whether the notification has at least one of tags,
+// ignoring case.
+func (n *Notification) HasAnyTag(tags []string) bool {
+	for _, want := range tags {
+		for _, tag := range n.Tags {
+			if strings.EqualFold(tag, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ParseNotificationLevel parses a string into a NotificationLevel.
 func ParseNotificationLevel(level string) (NotificationLevel, error) {
 	nl := NotificationLevel(level)
@@ -214,23 +330,48 @@ func ParseNotificationState(state string) (NotificationState, error) {
 	return ns, nil
 }
 
-// ParseNotificationLine parses a TSV line into a Notification.
-// Accepts either 9 fields (no read timestamp) or 10 fields.
-func ParseNotificationLine(line string) (Notification, error) {
-	fields := strings.Split(line, "\t")
-	switch len(fields) {
-	case 9:
+// NotificationFields is the number of fields in a notification TSV line.
+// storage.NumFields must match it.
+const NotificationFields = 17
+
+// MinNotificationFields is the field count of the oldest notification TSV
+// lines, written before read_timestamp existed.
+const MinNotificationFields = 9
+
+// PadNotificationFields pads the fields of a TSV line written before its
+// trailing fields existed with empty values, so every line has
+// NotificationFields fields. Lines with fewer than MinNotificationFields or
+// more than NotificationFields fields are rejected.
+func PadNotificationFields(fields []string) ([]string, error) {
+	if len(fields) < MinNotificationFields || len(fields) > NotificationFields {
+		return nil, fmt.Errorf("invalid notification field count: %d", len(fields))
+	}
+	for len(fields) < NotificationFields {
 		fields = append(fields, "")
-	case 10:
-		// OK
-	default:
-		return Notification{}, fmt.Errorf("invalid notification field count: %d", len(fields))
+	}
+	return fields, nil
+}
+
+// ParseNotificationLine parses a TSV line into a Notification. Lines from
+// before a trailing field existed are accepted; see PadNotificationFields.
+func ParseNotificationLine(line string) (Notification, error) {
+	fields, err := PadNotificationFields(strings.Split(line, "\t"))
+	if err != nil {
+		return Notification{}, err
 	}
 
 	id := 0
 	if fields[0] != "" {
 		_, _ = fmt.Sscanf(fields[0], "%d", &id)
 	}
+	priority := 0
+	if fields[14] != "" {
+		_, _ = fmt.Sscanf(fields[14], "%d", &priority)
+	}
+	occurrences := 0
+	if fields[16] != "" {
+		_, _ = fmt.Sscanf(fields[16], "%d", &occurrences)
+	}
 
 	return Notification{
 		ID:            id,
@@ -243,13 +384,32 @@ func ParseNotificationLine(line string) (Notification, error) {
 		PaneCreated:   fields[7],
 		Level:         NotificationLevel(fields[8]),
 		ReadTimestamp: fields[9],
+		Important:     fields[10] == "1",
+		Color:         fields[11],
+		Owner:         fields[12],
+		SnoozedUntil:  fields[13],
+		Priority:      priority,
+		Tags:          ParseTags(fields[15]),
+		Occurrences:   occurrences,
 	}, nil
 }
 
 // FormatNotificationLine serializes the notification to a TSV line.
 func (n Notification) FormatNotificationLine() string {
+	important := ""
+	if n.Important {
+		important = "1"
+	}
+	priority := ""
+	if n.Priority != 0 {
+		priority = strconv.Itoa(n.Priority)
+	}
+	occurrences := ""
+	if n.Occurrences != 0 {
+		occurrences = strconv.Itoa(n.Occurrences)
+	}
 	return fmt.Sprintf(
-		"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
+		"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
 		n.ID,
 		n.Timestamp,
 		n.State.String(),
@@ -260,6 +420,13 @@ func (n Notification) FormatNotificationLine() string {
 		n.PaneCreated,
 		n.Level.String(),
 		n.ReadTimestamp,
+		important,
+		n.Color,
+		n.Owner,
+		n.SnoozedUntil,
+		priority,
+		strings.Join(n.Tags, ","),
+		occurrences,
 	)
 }
 
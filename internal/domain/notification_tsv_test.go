@@ -39,6 +39,19 @@ func TestParseNotificationLine_InvalidFieldCount(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid notification field count")
 }
 
+func TestPadNotificationFields(t *testing.T) {
+	for count := MinNotificationFields; count <= NotificationFields; count++ {
+		fields, err := PadNotificationFields(make([]string, count))
+		require.NoError(t, err)
+		assert.Len(t, fields, NotificationFields)
+	}
+
+	_, err := PadNotificationFields(make([]string, NotificationFields+1))
+	assert.Error(t, err, "lines with more fields than known are rejected")
+	_, err = PadNotificationFields(make([]string, MinNotificationFields-1))
+	assert.Error(t, err)
+}
+
 func TestParseNotificationLine_RoundTrip(t *testing.T) {
 	original := Notification{
 		ID:            42,
@@ -84,7 +97,136 @@ func TestFormatNotificationLine(t *testing.T) {
 	}
 
 	line := n.FormatNotificationLine()
-	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t", line)
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t\t\t\t\t\t\t", line)
+
+	n.Important = true
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t\t\t\t\t\t", n.FormatNotificationLine())
+
+	n.Color = "#ff8800"
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\t\t\t\t\t", n.FormatNotificationLine())
+
+	n.Owner = "alice"
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\talice\t\t\t\t", n.FormatNotificationLine())
+
+	n.SnoozedUntil = "2024-01-01T18:00:00Z"
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\talice\t2024-01-01T18:00:00Z\t\t\t", n.FormatNotificationLine())
+
+	n.Priority = 5
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\talice\t2024-01-01T18:00:00Z\t5\t\t", n.FormatNotificationLine())
+
+	n.Tags = []string{"ci", "deploy"}
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\talice\t2024-01-01T18:00:00Z\t5\tci,deploy\t", n.FormatNotificationLine())
+
+	n.Occurrences = 3
+	assert.Equal(t, "1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tplain message\t\tinfo\t\t1\t#ff8800\talice\t2024-01-01T18:00:00Z\t5\tci,deploy\t3", n.FormatNotificationLine())
+}
+
+func TestParseNotificationLineImportantField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t1")
+	require.NoError(t, err)
+	assert.True(t, n.Important)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t")
+	require.NoError(t, err)
+	assert.False(t, n.Important, "10-field lines predate the flag")
+
+	_, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t1\t\t\t\t\t\t\textra")
+	assert.Error(t, err)
+}
+
+func TestParseNotificationLineColorField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t\t208")
+	require.NoError(t, err)
+	assert.Equal(t, "208", n.Color)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t1")
+	require.NoError(t, err)
+	assert.Empty(t, n.Color, "11-field lines predate the color")
+}
+
+func TestParseNotificationLineOwnerField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t\t\talice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", n.Owner)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t\t208")
+	require.NoError(t, err)
+	assert.Empty(t, n.Owner, "12-field lines predate the owner")
+}
+
+func TestParseNotificationLineSnoozedUntilField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t\t\talice\t2024-01-01T18:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T18:00:00Z", n.SnoozedUntil)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\tinfo\t\t\t\talice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", n.Owner)
+	assert.Empty(t, n.SnoozedUntil, "13-field lines predate the snooze time")
+}
+
+func TestParseNotificationLinePriorityField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t10")
+	require.NoError(t, err)
+	assert.Equal(t, 10, n.Priority)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t")
+	require.NoError(t, err)
+	assert.Zero(t, n.Priority, "14-field lines predate the priority")
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t")
+	require.NoError(t, err)
+	assert.Zero(t, n.Priority, "an empty priority is the default")
+}
+
+func TestParseNotificationLineTagsField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t\tci,deploy")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci", "deploy"}, n.Tags)
+	assert.True(t, n.HasAnyTag([]string{"Deploy"}))
+	assert.False(t, n.HasAnyTag([]string{"prod"}))
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t10")
+	require.NoError(t, err)
+	assert.Nil(t, n.Tags, "15-field lines predate the tags")
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t\t")
+	require.NoError(t, err)
+	assert.Nil(t, n.Tags, "an empty tags field means untagged")
+}
+
+func TestParseNotificationLineOccurrencesField(t *testing.T) {
+	n, err := ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t\t\t4")
+	require.NoError(t, err)
+	assert.Equal(t, 4, n.Occurrences)
+
+	n, err = ParseNotificationLine("1\t2024-01-01T12:00:00Z\tactive\t$0\t@1\t%2\tmsg\t\terror\t\t\t\t\t\t\tci")
+	require.NoError(t, err)
+	assert.Zero(t, n.Occurrences, "16-field lines predate the occurrence count")
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{" Deploy", "ci", "", "deploy"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci", "deploy"}, tags)
+
+	tags, err = NormalizeTags(nil)
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+
+	_, err = NormalizeTags([]string{"two words"})
+	assert.Error(t, err)
+	_, err = NormalizeTags([]string{"a,b"})
+	assert.Error(t, err)
+}
+
+func TestIsValidColor(t *testing.T) {
+	for _, color := range []string{"0", "208", "255", "#f80", "#FF8800"} {
+		assert.True(t, IsValidColor(color), color)
+	}
+	for _, color := range []string{"", "256", "-1", "007", "red", "#ff88", "#gg0000", "\x1b[0;31m"} {
+		assert.False(t, IsValidColor(color), color)
+	}
 }
 
 func TestParseNotificationLine_EmptyFields(t *testing.T) {
@@ -15,6 +15,10 @@ var (
 
 	// ErrStorageFailed is returned when a storage operation fails.
 	ErrStorageFailed = errors.New("storage operation failed")
+
+	// ErrSuppressedAfterDismiss is returned when an add is dropped because the
+	// same notification was dismissed within dedup.suppress_after_dismiss.
+	ErrSuppressedAfterDismiss = errors.New("notification was dismissed recently")
 )
 
 // NotificationRepository defines the interface for notification persistence.
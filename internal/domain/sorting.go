@@ -19,6 +19,7 @@ const (
 	SortBySessionField    SortByField = "session"
 	SortByMessageField    SortByField = "message"
 	SortByReadStatusField SortByField = "read_status"
+	SortByPriorityField   SortByField = "priority"
 )
 
 // IsValid checks if the sort by field is valid.
@@ -26,7 +27,7 @@ func (s SortByField) IsValid() bool {
 	switch s {
 	case SortByIDField, SortByTimestampField, SortByStateField,
 		SortByLevelField, SortBySessionField, SortByMessageField,
-		SortByReadStatusField:
+		SortByReadStatusField, SortByPriorityField:
 		return true
 	default:
 		return false
@@ -140,6 +141,13 @@ func compareByField(i, j Notification, opts SortOptions) bool {
 		return i.Level.String() < j.Level.String()
 	case SortBySessionField:
 		return i.Session < j.Session
+	case SortByPriorityField:
+		// Equal priorities fall back to the timestamp, so descending order
+		// lists the newest of each priority first.
+		if i.Priority != j.Priority {
+			return i.Priority < j.Priority
+		}
+		return i.Timestamp < j.Timestamp
 	case SortByMessageField:
 		msgI := i.Message
 		msgJ := j.Message
@@ -187,6 +195,11 @@ func SortBySession(notifs []Notification, order SortOrder) []Notification {
 	return SortNotifications(notifs, SortOptions{Field: SortBySessionField, Order: order})
 }
 
+// SortByPriority sorts notifications by priority.
+func SortByPriority(notifs []Notification, order SortOrder) []Notification {
+	return SortNotifications(notifs, SortOptions{Field: SortByPriorityField, Order: order})
+}
+
 // SortByReadStatus sorts notifications by read status (unread first).
 func SortByReadStatus(notifs []Notification, order SortOrder) []Notification {
 	return SortNotifications(notifs, SortOptions{Field: SortByReadStatusField, Order: order})
@@ -19,6 +19,7 @@ func TestSortByField_IsValid(t *testing.T) {
 		{"valid session", SortBySessionField, true},
 		{"valid message", SortByMessageField, true},
 		{"valid read_status", SortByReadStatusField, true},
+		{"valid priority", SortByPriorityField, true},
 		{"invalid", SortByField("invalid"), false},
 		{"invalid empty", SortByField(""), false},
 	}
@@ -279,6 +280,19 @@ func TestSortBySession(t *testing.T) {
 	assert.Equal(t, "$3", result[2].Session)
 }
 
+func TestSortByPriority(t *testing.T) {
+	notifications := []Notification{
+		{ID: 1, Timestamp: "2024-01-01T10:00:00Z"},
+		{ID: 2, Timestamp: "2024-01-01T11:00:00Z", Priority: 5},
+		{ID: 3, Timestamp: "2024-01-01T12:00:00Z"},
+		{ID: 4, Timestamp: "2024-01-01T09:00:00Z", Priority: 5},
+		{ID: 5, Timestamp: "2024-01-01T13:00:00Z", Priority: -1},
+	}
+
+	result := SortByPriority(notifications, SortOrderDesc)
+	assert.Equal(t, []int{2, 4, 3, 1, 5}, []int{result[0].ID, result[1].ID, result[2].ID, result[3].ID, result[4].ID})
+}
+
 func TestSortByMessage(t *testing.T) {
 	notifications := []Notification{
 		{ID: 1, Message: "zebra"},
@@ -0,0 +1,122 @@
+// Package events appends compact JSON events to the events.jsonl stream in the state directory.
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/config"
+)
+
+// FileName is the name of the event stream inside the state directory.
+const FileName = "events.jsonl"
+
+// Event types understood by the events.jsonl stream.
+const (
+	// TypeJump is emitted after a successful jump to a notification's pane.
+	TypeJump = "jump"
+	// TypeAdd describes a new notification. Replay re-adds it.
+	TypeAdd = "add"
+	// TypeDismiss describes a dismissed notification.
+	TypeDismiss = "dismiss"
+	// TypeRead describes a notification marked as read.
+	TypeRead = "read"
+)
+
+// Event is a single line of the events.jsonl stream.
+type Event struct {
+	Type           string `json:"type"`
+	Timestamp      string `json:"ts"`
+	NotificationID string `json:"id,omitempty"`
+	Session        string `json:"session,omitempty"`
+	Window         string `json:"window,omitempty"`
+	Pane           string `json:"pane,omitempty"`
+	Message        string `json:"message,omitempty"`
+	Level          string `json:"level,omitempty"`
+}
+
+// Path returns the location of the events.jsonl stream.
+func Path() string {
+	stateDir := os.Getenv("TMUX_INTRAY_STATE_DIR")
+	if stateDir == "" {
+		config.Load()
+		stateDir = config.Get("state_dir", "")
+	}
+	return filepath.Join(stateDir, FileName)
+}
+
+// Append writes the event as one JSON line, stamping it with the current time when unset.
+func Append(event Event) error {
+	return AppendTo(Path(), event)
+}
+
+// AppendTo writes each event as one JSON line to the stream at path, opening
+// it once for all of them. Events without a timestamp get the current time.
+func AppendTo(path string, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	var lines []byte
+	for _, event := range events {
+		if event.Timestamp == "" {
+			event.Timestamp = now
+		}
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("events: encode %s event: %w", event.Type, err)
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("events: create state directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("events: open stream: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(lines); err != nil {
+		return fmt.Errorf("events: write events: %w", err)
+	}
+	return nil
+}
+
+// MaxStreamSize is the size past which Rotate moves the stream aside.
+const MaxStreamSize = 10 << 20
+
+// Rotate moves the stream at path to path+".1", replacing the stream rotated
+// before it, once the stream is larger than maxSize. It reports whether it
+// rotated. A missing stream is left alone.
+func Rotate(path string, maxSize int64) (bool, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("events: stat stream: %w", err)
+	}
+	if info.Size() <= maxSize {
+		return false, nil
+	}
+	if err := os.Rename(path, path+".1"); err != nil {
+		return false, fmt.Errorf("events: rotate stream: %w", err)
+	}
+	return true, nil
+}
+
+// RecordJump appends a jump event for a notification and the pane it targeted.
+func RecordJump(id, session, window, pane string) error {
+	return Append(Event{
+		Type:           TypeJump,
+		NotificationID: id,
+		Session:        session,
+		Window:         window,
+		Pane:           pane,
+	})
+}
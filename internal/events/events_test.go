@@ -0,0 +1,69 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordJumpAppendsOneLinePerEvent(t *testing.T) {
+	stateDir := filepath.Join(t.TempDir(), "state")
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+
+	require.NoError(t, RecordJump("42", "$1", "@2", "%3"))
+	require.NoError(t, RecordJump("43", "$1", "@2", "%4"))
+
+	content, err := os.ReadFile(filepath.Join(stateDir, FileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var event Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	require.Equal(t, TypeJump, event.Type)
+	require.Equal(t, "42", event.NotificationID)
+	require.Equal(t, "%3", event.Pane)
+	require.NotEmpty(t, event.Timestamp)
+}
+
+func TestAppendToWritesEveryEventInOneCall(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", FileName)
+
+	require.NoError(t, AppendTo(path, Event{Type: TypeRead, NotificationID: "1"}, Event{Type: TypeRead, NotificationID: "2"}))
+	require.NoError(t, AppendTo(path))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	events, err := Read(f)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "2", events[1].NotificationID)
+	require.NotEmpty(t, events[1].Timestamp)
+}
+
+func TestRotateMovesStreamAsideOnlyWhenTooLarge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+
+	rotated, err := Rotate(path, 10)
+	require.NoError(t, err)
+	require.False(t, rotated, "a missing stream is left alone")
+
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o644))
+	rotated, err = Rotate(path, 10)
+	require.NoError(t, err)
+	require.False(t, rotated)
+
+	require.NoError(t, os.WriteFile(path, []byte("0123456789A"), 0o644))
+	rotated, err = Rotate(path, 10)
+	require.NoError(t, err)
+	require.True(t, rotated)
+	require.NoFileExists(t, path)
+	content, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "0123456789A", string(content))
+}
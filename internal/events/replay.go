@@ -0,0 +1,93 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Read parses an events.jsonl stream. Blank lines are skipped.
+func Read(r io.Reader) ([]Event, error) {
+	var events []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, fmt.Errorf("events: line %d: %w", lineNo, err)
+		}
+		if event.Type == "" {
+			return nil, fmt.Errorf("events: line %d: missing event type", lineNo)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("events: read stream: %w", err)
+	}
+	return events, nil
+}
+
+// Scheduler replays events, waiting between them for the recorded gap divided by Speed.
+type Scheduler struct {
+	// Speed scales the recorded delays; 2 replays twice as fast. Zero replays without waiting.
+	Speed float64
+	// Sleep waits for d or until ctx is done. Tests replace it; nil uses a timer.
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+// Run calls apply for each event in order, sleeping between events per the recorded timestamps.
+// Events without a parseable timestamp are applied immediately after the previous one.
+func (s Scheduler) Run(ctx context.Context, events []Event, apply func(Event) error) error {
+	if s.Speed < 0 {
+		return fmt.Errorf("events: invalid replay speed %v", s.Speed)
+	}
+	sleep := s.Sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+
+	var previous time.Time
+	for i, event := range events {
+		ts, err := time.Parse(time.RFC3339, event.Timestamp)
+		if err == nil {
+			if delay := s.delay(previous, ts); delay > 0 {
+				if err := sleep(ctx, delay); err != nil {
+					return err
+				}
+			}
+			previous = ts
+		}
+		if err := apply(event); err != nil {
+			return fmt.Errorf("events: replay event %d (%s): %w", i+1, event.Type, err)
+		}
+	}
+	return nil
+}
+
+func (s Scheduler) delay(previous, current time.Time) time.Duration {
+	if previous.IsZero() || s.Speed == 0 || !current.After(previous) {
+		return 0
+	}
+	return time.Duration(float64(current.Sub(previous)) / s.Speed)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const recorded = `{"type":"add","ts":"2026-01-01T10:00:00Z","id":"1","message":"build started","level":"info"}
+
+{"type":"add","ts":"2026-01-01T10:00:10Z","id":"2","message":"build failed","level":"error"}
+{"type":"jump","ts":"2026-01-01T10:01:10Z","id":"2","session":"$1","window":"@1","pane":"%1"}
+`
+
+func TestReadParsesEventsInOrder(t *testing.T) {
+	events, err := Read(strings.NewReader(recorded))
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, TypeAdd, events[0].Type)
+	require.Equal(t, "build failed", events[1].Message)
+	require.Equal(t, "%1", events[2].Pane)
+}
+
+func TestReadReportsBadLine(t *testing.T) {
+	_, err := Read(strings.NewReader("{\"type\":\"add\"}\nnot json\n"))
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestSchedulerReplaysInOrderWithScaledDelays(t *testing.T) {
+	events, err := Read(strings.NewReader(recorded))
+	require.NoError(t, err)
+
+	var sleeps []time.Duration
+	var applied []string
+	s := Scheduler{
+		Speed: 10,
+		Sleep: func(ctx context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		},
+	}
+	err = s.Run(context.Background(), events, func(e Event) error {
+		applied = append(applied, e.Type+":"+e.NotificationID)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"add:1", "add:2", "jump:2"}, applied)
+	require.Equal(t, []time.Duration{time.Second, 6 * time.Second}, sleeps)
+}
+
+func TestSchedulerSpeedZeroSkipsDelays(t *testing.T) {
+	events, err := Read(strings.NewReader(recorded))
+	require.NoError(t, err)
+
+	s := Scheduler{Sleep: func(ctx context.Context, d time.Duration) error {
+		t.Fatalf("unexpected sleep %s", d)
+		return nil
+	}}
+	count := 0
+	require.NoError(t, s.Run(context.Background(), events, func(Event) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 3, count)
+}
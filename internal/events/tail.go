@@ -0,0 +1,79 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+)
+
+// Tailer reads the events appended to an events.jsonl stream since its last poll.
+type Tailer struct {
+	path    string
+	offset  int64
+	partial []byte
+}
+
+// NewTailer creates a tailer that starts at the beginning of the stream at path.
+func NewTailer(path string) *Tailer {
+	return &Tailer{path: path}
+}
+
+// Poll returns the events appended since the previous call. A missing stream
+// yields no events. A final line without a newline is held back until its
+// writer completes it. When the stream shrinks (truncated or replaced), reading
+// restarts from the beginning. Lines that are not valid events are skipped.
+func (t *Tailer) Poll() ([]Event, error) {
+	f, err := os.Open(t.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("events: open stream: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("events: stat stream: %w", err)
+	}
+	if info.Size() < t.offset {
+		t.offset = 0
+		t.partial = nil
+	}
+	if _, err := f.Seek(t.offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("events: seek stream: %w", err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("events: read stream: %w", err)
+	}
+	t.offset += int64(len(data))
+
+	data = append(t.partial, data...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		t.partial = data
+		return nil, nil
+	}
+	t.partial = append([]byte(nil), data[end+1:]...)
+
+	var events []Event
+	for _, line := range bytes.Split(data[:end], []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil || event.Type == "" {
+			colors.Debug(fmt.Sprintf("events: skipping malformed line: %s", line))
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
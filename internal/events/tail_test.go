@@ -0,0 +1,69 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func appendRaw(t *testing.T, path, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestTailerReturnsOnlyNewEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	tailer := NewTailer(path)
+
+	got, err := tailer.Poll()
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	appendRaw(t, path, recorded)
+	got, err = tailer.Poll()
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	appendRaw(t, path, `{"type":"dismiss","id":"2"}`+"\n")
+	got, err = tailer.Poll()
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, TypeDismiss, got[0].Type)
+}
+
+func TestTailerHoldsIncompleteLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	tailer := NewTailer(path)
+
+	appendRaw(t, path, `{"type":"add","id":"1",`)
+	got, err := tailer.Poll()
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	appendRaw(t, path, `"message":"hi"}`+"\nnot json\n")
+	got, err = tailer.Poll()
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "hi", got[0].Message)
+}
+
+func TestTailerRestartsAfterTruncation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	tailer := NewTailer(path)
+
+	appendRaw(t, path, recorded)
+	_, err := tailer.Poll()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"type":"read","id":"9"}`+"\n"), 0o644))
+	got, err := tailer.Poll()
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "9", got[0].NotificationID)
+}
@@ -33,6 +33,13 @@ func ToDomain(n Notification) (*domain.Notification, error) {
 	if err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	domainNotif.Important = n.Important
+	domainNotif.Color = n.Color
+	domainNotif.Owner = n.Owner
+	domainNotif.SnoozedUntil = n.SnoozedUntil
+	domainNotif.Priority = n.Priority
+	domainNotif.Tags = n.Tags
+	domainNotif.Occurrences = n.Occurrences
 
 	return domainNotif, nil
 }
@@ -52,6 +59,13 @@ func ToDomainUnsafe(n Notification) *domain.Notification {
 		PaneCreated:   n.PaneCreated,
 		Level:         domain.NotificationLevel(n.Level),
 		ReadTimestamp: n.ReadTimestamp,
+		Important:     n.Important,
+		Color:         n.Color,
+		Owner:         n.Owner,
+		SnoozedUntil:  n.SnoozedUntil,
+		Priority:      n.Priority,
+		Tags:          n.Tags,
+		Occurrences:   n.Occurrences,
 	}
 }
 
@@ -68,6 +82,13 @@ func FromDomain(n *domain.Notification) Notification {
 		PaneCreated:   n.PaneCreated,
 		Level:         n.Level.String(),
 		ReadTimestamp: n.ReadTimestamp,
+		Important:     n.Important,
+		Color:         n.Color,
+		Owner:         n.Owner,
+		SnoozedUntil:  n.SnoozedUntil,
+		Priority:      n.Priority,
+		Tags:          n.Tags,
+		Occurrences:   n.Occurrences,
 	}
 }
 
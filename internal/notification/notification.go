@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
 )
 
 // Notification represents a single notification record.
@@ -18,23 +21,41 @@ type Notification struct {
 	PaneCreated   string
 	Level         string
 	ReadTimestamp string
+	// Important is a user-set curation flag; it does not affect sorting.
+	Important bool
+	// Color overrides the level color of the notification's TUI row.
+	Color string
+	// Owner is who claimed the notification; empty means unassigned.
+	Owner string
+	// SnoozedUntil is when a snoozed notification comes back; empty means not snoozed.
+	SnoozedUntil string
+	// Priority orders notifications when sorting by priority; 0 is the default.
+	Priority int
+	// Tags are free-form labels; nil means untagged.
+	Tags []string
+	// Occurrences is how many adds were collapsed into this one; 0 means one.
+	Occurrences int
 }
 
-// ParseNotification parses a TSV line into a Notification.
+// ParseNotification parses a TSV line into a Notification. Lines from before
+// a trailing field existed are accepted; see domain.PadNotificationFields.
 func ParseNotification(line string) (Notification, error) {
-	fields := strings.Split(line, "\t")
-	switch len(fields) {
-	case 9:
-		fields = append(fields, "")
-	case 10:
-		// OK
-	default:
-		return Notification{}, fmt.Errorf("invalid notification field count: %d", len(fields))
+	fields, err := domain.PadNotificationFields(strings.Split(line, "\t"))
+	if err != nil {
+		return Notification{}, err
 	}
 	id := 0
 	if fields[0] != "" {
 		_, _ = fmt.Sscanf(fields[0], "%d", &id)
 	}
+	priority := 0
+	if fields[14] != "" {
+		_, _ = fmt.Sscanf(fields[14], "%d", &priority)
+	}
+	occurrences := 0
+	if fields[16] != "" {
+		_, _ = fmt.Sscanf(fields[16], "%d", &occurrences)
+	}
 	return Notification{
 		ID:            id,
 		Timestamp:     fields[1],
@@ -46,9 +67,35 @@ func ParseNotification(line string) (Notification, error) {
 		PaneCreated:   fields[7],
 		Level:         fields[8],
 		ReadTimestamp: fields[9],
+		Important:     fields[10] == "1",
+		Color:         fields[11],
+		Owner:         fields[12],
+		SnoozedUntil:  fields[13],
+		Priority:      priority,
+		Tags:          domain.ParseTags(fields[15]),
+		Occurrences:   occurrences,
 	}, nil
 }
 
+// ParseNotifications parses newline-separated TSV notification lines in
+// order. Empty lines are ignored; malformed lines are skipped and logged at
+// debug level.
+func ParseNotifications(lines string) []Notification {
+	var notifications []Notification
+	for _, line := range strings.Split(lines, "\n") {
+		if line == "" {
+			continue
+		}
+		notif, err := ParseNotification(line)
+		if err != nil {
+			colors.Debug(fmt.Sprintf("skipping malformed notification line: %v", err))
+			continue
+		}
+		notifications = append(notifications, notif)
+	}
+	return notifications
+}
+
 // IsRead reports whether the notification has a read timestamp.
 func (n Notification) IsRead() bool {
 	return n.ReadTimestamp != ""
@@ -42,6 +42,26 @@ func TestParseNotificationWithoutReadTimestamp(t *testing.T) {
 	}
 }
 
+func TestParseNotificationsSkipsMalformedLines(t *testing.T) {
+	lines := "1\t2025-01-01T12:00:00Z\tactive\tsess\twin\tpane\tfirst\t123\tinfo\n" +
+		"not a notification\n" +
+		"\n" +
+		"2\t2025-01-01T12:01:00Z\tdismissed\tsess\twin\tpane\tsecond\t123\twarning"
+	notifications := ParseNotifications(lines)
+	if len(notifications) != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", len(notifications))
+	}
+	if notifications[0].Message != "first" || notifications[1].Message != "second" {
+		t.Errorf("Order mismatch: %q, %q", notifications[0].Message, notifications[1].Message)
+	}
+	if notifications[1].State != "dismissed" || notifications[1].Level != "warning" {
+		t.Errorf("Fields mismatch: %+v", notifications[1])
+	}
+	if got := ParseNotifications(""); len(got) != 0 {
+		t.Errorf("Expected no notifications, got %d", len(got))
+	}
+}
+
 func TestNotificationReadHelpers(t *testing.T) {
 	n := Notification{}
 	if n.IsRead() {
@@ -1,6 +1,8 @@
 // Package ports defines application boundary interfaces used by core services.
 package ports
 
+import "time"
+
 // NotificationRepository defines the storage operations used by core services.
 type NotificationRepository interface {
 	AddNotification(message, timestamp, session, window, pane, paneCreated, level string) (string, error)
@@ -12,6 +14,10 @@ type NotificationRepository interface {
 	MarkNotificationUnread(id string) error
 	CleanupOldNotifications(daysThreshold int, dryRun bool) error
 	GetActiveCount() int
+	MuteSession(session string) error
+	UnmuteSession(session string) error
+	ListMutedSessions() ([]string, error)
+	EscalateStale() (int, error)
 }
 
 // NotificationLookup defines read-only notification lookup operations.
@@ -19,6 +25,21 @@ type NotificationLookup interface {
 	GetNotificationByID(id string) (string, error)
 }
 
+// PendingAction is an action queued until tmux is available.
+type PendingAction struct {
+	ID             int64
+	Action         string
+	NotificationID string
+	QueuedAt       string
+}
+
+// SessionSnooze hides a notification until its session becomes active.
+type SessionSnooze struct {
+	NotificationID string
+	Session        string
+	SnoozedAt      string
+}
+
 // TmuxContext captures current tmux identifiers.
 type TmuxContext struct {
 	SessionID string
@@ -31,6 +52,8 @@ type TmuxContext struct {
 type TmuxClient interface {
 	GetCurrentContext() (TmuxContext, error)
 	ValidatePaneExists(sessionID, windowID, paneID string) (bool, error)
+	ListAttachedSessions() (map[string]string, error)
+	CapturePane(paneID string, lines int) (string, error)
 	JumpToPane(sessionID, windowID, paneID string) (bool, error)
 	SetEnvironment(name, value string) error
 	GetEnvironment(name string) (string, error)
@@ -54,4 +77,9 @@ type ConfigProvider interface {
 type StatusPublisher interface {
 	HasSession() (bool, error)
 	SetStatusOption(name, value string) error
+	UnsetStatusOption(name string) error
+	SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error
+	// ListStatusOptions returns the global options whose names start with
+	// prefix, keyed by name.
+	ListStatusOptions(prefix string) (map[string]string, error)
 }
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// aliasPrefix starts a query token that names a search alias.
+const aliasPrefix = "@"
+
+// ExpandAliases replaces each "@name" token in query with the definition of
+// the alias name, so "@errors" can stand for "level:error unread".
+// Definitions may use other aliases. A token that names no alias, or an
+// alias already being expanded, is kept as typed, so "@1" still searches for
+// window @1 and a cycle cannot loop forever.
+func ExpandAliases(query string, aliases map[string]string) string {
+	if len(aliases) == 0 || !strings.Contains(query, aliasPrefix) {
+		return query
+	}
+	tokens, changed := expandAliasTokens(strings.Fields(query), aliases, map[string]bool{})
+	if !changed {
+		return query
+	}
+	return strings.Join(tokens, " ")
+}
+
+// expandAliasTokens expands the alias tokens in tokens, skipping the aliases
+// in expanding. It reports whether any token was replaced.
+func expandAliasTokens(tokens []string, aliases map[string]string, expanding map[string]bool) ([]string, bool) {
+	expanded := make([]string, 0, len(tokens))
+	changed := false
+	for _, token := range tokens {
+		name, ok := strings.CutPrefix(token, aliasPrefix)
+		definition, defined := aliases[name]
+		if !ok || !defined || expanding[name] {
+			expanded = append(expanded, token)
+			continue
+		}
+		expanding[name] = true
+		inner, _ := expandAliasTokens(strings.Fields(definition), aliases, expanding)
+		delete(expanding, name)
+		expanded = append(expanded, inner...)
+		changed = true
+	}
+	return expanded, changed
+}
+
+// ValidateAliases checks alias names and rejects definitions that expand back
+// into themselves. Names are written without the "@", cannot contain
+// whitespace, and cannot be all digits, which would hide tmux window IDs.
+func ValidateAliases(aliases map[string]string) error {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == "" || strings.HasPrefix(name, aliasPrefix) || len(strings.Fields(name)) != 1 || strings.TrimFunc(name, isDigit) == "" {
+			return fmt.Errorf("invalid search alias name %q", name)
+		}
+	}
+	for _, name := range names {
+		if cycle := aliasCycle([]string{name}, aliases); cycle != nil {
+			return fmt.Errorf("search alias %s%s expands to itself", aliasPrefix, strings.Join(cycle, " -> "+aliasPrefix))
+		}
+	}
+	return nil
+}
+
+// aliasCycle follows the aliases used by the last alias in path and returns
+// the path extended up to the first alias that repeats, or nil when none does.
+func aliasCycle(path []string, aliases map[string]string) []string {
+	for _, token := range strings.Fields(aliases[path[len(path)-1]]) {
+		next, ok := strings.CutPrefix(token, aliasPrefix)
+		if _, defined := aliases[next]; !ok || !defined {
+			continue
+		}
+		if slices.Contains(path, next) {
+			return append(path, next)
+		}
+		if cycle := aliasCycle(append(path, next), aliases); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,80 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAliasesReplacesAliasWithDefinition(t *testing.T) {
+	aliases := map[string]string{
+		"errors": "important:true unread",
+		"mine":   "owner:me @errors",
+	}
+
+	assert.Equal(t, "important:true unread deploy", ExpandAliases("@errors deploy", aliases))
+	assert.Equal(t, "owner:me important:true unread", ExpandAliases("@mine", aliases), "aliases expand inside definitions")
+	assert.Equal(t, "deploy  failed", ExpandAliases("deploy  failed", aliases), "queries without aliases are unchanged")
+}
+
+func TestAliasExampleFiltersByLevel(t *testing.T) {
+	aliases := map[string]string{"errors": "level:error unread"}
+	provider := NewTokenProvider(WithCaseInsensitive(true))
+
+	query := ExpandAliases("@errors", aliases)
+	assert.True(t, provider.Match(domain.Notification{ID: 1, Level: domain.LevelError, Message: "build"}, query))
+	assert.False(t, provider.Match(domain.Notification{ID: 2, Level: domain.LevelWarning, Message: "error budget low"}, query), "level: does not match message text")
+	assert.False(t, provider.Match(domain.Notification{ID: 3, Level: domain.LevelError, Message: "build", ReadTimestamp: "2024-01-01T12:00:00Z"}, query))
+}
+
+func TestExpandAliasesKeepsUndefinedAliasLiteral(t *testing.T) {
+	aliases := map[string]string{"errors": "unread"}
+
+	assert.Equal(t, "@missing", ExpandAliases("@missing", aliases))
+	assert.Equal(t, "@1 unread", ExpandAliases("@1 @errors", aliases))
+
+	provider := NewTokenProvider(WithCaseInsensitive(true))
+	notif := domain.Notification{ID: 1, Window: "@1", Message: "build"}
+	assert.True(t, provider.Match(notif, ExpandAliases("@1", aliases)), "@1 still matches the window ID")
+	assert.False(t, provider.Match(notif, ExpandAliases("@missing", aliases)))
+}
+
+func TestExpandAliasesStopsAtCycles(t *testing.T) {
+	aliases := map[string]string{
+		"a": "unread @b",
+		"b": "@a important:true",
+	}
+
+	assert.Equal(t, "unread @a important:true", ExpandAliases("@a", aliases))
+}
+
+func TestExpandedAliasFiltersLikeItsDefinition(t *testing.T) {
+	aliases := map[string]string{"urgent": "important:true unread"}
+	provider := NewTokenProvider(WithCaseInsensitive(true))
+
+	urgent := domain.Notification{ID: 1, Message: "deploy failed", Important: true}
+	seen := domain.Notification{ID: 2, Message: "deploy failed", Important: true, ReadTimestamp: "2024-01-01T12:00:00Z"}
+
+	assert.True(t, provider.Match(urgent, ExpandAliases("@urgent deploy", aliases)))
+	assert.False(t, provider.Match(seen, ExpandAliases("@urgent deploy", aliases)))
+}
+
+func TestValidateAliases(t *testing.T) {
+	require.NoError(t, ValidateAliases(nil))
+	require.NoError(t, ValidateAliases(map[string]string{
+		"errors": "unread",
+		"mine":   "owner:me @errors @errors",
+	}))
+
+	err := ValidateAliases(map[string]string{"a": "@b", "b": "unread @a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "@a -> @b -> @a")
+
+	assert.Error(t, ValidateAliases(map[string]string{"self": "@self"}))
+	assert.Error(t, ValidateAliases(map[string]string{"": "unread"}))
+	assert.Error(t, ValidateAliases(map[string]string{"@errors": "unread"}))
+	assert.Error(t, ValidateAliases(map[string]string{"my errors": "unread"}))
+	assert.Error(t, ValidateAliases(map[string]string{"12": "unread"}), "digit names would hide window IDs")
+}
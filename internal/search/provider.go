@@ -20,11 +20,22 @@ type Provider interface {
 
 // Options holds configuration options for creating search providers.
 type Options struct {
-	CaseInsensitive bool              // If true, searches ignore case sensitivity
-	Fields          []string          // Fields to search in (default: all fields)
-	SessionNames    map[string]string // Map of session ID to session name for name resolution
-	WindowNames     map[string]string // Map of window ID to window name for name resolution
-	PaneNames       map[string]string // Map of pane ID to pane name for name resolution
+	CaseInsensitive bool                   // If true, searches ignore case sensitivity
+	Fields          []string               // Fields to search in (default: all fields)
+	SessionNames    map[string]string      // Map of session ID to session name for name resolution
+	WindowNames     map[string]string      // Map of window ID to window name for name resolution
+	PaneNames       map[string]string      // Map of pane ID to pane name for name resolution
+	NameSource      NameSource             // Live name maps; takes precedence over the static maps when set
+	CurrentUser     func() (string, error) // Resolves "owner:me"; when nil, "me" is matched literally
+}
+
+// NameSource supplies the current ID to display name maps.
+// Providers query it on every match, so names refreshed after the provider
+// was created are still searchable.
+type NameSource interface {
+	GetSessionNames() map[string]string
+	GetWindowNames() map[string]string
+	GetPaneNames() map[string]string
 }
 
 // DefaultOptions returns the default search options.
@@ -74,6 +85,45 @@ func WithPaneNames(names map[string]string) Option {
 	}
 }
 
+// WithNameSource sets a live source for session, window, and pane names.
+func WithNameSource(source NameSource) Option {
+	return func(o *Options) {
+		o.NameSource = source
+	}
+}
+
+// WithCurrentUser sets how "owner:me" finds the current user. The lookup runs
+// on every query, so a changed user is picked up without a new provider.
+func WithCurrentUser(lookup func() (string, error)) Option {
+	return func(o *Options) {
+		o.CurrentUser = lookup
+	}
+}
+
+// sessionNames returns the session name map, preferring the live name source.
+func (o Options) sessionNames() map[string]string {
+	if o.NameSource != nil {
+		return o.NameSource.GetSessionNames()
+	}
+	return o.SessionNames
+}
+
+// windowNames returns the window name map, preferring the live name source.
+func (o Options) windowNames() map[string]string {
+	if o.NameSource != nil {
+		return o.NameSource.GetWindowNames()
+	}
+	return o.WindowNames
+}
+
+// paneNames returns the pane name map, preferring the live name source.
+func (o Options) paneNames() map[string]string {
+	if o.NameSource != nil {
+		return o.NameSource.GetPaneNames()
+	}
+	return o.PaneNames
+}
+
 // applyOptions applies the given options to the options struct.
 func applyOptions(opts []Option) Options {
 	o := DefaultOptions()
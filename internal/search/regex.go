@@ -44,11 +44,11 @@ func (p *RegexProvider) Match(notif domain.Notification, query string) bool {
 		case "message":
 			fieldValues = []string{notif.Message}
 		case "session":
-			fieldValues = p.getFieldValuesWithNames(notif.Session, p.opts.SessionNames)
+			fieldValues = p.getFieldValuesWithNames(notif.Session, p.opts.sessionNames())
 		case "window":
-			fieldValues = p.getFieldValuesWithNames(notif.Window, p.opts.WindowNames)
+			fieldValues = p.getFieldValuesWithNames(notif.Window, p.opts.windowNames())
 		case "pane":
-			fieldValues = p.getFieldValuesWithNames(notif.Pane, p.opts.PaneNames)
+			fieldValues = p.getFieldValuesWithNames(notif.Pane, p.opts.paneNames())
 		case "level":
 			fieldValues = []string{notif.Level.String()}
 		case "state":
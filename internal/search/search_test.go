@@ -1,6 +1,7 @@
 package search
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -34,6 +35,30 @@ var testNotificationRead = domain.Notification{
 	ReadTimestamp: "2024-01-01T13:00:00Z",
 }
 
+var testNotificationImportant = domain.Notification{
+	ID:        3,
+	Timestamp: "2024-01-01T12:00:00Z",
+	State:     domain.StateDismissed,
+	Session:   "$1",
+	Window:    "@0",
+	Pane:      "%0",
+	Message:   "error: database migration pending",
+	Level:     domain.LevelError,
+	Important: true,
+}
+
+var testNotificationOwned = domain.Notification{
+	ID:        4,
+	Timestamp: "2024-01-01T12:00:00Z",
+	State:     domain.StateActive,
+	Session:   "$1",
+	Window:    "@0",
+	Pane:      "%0",
+	Message:   "error: deploy failed",
+	Level:     domain.LevelError,
+	Owner:     "alice",
+}
+
 // TestDefaultOptions verifies default option values.
 func TestDefaultOptions(t *testing.T) {
 	opts := DefaultOptions()
@@ -322,6 +347,76 @@ func TestTokenProvider(t *testing.T) {
 			query:    "%0 error",
 			expected: true,
 		},
+		{
+			name:     "important:true matches flagged notification",
+			provider: NewTokenProvider(),
+			notif:    testNotificationImportant,
+			query:    "important:true",
+			expected: true,
+		},
+		{
+			name:     "important:true skips unflagged notification",
+			provider: NewTokenProvider(),
+			notif:    testNotification,
+			query:    "important:true",
+			expected: false,
+		},
+		{
+			name:     "important:false skips flagged notification",
+			provider: NewTokenProvider(),
+			notif:    testNotificationImportant,
+			query:    "IMPORTANT:FALSE",
+			expected: false,
+		},
+		{
+			name:     "important filter with text token",
+			provider: NewTokenProvider(),
+			notif:    testNotificationImportant,
+			query:    "important:true database",
+			expected: true,
+		},
+		{
+			name:     "owner token matches owner ignoring case",
+			provider: NewTokenProvider(),
+			notif:    testNotificationOwned,
+			query:    "owner:Alice",
+			expected: true,
+		},
+		{
+			name:     "owner token skips other owners",
+			provider: NewTokenProvider(),
+			notif:    testNotificationOwned,
+			query:    "owner:bob",
+			expected: false,
+		},
+		{
+			name:     "owner token does not match as text",
+			provider: NewTokenProvider(),
+			notif:    testNotification,
+			query:    "owner:alice",
+			expected: false,
+		},
+		{
+			name:     "empty owner token matches unassigned",
+			provider: NewTokenProvider(),
+			notif:    testNotification,
+			query:    "owner:",
+			expected: true,
+		},
+		{
+			name:     "empty owner token skips assigned",
+			provider: NewTokenProvider(),
+			notif:    testNotificationOwned,
+			query:    "owner:",
+			expected: false,
+		},
+		{
+			name:     "owner filter with text token",
+			provider: NewTokenProvider(),
+			notif:    testNotificationOwned,
+			query:    "owner:alice deploy",
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -338,6 +433,141 @@ func TestTokenProviderName(t *testing.T) {
 	assert.Equal(t, "token", provider.Name())
 }
 
+// TestTokenProviderCombinedFilters verifies that owner, important, read and
+// text tokens narrow to their intersection, and that a scope left out of the
+// query does not constrain the results.
+func TestTokenProviderCombinedFilters(t *testing.T) {
+	notifications := []domain.Notification{
+		{ID: 1, Message: "deploy failed", Owner: "alice", Important: true},
+		{ID: 2, Message: "deploy failed", Owner: "alice", Important: true, ReadTimestamp: "2024-01-01T12:00:00Z"},
+		{ID: 3, Message: "deploy failed", Owner: "alice"},
+		{ID: 4, Message: "deploy failed", Owner: "bob", Important: true},
+		{ID: 5, Message: "disk full", Owner: "alice", Important: true},
+		{ID: 6, Message: "deploy failed", Important: true},
+	}
+	provider := NewTokenProvider(
+		WithCaseInsensitive(true),
+		WithCurrentUser(func() (string, error) { return "alice", nil }),
+	)
+
+	matching := func(query string) []int {
+		var ids []int
+		for _, notif := range notifications {
+			if provider.Match(notif, query) {
+				ids = append(ids, notif.ID)
+			}
+		}
+		return ids
+	}
+
+	tests := []struct {
+		query    string
+		expected []int
+	}{
+		{query: "owner:me important:true unread deploy", expected: []int{1}},
+		{query: "owner:me important:true unread", expected: []int{1, 5}},
+		{query: "owner:me important:true", expected: []int{1, 2, 5}},
+		{query: "owner:me", expected: []int{1, 2, 3, 5}},
+		{query: "important:true unread", expected: []int{1, 4, 5, 6}},
+		{query: "unread deploy", expected: []int{1, 3, 4, 6}},
+		{query: "owner:ME deploy", expected: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matching(tt.query))
+		})
+	}
+}
+
+// TestTokenProviderOwnerMeWithoutCurrentUser verifies that "owner:me" is
+// matched literally when the current user cannot be resolved.
+func TestTokenProviderOwnerMeWithoutCurrentUser(t *testing.T) {
+	notif := domain.Notification{Message: "deploy failed", Owner: "me"}
+
+	assert.True(t, NewTokenProvider().Match(notif, "owner:me"))
+	failing := NewTokenProvider(WithCurrentUser(func() (string, error) { return "", errors.New("no user") }))
+	assert.True(t, failing.Match(notif, "owner:me"))
+}
+
+// TestTokenProviderTagFilter verifies that a tag token matches any of its
+// tags, that several tag tokens must all match, and that "tag:" matches
+// untagged notifications.
+func TestTokenProviderTagFilter(t *testing.T) {
+	notifications := []domain.Notification{
+		{ID: 1, Message: "deploy failed", Tags: []string{"deploy", "prod"}},
+		{ID: 2, Message: "tests failed", Tags: []string{"ci"}},
+		{ID: 3, Message: "deploy started", Tags: []string{"deploy"}},
+		{ID: 4, Message: "disk full"},
+	}
+	provider := NewTokenProvider(WithCaseInsensitive(true))
+
+	matching := func(query string) []int {
+		var ids []int
+		for _, notif := range notifications {
+			if provider.Match(notif, query) {
+				ids = append(ids, notif.ID)
+			}
+		}
+		return ids
+	}
+
+	tests := []struct {
+		query    string
+		expected []int
+	}{
+		{query: "tag:deploy", expected: []int{1, 3}},
+		{query: "TAG:Deploy", expected: []int{1, 3}},
+		{query: "tag:deploy,ci", expected: []int{1, 2, 3}},
+		{query: "tag:deploy tag:prod", expected: []int{1}},
+		{query: "tag:deploy started", expected: []int{3}},
+		{query: "tag:", expected: []int{4}},
+		{query: "tag:missing", expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matching(tt.query))
+		})
+	}
+}
+
+func TestTokenProviderLevelFilter(t *testing.T) {
+	notifications := []domain.Notification{
+		{ID: 1, Message: "deploy failed", Level: domain.LevelError},
+		{ID: 2, Message: "disk at error threshold", Level: domain.LevelWarning},
+		{ID: 3, Message: "deploy done"},
+		{ID: 4, Message: "host down", Level: domain.LevelCritical},
+	}
+	provider := NewTokenProvider(WithCaseInsensitive(true))
+
+	matching := func(query string) []int {
+		var ids []int
+		for _, notif := range notifications {
+			if provider.Match(notif, query) {
+				ids = append(ids, notif.ID)
+			}
+		}
+		return ids
+	}
+
+	tests := []struct {
+		query    string
+		expected []int
+	}{
+		{query: "level:error", expected: []int{1}},
+		{query: "LEVEL:Error", expected: []int{1}},
+		{query: "level:error,critical", expected: []int{1, 4}},
+		{query: "level:info", expected: []int{3}},
+		{query: "level:error deploy", expected: []int{1}},
+		{query: "level:error level:critical", expected: nil},
+		{query: "level:", expected: []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matching(tt.query))
+		})
+	}
+}
+
 // TestProviderEdgeCases tests edge cases for all providers.
 func TestProviderEdgeCases(t *testing.T) {
 	providers := []struct {
@@ -745,3 +975,85 @@ func TestNameBasedSearchCrossField(t *testing.T) {
 		})
 	}
 }
+
+type stubNameSource struct {
+	sessions map[string]string
+	windows  map[string]string
+	panes    map[string]string
+}
+
+func (s *stubNameSource) GetSessionNames() map[string]string { return s.sessions }
+func (s *stubNameSource) GetWindowNames() map[string]string  { return s.windows }
+func (s *stubNameSource) GetPaneNames() map[string]string    { return s.panes }
+
+func TestTokenProviderMatchesOnlyDisplayName(t *testing.T) {
+	notif := domain.Notification{
+		ID:      1,
+		Session: "$1",
+		Window:  "@1",
+		Pane:    "%1",
+		Message: "build finished",
+		Level:   "info",
+		State:   "active",
+	}
+
+	provider := NewTokenProvider(
+		WithCaseInsensitive(true),
+		WithSessionNames(map[string]string{"$1": "api"}),
+		WithWindowNames(map[string]string{"@1": "server"}),
+		WithPaneNames(map[string]string{"%1": "logs"}),
+	)
+
+	for _, query := range []string{"api", "API", "server", "logs", "api build"} {
+		if !provider.Match(notif, query) {
+			t.Errorf("expected query %q to match display names", query)
+		}
+	}
+	if provider.Match(notif, "web") {
+		t.Error("expected query \"web\" not to match")
+	}
+}
+
+func TestNameSourceReflectsRefreshedNames(t *testing.T) {
+	notif := domain.Notification{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "done"}
+	source := &stubNameSource{}
+
+	providers := []Provider{
+		NewTokenProvider(WithNameSource(source)),
+		NewSubstringProvider(WithNameSource(source)),
+		NewRegexProvider(WithNameSource(source)),
+	}
+
+	for _, provider := range providers {
+		if provider.Match(notif, "api") {
+			t.Errorf("%s: expected no match before names are resolved", provider.Name())
+		}
+	}
+
+	source.sessions = map[string]string{"$1": "api"}
+	source.windows = map[string]string{"@1": "server"}
+	source.panes = map[string]string{"%1": "logs"}
+
+	for _, provider := range providers {
+		for _, query := range []string{"api", "server", "logs"} {
+			if !provider.Match(notif, query) {
+				t.Errorf("%s: expected query %q to match refreshed names", provider.Name(), query)
+			}
+		}
+	}
+}
+
+func TestNameSourceTakesPrecedenceOverStaticNames(t *testing.T) {
+	notif := domain.Notification{ID: 1, Session: "$1", Message: "done"}
+	provider := NewTokenProvider(
+		WithSessionNames(map[string]string{"$1": "old"}),
+		WithNameSource(&stubNameSource{sessions: map[string]string{"$1": "new"}}),
+	)
+
+	if provider.Match(notif, "old") {
+		t.Error("expected static names to be ignored when a name source is set")
+	}
+	if !provider.Match(notif, "new") {
+		t.Error("expected name source names to match")
+	}
+}
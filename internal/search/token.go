@@ -1,6 +1,7 @@
 package search
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -9,15 +10,39 @@ import (
 // TokenProvider provides token-based search.
 // The query is split into whitespace-separated tokens.
 // Each token must match at least one field (AND logic).
-// Special tokens: "read" (match only read), "unread" (match only unread).
+// Special tokens: "read" (match only read), "unread" (match only unread),
+// "important:true" and "important:false" (match by the important flag),
+// "owner:<name>" (match the owner, ignoring case), "owner:me" (match the
+// current user, see WithCurrentUser) and "owner:" (match unassigned
+// notifications), "tag:<a,b>" (match notifications tagged with any of
+// the listed tags; "tag:" matches untagged notifications) and "level:<a,b>"
+// (match notifications at any of the listed levels; "level:" alone matches
+// all). Each tag and level token must match on its own. Filters and text tokens compose: every one present must
+// match, and a scope left out of the query does not constrain the results.
 type TokenProvider struct {
 	opts Options
 }
 
+// ownerTokenPrefix starts a token that filters by owner.
+const ownerTokenPrefix = "owner:"
+
+// tagTokenPrefix starts a token that filters by tag.
+const tagTokenPrefix = "tag:"
+
+// levelTokenPrefix starts a token that filters by level.
+const levelTokenPrefix = "level:"
+
+// ownerMe is the owner filter value that stands for the current user.
+const ownerMe = "me"
+
 type tokenQuery struct {
-	readFilter   bool
-	unreadFilter bool
-	textTokens   []string
+	readFilter      bool
+	unreadFilter    bool
+	importantFilter *bool
+	ownerFilter     *string
+	tagFilters      [][]string
+	levelFilters    [][]string
+	textTokens      []string
 }
 
 // NewTokenProvider creates a new token search provider.
@@ -43,6 +68,19 @@ func (p *TokenProvider) Match(notif domain.Notification, query string) bool {
 	if !parsed.matchesReadFilter(notif) {
 		return false
 	}
+	if parsed.importantFilter != nil && notif.Important != *parsed.importantFilter {
+		return false
+	}
+	if parsed.ownerFilter != nil && !strings.EqualFold(notif.Owner, *parsed.ownerFilter) {
+		return false
+	}
+
+	if !parsed.matchesTagFilters(notif) {
+		return false
+	}
+	if !parsed.matchesLevelFilters(notif) {
+		return false
+	}
 
 	if len(parsed.textTokens) == 0 {
 		return true
@@ -67,7 +105,25 @@ func (p *TokenProvider) parseTokenQuery(query string) tokenQuery {
 			parsed.readFilter = true
 		case "unread":
 			parsed.unreadFilter = true
+		case "important:true", "important:false":
+			important := tokenLower == "important:true"
+			parsed.importantFilter = &important
 		default:
+			if strings.HasPrefix(tokenLower, ownerTokenPrefix) {
+				owner := p.resolveOwner(token[len(ownerTokenPrefix):])
+				parsed.ownerFilter = &owner
+				continue
+			}
+			if strings.HasPrefix(tokenLower, tagTokenPrefix) {
+				parsed.tagFilters = append(parsed.tagFilters, domain.ParseTags(tokenLower[len(tagTokenPrefix):]))
+				continue
+			}
+			if strings.HasPrefix(tokenLower, levelTokenPrefix) {
+				if levels := domain.ParseTags(tokenLower[len(levelTokenPrefix):]); len(levels) > 0 {
+					parsed.levelFilters = append(parsed.levelFilters, levels)
+				}
+				continue
+			}
 			if p.opts.CaseInsensitive {
 				parsed.textTokens = append(parsed.textTokens, strings.ToLower(token))
 			} else {
@@ -84,6 +140,50 @@ func (p *TokenProvider) parseTokenQuery(query string) tokenQuery {
 	return parsed
 }
 
+// matchesTagFilters reports whether notif carries one of the tags of every
+// tag token. An empty tag token matches untagged notifications.
+func (q tokenQuery) matchesTagFilters(notif domain.Notification) bool {
+	for _, tags := range q.tagFilters {
+		if len(tags) == 0 {
+			if len(notif.Tags) != 0 {
+				return false
+			}
+			continue
+		}
+		if !notif.HasAnyTag(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesLevelFilters reports whether notif has one of the levels of every
+// level token. A notification without a level counts as info.
+func (q tokenQuery) matchesLevelFilters(notif domain.Notification) bool {
+	level := notif.Level.String()
+	if level == "" {
+		level = domain.LevelInfo.String()
+	}
+	for _, levels := range q.levelFilters {
+		if !slices.Contains(levels, level) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveOwner replaces "me" with the current user when it can be found.
+func (p *TokenProvider) resolveOwner(owner string) string {
+	if !strings.EqualFold(owner, ownerMe) || p.opts.CurrentUser == nil {
+		return owner
+	}
+	name, err := p.opts.CurrentUser()
+	if err != nil || name == "" {
+		return owner
+	}
+	return name
+}
+
 func (q tokenQuery) matchesReadFilter(notif domain.Notification) bool {
 	if q.readFilter && !notif.IsRead() {
 		return false
@@ -125,11 +225,11 @@ func (p *TokenProvider) getFieldValues(notif domain.Notification, field string)
 	case "message":
 		return []string{notif.Message}
 	case "session":
-		return p.getFieldValuesWithNames(notif.Session, p.opts.SessionNames)
+		return p.getFieldValuesWithNames(notif.Session, p.opts.sessionNames())
 	case "window":
-		return p.getFieldValuesWithNames(notif.Window, p.opts.WindowNames)
+		return p.getFieldValuesWithNames(notif.Window, p.opts.windowNames())
 	case "pane":
-		return p.getFieldValuesWithNames(notif.Pane, p.opts.PaneNames)
+		return p.getFieldValuesWithNames(notif.Pane, p.opts.paneNames())
 	case "level":
 		return []string{notif.Level.String()}
 	case "state":
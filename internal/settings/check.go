@@ -0,0 +1,125 @@
+package settings
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Problem describes one invalid entry found in a settings file.
+type Problem struct {
+	// Line is the 1-based line of the offending key, or 0 when it is not in the file.
+	Line int
+	// Key is the dotted TOML key, e.g. "filters.level".
+	Key string
+	// Message explains what is wrong with the value.
+	Message string
+}
+
+// String formats the problem as "line N: key: message".
+func (p Problem) String() string {
+	location := "(default)"
+	if p.Line > 0 {
+		location = fmt.Sprintf("line %d", p.Line)
+	}
+	if p.Key == "" {
+		return fmt.Sprintf("%s: %s", location, p.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", location, p.Key, p.Message)
+}
+
+// Path returns the settings file path used by Load and Save.
+func Path() string {
+	config.Load()
+	return getSettingsPath()
+}
+
+// CheckFile reports every problem in the settings file at path, unlike Load,
+// which stops at the first invalid value. Unknown keys are reported too. A file
+// that is not valid TOML yields a single problem at the parse error position.
+func CheckFile(path string) ([]Problem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings file: %w", err)
+	}
+	data = convertCamelToSnake(data)
+
+	settings := DefaultSettings()
+	var problems []Problem
+	err = toml.NewDecoder(bytes.NewReader(data)).DisallowUnknownFields().Decode(settings)
+	var strictErr *toml.StrictMissingError
+	var decodeErr *toml.DecodeError
+	switch {
+	case err == nil:
+	case errors.As(err, &strictErr):
+		for _, unknown := range strictErr.Errors {
+			line, _ := unknown.Position()
+			problems = append(problems, Problem{Line: line, Key: strings.Join(unknown.Key(), "."), Message: "unknown setting"})
+		}
+	case errors.As(err, &decodeErr):
+		line, _ := decodeErr.Position()
+		return []Problem{{Line: line, Key: strings.Join(decodeErr.Key(), "."), Message: decodeErr.Error()}}, nil
+	default:
+		return nil, fmt.Errorf("failed to parse settings file: %w", err)
+	}
+
+	if tab := strings.ToLower(strings.TrimSpace(string(settings.ActiveTab))); tab != "" && !Tab(tab).IsValid() {
+		problems = append(problems, Problem{Key: "active_tab", Message: fmt.Sprintf("invalid activeTab value: %s", settings.ActiveTab)})
+	}
+	if settings.ViewMode == ViewModeCompact {
+		settings.ViewMode = ViewModeDetailed
+	}
+	settings.GroupHeader.normalize()
+	for _, problem := range checkValues(settings) {
+		problems = append(problems, Problem{Key: problem.key, Message: problem.err.Error()})
+	}
+
+	lines := keyLines(data)
+	for i := range problems {
+		if problems[i].Line == 0 {
+			problems[i].Line = lines[problems[i].Key]
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+	return problems, nil
+}
+
+// keyLines maps dotted keys to the line that assigns them. It understands
+// [table] headers and "key = value" lines, which covers files written by Save.
+func keyLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+	table := ""
+	for i, raw := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			table = strings.TrimSpace(strings.Trim(line, "[]"))
+			if _, ok := lines[table]; !ok {
+				lines[table] = i + 1
+			}
+			continue
+		}
+		key, _, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"`)
+		if table != "" {
+			key = table + "." + key
+		}
+		if _, ok := lines[key]; !ok {
+			lines[key] = i + 1
+		}
+	}
+	return lines
+}
@@ -0,0 +1,108 @@
+package settings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSettingsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tui.toml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestCheckFileReportsEveryProblem(t *testing.T) {
+	path := writeSettingsFile(t, `sort_by = "timestamp"
+group_by = "galaxy"
+default_expand_level = 7
+colour_scheme = "dark"
+active_tab = "inbox"
+
+[filters]
+level = "loud"
+
+[group_header]
+collapsed_glyph = "📁"
+`)
+
+	problems, err := CheckFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Problem{
+		{Line: 2, Key: "group_by", Message: "invalid groupBy value: galaxy"},
+		{Line: 3, Key: "default_expand_level", Message: "invalid defaultExpandLevel value: 7"},
+		{Line: 4, Key: "colour_scheme", Message: "unknown setting"},
+		{Line: 5, Key: "active_tab", Message: "invalid activeTab value: inbox"},
+		{Line: 8, Key: "filters.level", Message: "invalid filter level: loud"},
+		{Line: 11, Key: "group_header.collapsed_glyph", Message: `invalid groupHeader options: collapsed glyph "📁" must be a single-width character`},
+	}, problems)
+	assert.Equal(t, "line 2: group_by: invalid groupBy value: galaxy", problems[0].String())
+}
+
+func TestCheckFileAttributesGlyphProblemsToTheirKeys(t *testing.T) {
+	path := writeSettingsFile(t, `[group_header]
+expanded_glyph = "📂"
+
+[selection]
+cursor_glyph = "▶▶"
+`)
+
+	problems, err := CheckFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Problem{
+		{Line: 2, Key: "group_header.expanded_glyph", Message: `invalid groupHeader options: expanded glyph "📂" must be a single-width character`},
+		{Line: 5, Key: "selection.cursor_glyph", Message: `invalid selection options: cursor glyph "▶▶" must be a single-width character`},
+	}, problems)
+}
+
+func TestCheckFileReportsEachBadLevelRoute(t *testing.T) {
+	path := writeSettingsFile(t, `[level_routing]
+info = ["none"]
+warning = ["pager"]
+loud = ["sound"]
+critical = ["sound", "desktop", "webhook"]
+`)
+
+	problems, err := CheckFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Problem{
+		{Line: 3, Key: "level_routing.warning", Message: `invalid levelRouting channel for warning: "pager" (must be sound, desktop, webhook or none)`},
+		{Line: 4, Key: "level_routing.loud", Message: `invalid levelRouting level: "loud" (must be info, warning, error or critical)`},
+	}, problems)
+}
+
+func TestCheckFileValidFile(t *testing.T) {
+	path := writeSettingsFile(t, `view_mode = "compact"
+groupBy = "session"
+week_start = "sunday"
+`)
+
+	problems, err := CheckFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestCheckFileReportsParseErrorPosition(t *testing.T) {
+	path := writeSettingsFile(t, `sort_by = "timestamp"
+default_expand_level = "two"
+`)
+
+	problems, err := CheckFile(path)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, 2, problems[0].Line)
+	assert.Contains(t, problems[0].Message, "cannot decode TOML string")
+}
+
+func TestCheckFileMissingFile(t *testing.T) {
+	_, err := CheckFile(filepath.Join(t.TempDir(), "missing.toml"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read settings file")
+}
@@ -55,6 +55,7 @@ const (
 	SortByState     = "state"
 	SortByLevel     = "level"
 	SortBySession   = "session"
+	SortByPriority  = "priority"
 )
 
 // View mode constants.
@@ -63,6 +64,7 @@ const (
 	ViewModeDetailed = "detailed"
 	ViewModeGrouped  = "grouped"
 	ViewModeSearch   = "search"
+	ViewModeSummary  = "summary"
 )
 
 // Group by constants.
@@ -73,6 +75,28 @@ const (
 	GroupByPane        = "pane"
 	GroupByMessage     = "message"
 	GroupByPaneMessage = "pane_message"
+	GroupByDay         = "day"
+	GroupByWeek        = "week"
+)
+
+// GroupByModes lists every supported grouping mode.
+var GroupByModes = []string{
+	GroupByNone, GroupBySession, GroupByWindow, GroupByPane,
+	GroupByMessage, GroupByPaneMessage, GroupByDay, GroupByWeek,
+}
+
+// Week start constants used by week grouping.
+const (
+	WeekStartMonday = "monday"
+	WeekStartSunday = "sunday"
+)
+
+// Search Enter constants choose what Enter does in search view mode.
+const (
+	// SearchEnterStay jumps and keeps the search input active.
+	SearchEnterStay = "stay"
+	// SearchEnterExit jumps, then leaves search mode as Esc does.
+	SearchEnterExit = "exit"
 )
 
 // Expansion level limits.
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
 )
 
 const (
@@ -28,6 +29,16 @@ func getSettingsPath() string {
 	return settingsPath
 }
 
+// getSettingsLockPath returns the lock directory guarding the settings file.
+// By default the directory containing the settings file is locked; the
+// optional lock_path override moves the lock under a dedicated directory.
+func getSettingsLockPath(settingsPath string) string {
+	if lockDir := storage.GetLockDir(); lockDir != "" {
+		return filepath.Join(lockDir, "tui-settings.lock")
+	}
+	return filepath.Dir(settingsPath) + ".lock"
+}
+
 // resolveConfigDir returns the configured tmux-intray config directory,
 // falling back to the XDG default if needed.
 func resolveConfigDir() string {
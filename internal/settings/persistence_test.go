@@ -154,6 +154,22 @@ func TestSaveWithLocking(t *testing.T) {
 	assert.True(t, os.IsNotExist(err))
 }
 
+func TestSettingsLockHonorsLockPath(t *testing.T) {
+	lockRoot := filepath.Join(t.TempDir(), "locks")
+	t.Setenv("TMUX_INTRAY_LOCK_PATH", lockRoot)
+	configDir := setupSettingsTest(t)
+
+	settingsPath := filepath.Join(configDir, "tui.toml")
+	assert.Equal(t, filepath.Join(lockRoot, "tui-settings.lock"), getSettingsLockPath(settingsPath))
+
+	require.NoError(t, Save(DefaultSettings()))
+
+	_, err := os.Stat(filepath.Join(lockRoot, "tui-settings.lock"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(configDir + ".lock")
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestValidateSettings(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -5,13 +5,15 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/mattn/go-runewidth"
 	"github.com/pelletier/go-toml/v2"
 )
 
@@ -84,8 +86,25 @@ type GroupHeaderOptions struct {
 	// BadgeColors defines ANSI color codes per level key.
 	// Keys: info, warning, error, critical.
 	BadgeColors map[string]string `toml:"badge_colors"`
+
+	// CollapsedGlyph is the fold indicator shown before collapsed groups.
+	CollapsedGlyph string `toml:"collapsed_glyph"`
+
+	// ExpandedGlyph is the fold indicator shown before expanded groups.
+	ExpandedGlyph string `toml:"expanded_glyph"`
+
+	// FoldColor is the ANSI color code for fold indicators.
+	// Empty string renders them in the group row color.
+	FoldColor string `toml:"fold_color"`
 }
 
+const (
+	// DefaultCollapsedGlyph is the default fold indicator for collapsed groups.
+	DefaultCollapsedGlyph = "▸"
+	// DefaultExpandedGlyph is the default fold indicator for expanded groups.
+	DefaultExpandedGlyph = "▾"
+)
+
 // DefaultGroupHeaderOptions returns default rendering options for group headers.
 func DefaultGroupHeaderOptions() GroupHeaderOptions {
 	return GroupHeaderOptions{
@@ -93,6 +112,8 @@ func DefaultGroupHeaderOptions() GroupHeaderOptions {
 		ShowLevelBadges:       true,
 		ShowSourceAggregation: false,
 		BadgeColors:           defaultBadgeColors(),
+		CollapsedGlyph:        DefaultCollapsedGlyph,
+		ExpandedGlyph:         DefaultExpandedGlyph,
 	}
 }
 
@@ -103,6 +124,9 @@ func (o GroupHeaderOptions) Clone() GroupHeaderOptions {
 		ShowLevelBadges:       o.ShowLevelBadges,
 		ShowSourceAggregation: o.ShowSourceAggregation,
 		BadgeColors:           make(map[string]string, len(o.BadgeColors)),
+		CollapsedGlyph:        o.CollapsedGlyph,
+		ExpandedGlyph:         o.ExpandedGlyph,
+		FoldColor:             o.FoldColor,
 	}
 	for level, color := range o.BadgeColors {
 		clone.BadgeColors[level] = color
@@ -132,17 +156,61 @@ func (o *GroupHeaderOptions) normalize() {
 			o.BadgeColors[level] = color
 		}
 	}
+	if o.CollapsedGlyph == "" {
+		o.CollapsedGlyph = DefaultCollapsedGlyph
+	}
+	if o.ExpandedGlyph == "" {
+		o.ExpandedGlyph = DefaultExpandedGlyph
+	}
 }
 
-// Validate ensures the options structure is well-formed.
-func (o GroupHeaderOptions) Validate() error {
-	requiredLevels := []string{LevelFilterInfo, LevelFilterWarning, LevelFilterError, LevelFilterCritical}
-	for _, level := range requiredLevels {
+// Validate ensures the options structure is well-formed. On failure it also
+// returns the group_header key that holds the bad value.
+func (o GroupHeaderOptions) Validate() (string, error) {
+	for _, level := range badgeColorLevels {
 		if o.BadgeColors[level] == "" {
-			return fmt.Errorf("missing badge color for level: %s", level)
+			return "badge_colors", fmt.Errorf("missing badge color for level: %s", level)
 		}
 	}
-	return nil
+	if !isSingleWidthGlyph(o.CollapsedGlyph) {
+		return "collapsed_glyph", fmt.Errorf("collapsed glyph %q must be a single-width character", o.CollapsedGlyph)
+	}
+	if !isSingleWidthGlyph(o.ExpandedGlyph) {
+		return "expanded_glyph", fmt.Errorf("expanded glyph %q must be a single-width character", o.ExpandedGlyph)
+	}
+	return "", nil
+}
+
+// badgeColorLevels lists the levels that must have a badge color.
+var badgeColorLevels = []string{LevelFilterInfo, LevelFilterWarning, LevelFilterError, LevelFilterCritical}
+
+// SelectionOptions controls how the row under the cursor is drawn.
+type SelectionOptions struct {
+	// Background is the selected row background. Accepts an ANSI escape
+	// sequence or a lipgloss color ("4", "#005fd7"). Empty uses blue.
+	Background string `toml:"background"`
+
+	// Foreground is the selected row text color, in the same formats as
+	// Background. Empty uses black.
+	Foreground string `toml:"foreground"`
+
+	// CursorGlyph is drawn in a left gutter next to the selected row.
+	// Empty disables the gutter.
+	CursorGlyph string `toml:"cursor_glyph"`
+}
+
+// Validate ensures the selection options are well-formed. On failure it also
+// returns the selection key that holds the bad value.
+func (o SelectionOptions) Validate() (string, error) {
+	if o.CursorGlyph != "" && !isSingleWidthGlyph(o.CursorGlyph) {
+		return "cursor_glyph", fmt.Errorf("cursor glyph %q must be a single-width character", o.CursorGlyph)
+	}
+	return "", nil
+}
+
+// isSingleWidthGlyph reports whether glyph is one rune occupying one terminal cell.
+func isSingleWidthGlyph(glyph string) bool {
+	return utf8.RuneCountInString(glyph) == 1 && runewidth.StringWidth(glyph) == 1
 }
 
 // Settings holds TUI user preferences persisted to disk.
@@ -206,7 +274,8 @@ type Settings struct {
 	// Note: "compact" is deprecated and will be migrated to "detailed".
 	ViewMode string `toml:"view_mode"`
 
-	// GroupBy specifies the grouping mode: "none", "session", "window", "pane", "message", or "pane_message".
+	// GroupBy specifies the grouping mode: "none", "session", "window", "pane", "message", "pane_message",
+	// "day", or "week".
 	// Empty string means use default grouping (none).
 	GroupBy string `toml:"group_by"`
 
@@ -223,15 +292,133 @@ type Settings struct {
 	// GroupHeader configures group header rendering.
 	GroupHeader GroupHeaderOptions `toml:"group_header"`
 
+	// Selection configures the selected row colors and cursor gutter.
+	Selection SelectionOptions `toml:"selection"`
+
 	// ShowHelp controls whether to show help text in the footer.
 	// Defaults to true for backward compatibility.
 	ShowHelp bool `toml:"show_help"`
+
+	// SearchEmptyShowsAll controls what search view mode lists while the query is empty.
+	// When true, all notifications are shown; when false, nothing is shown until a query is typed.
+	// Defaults to true for backward compatibility.
+	SearchEmptyShowsAll bool `toml:"search_empty_shows_all"`
+
+	// AutoReadDwellSeconds marks the selected notification read after it stays
+	// under the cursor for this many seconds. Zero disables the behavior.
+	AutoReadDwellSeconds int `toml:"auto_read_dwell_seconds"`
+
+	// AgeDividers shows "Last hour", "Today", and "Earlier" divider lines
+	// between notifications in detailed view when sorted by timestamp.
+	// Defaults to false.
+	AgeDividers bool `toml:"age_dividers"`
+
+	// WrapNavigation makes moving past the last row jump to the first row
+	// and vice versa. Defaults to false (the cursor stops at the ends).
+	WrapNavigation bool `toml:"wrap_navigation"`
+
+	// DefaultLevel is the level `tmux-intray add` uses when --level is omitted.
+	// Valid values: "info", "warning", "error", "critical". Defaults to "info".
+	DefaultLevel string `toml:"default_level"`
+
+	// ActiveCountWarning shows a cleanup banner in the TUI header once the
+	// number of active notifications reaches this value. Zero disables it.
+	ActiveCountWarning int `toml:"active_count_warning"`
+
+	// ConfirmDismissAll asks for confirmation, showing the active count, before
+	// the TUI ":dismiss-all" command dismisses every active notification.
+	// Defaults to true.
+	ConfirmDismissAll bool `toml:"confirm_dismiss_all"`
+
+	// WeekStart is the first day of a week bucket when GroupBy is "week":
+	// "monday" (ISO weeks) or "sunday".
+	WeekStart string `toml:"week_start"`
+
+	// AutoRefreshSeconds reloads notifications from storage every this many
+	// seconds while the TUI is open. Zero disables it.
+	AutoRefreshSeconds int `toml:"auto_refresh_seconds"`
+
+	// NotifyMinLevel is the lowest level delivered by `tmux-intray add`. Adds
+	// below it are stored without running post-add hooks, which is where
+	// desktop and sound delivery happens. Empty delivers every level.
+	NotifyMinLevel string `toml:"notify_min_level"`
+
+	// LevelRouting maps a level to the delivery channels ("sound", "desktop",
+	// "webhook") its adds use, e.g. info = ["none"], critical = ["sound",
+	// "desktop", "webhook"]. Add hooks see the channels in $CHANNELS. Levels
+	// missing from the table use every channel.
+	LevelRouting map[string][]string `toml:"level_routing"`
+
+	// FlashNewNotifications briefly highlights the table header when an
+	// auto-refresh brings in new notifications. Defaults to false.
+	FlashNewNotifications bool `toml:"flash_new_notifications"`
+
+	// ConfirmSaveOnQuit asks "Save view changes?" when quitting the TUI with
+	// sort, filter or view changes, instead of saving them as they happen.
+	// Defaults to false.
+	ConfirmSaveOnQuit bool `toml:"confirm_save_on_quit"`
+
+	// AutoSaveSeconds saves view changes every this many seconds while the
+	// TUI is open, so a crash loses at most one interval. Zero disables it.
+	AutoSaveSeconds int `toml:"auto_save_seconds"`
+
+	// GroupUnassigned collects notifications with no session, window or pane
+	// under one "(unassigned)" group in grouped views. Defaults to true.
+	GroupUnassigned bool `toml:"group_unassigned"`
+
+	// MaxTreeDepth caps how many levels the grouped view shows. Deeper levels
+	// collapse into a "+N more" row that expands on demand. Zero shows all.
+	MaxTreeDepth int `toml:"max_tree_depth"`
+
+	// ShowTabLabel adds a separator line above the table header naming the
+	// active tab and how many notifications it lists. Defaults to false.
+	ShowTabLabel bool `toml:"show_tab_label"`
+
+	// ConfirmCrossSessionJump asks before a TUI jump switches to a session
+	// other than the one the TUI runs in. Defaults to false.
+	ConfirmCrossSessionJump bool `toml:"confirm_cross_session_jump"`
+
+	// SearchAliases maps alias names to search queries. Typing "@name" in
+	// search expands to the query, e.g. errors = "level:error unread".
+	SearchAliases map[string]string `toml:"search_aliases"`
+
+	// ShowHeader shows the tabs and table header above the list. Defaults to true.
+	ShowHeader bool `toml:"show_header"`
+
+	// ShowFooter shows the footer below the list. Defaults to true.
+	ShowFooter bool `toml:"show_footer"`
+
+	// SourceGutter draws a bar left of each notification row, colored by
+	// its session so rows from the same source share a color.
+	SourceGutter bool `toml:"source_gutter"`
+
+	// SearchEnter chooses what Enter does while typing a search: "stay"
+	// jumps and keeps the search input active, "exit" jumps and then leaves
+	// search mode as Esc does. Defaults to "stay".
+	SearchEnter string `toml:"search_enter"`
+}
+
+// ChannelsForLevel returns the delivery channels routing lists for level,
+// never nil. A level missing from routing gets every channel; one routed to
+// "none" or [] gets none.
+func ChannelsForLevel(routing map[string][]string, level string) []string {
+	channels, ok := routing[level]
+	if !ok {
+		return append([]string(nil), domain.AllChannels...)
+	}
+	routed := []string{}
+	for _, channel := range channels {
+		if channel != domain.ChannelNone {
+			routed = append(routed, channel)
+		}
+	}
+	return routed
 }
 
 // DefaultSettings returns settings with all default values.
 func DefaultSettings() *Settings {
 	return &Settings{
-		Columns:     DefaultColumns,
+		Columns:     append([]string(nil), DefaultColumns...), // copy so decoding cannot mutate the shared default
 		SortBy:      SortByTimestamp,
 		SortOrder:   SortOrderDesc,
 		UnreadFirst: true, // Default to true to maintain current behavior (unread first)
@@ -244,13 +431,29 @@ func DefaultSettings() *Settings {
 			Window:  "",
 			Pane:    "",
 		},
-		ViewMode:           ViewModeSearch,
-		GroupBy:            GroupByNone,
-		DefaultExpandLevel: 1,
-		AutoExpandUnread:   false, // Default to false to avoid unexpected behavior
-		ExpansionState:     map[string]bool{},
-		GroupHeader:        DefaultGroupHeaderOptions(),
-		ShowHelp:           true,
+		ViewMode:             ViewModeSearch,
+		GroupBy:              GroupByNone,
+		DefaultExpandLevel:   1,
+		AutoExpandUnread:     false, // Default to false to avoid unexpected behavior
+		ExpansionState:       map[string]bool{},
+		GroupHeader:          DefaultGroupHeaderOptions(),
+		ShowHelp:             true,
+		SearchEmptyShowsAll:  true,
+		AutoReadDwellSeconds: 0, // Disabled by default
+		DefaultLevel:         LevelFilterInfo,
+		ActiveCountWarning:   200,
+		ConfirmDismissAll:    true,
+		WeekStart:            WeekStartMonday,
+		AutoRefreshSeconds:   0, // Disabled by default
+		AutoSaveSeconds:      0, // Disabled by default
+		GroupUnassigned:      true,
+		MaxTreeDepth:         0, // Unlimited by default
+		SearchAliases:        map[string]string{},
+		LevelRouting:         map[string][]string{},
+		ShowHeader:           true,
+		ShowFooter:           true,
+		SourceGutter:         false, // Disabled by default
+		SearchEnter:          SearchEnterStay,
 	}
 }
 
@@ -273,9 +476,7 @@ func Load() (*Settings, error) {
 	var loadErr error
 
 	// Use file locking to prevent concurrent access
-	// Lock the directory containing the settings file, not the file itself
-	settingsDir := filepath.Dir(settingsPath)
-	err := storage.WithLock(settingsDir+".lock", func() error {
+	err := storage.WithLock(getSettingsLockPath(settingsPath), func() error {
 		// Read and parse settings file
 		data, err := os.ReadFile(settingsPath)
 		if err != nil {
@@ -344,9 +545,7 @@ func Save(settings *Settings) error {
 	colors.Debug("Saving settings to:", settingsPath)
 
 	// Use file locking to prevent concurrent access
-	// Lock the directory containing the settings file, not the file itself
-	settingsDir := filepath.Dir(settingsPath)
-	return storage.WithLock(settingsDir+".lock", func() error {
+	return storage.WithLock(getSettingsLockPath(settingsPath), func() error {
 		// Write to temporary file first for atomic operation
 		tempPath := settingsPath + ".tmp." + strconv.Itoa(rand.Intn(1000000))
 		if err := os.WriteFile(tempPath, data, FileModeFile); err != nil {
@@ -396,9 +595,7 @@ func Reset() (*Settings, error) {
 	colors.Debug("Resetting settings to defaults")
 
 	// Use file locking to prevent concurrent access
-	// Lock the directory containing the settings file, not the file itself
-	settingsDir := filepath.Dir(settingsPath)
-	err := storage.WithLock(settingsDir+".lock", func() error {
+	err := storage.WithLock(getSettingsLockPath(settingsPath), func() error {
 		// Check if file exists
 		if _, err := os.Stat(settingsPath); os.IsNotExist(err) {
 			// File doesn't exist, nothing to do
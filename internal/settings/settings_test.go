@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/config"
@@ -48,6 +49,71 @@ func TestDefaultSettings(t *testing.T) {
 	assert.Equal(t, colors.Yellow, s.GroupHeader.BadgeColors[LevelFilterWarning])
 	assert.Equal(t, colors.Red, s.GroupHeader.BadgeColors[LevelFilterError])
 	assert.Equal(t, colors.Red, s.GroupHeader.BadgeColors[LevelFilterCritical])
+	assert.Equal(t, "▸", s.GroupHeader.CollapsedGlyph)
+	assert.Equal(t, "▾", s.GroupHeader.ExpandedGlyph)
+	assert.Empty(t, s.GroupHeader.FoldColor)
+
+	// Selection uses the built-in colors and no cursor gutter
+	assert.Equal(t, SelectionOptions{}, s.Selection)
+
+	// Check search settings
+	assert.True(t, s.SearchEmptyShowsAll)
+
+	// Auto-read on dwell is off by default
+	assert.Equal(t, 0, s.AutoReadDwellSeconds)
+
+	// Age dividers are off by default
+	assert.False(t, s.AgeDividers)
+
+	// Navigation does not wrap by default
+	assert.False(t, s.WrapNavigation)
+
+	// Cleanup banner shows from 200 active notifications
+	assert.Equal(t, 200, s.ActiveCountWarning)
+	assert.True(t, s.ConfirmDismissAll)
+
+	// Week grouping uses ISO weeks by default
+	assert.Equal(t, WeekStartMonday, s.WeekStart)
+
+	// Auto-refresh and the new-notification flash are off by default
+	assert.Equal(t, 0, s.AutoRefreshSeconds)
+	assert.False(t, s.FlashNewNotifications)
+
+	// View changes are saved as they happen unless quit asks first
+	assert.False(t, s.ConfirmSaveOnQuit)
+	assert.Equal(t, 0, s.AutoSaveSeconds)
+	assert.Equal(t, "", s.NotifyMinLevel)
+
+	// Location-less notifications share one group
+	assert.True(t, s.GroupUnassigned)
+	assert.Equal(t, 0, s.MaxTreeDepth)
+
+	// The active tab is named only by the tabs line
+	assert.False(t, s.ShowTabLabel)
+
+	// Jumps switch sessions without asking
+	assert.False(t, s.ConfirmCrossSessionJump)
+
+	// No search aliases are defined
+	assert.Empty(t, s.SearchAliases)
+
+	// The header and footer are shown
+	assert.True(t, s.ShowHeader)
+	assert.True(t, s.ShowFooter)
+	assert.False(t, s.SourceGutter)
+
+	// Enter in search jumps and keeps the search input active
+	assert.Equal(t, SearchEnterStay, s.SearchEnter)
+
+	// Add falls back to info when no level is given
+	assert.Equal(t, LevelFilterInfo, s.DefaultLevel)
+}
+
+func TestDefaultSettingsCopiesDefaultColumns(t *testing.T) {
+	s := DefaultSettings()
+	s.Columns[0] = "changed"
+
+	assert.NotEqual(t, "changed", DefaultColumns[0])
 }
 
 func TestLoadDefaultWhenFileDoesNotExist(t *testing.T) {
@@ -110,6 +176,9 @@ func TestLoadFromExistingFile(t *testing.T) {
 				LevelFilterError:    colors.Red,
 				LevelFilterCritical: colors.Red,
 			},
+			CollapsedGlyph: "+",
+			ExpandedGlyph:  "-",
+			FoldColor:      colors.Green,
 		},
 	}
 
@@ -273,6 +342,23 @@ func TestLoadInvalidColumn(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid column name")
 }
 
+func TestLoadInvalidDefaultLevel(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+	t.Setenv("HOME", tmpDir)
+
+	configDir := filepath.Join(tmpDir, "tmux-intray")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+
+	settingsPath := filepath.Join(configDir, "tui.toml")
+	require.NoError(t, os.WriteFile(settingsPath, []byte("default_level = \"loud\"\n"), 0644))
+	t.Cleanup(func() { _ = os.Remove(settingsPath) })
+
+	_, err := Load()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid defaultLevel value")
+}
+
 // Note: Validation is tested thoroughly in TestValidateInvalidSettings.
 // File loading validation tests are omitted due to config package state isolation issues.
 // The validation logic itself is confirmed to work correctly via the validate() function tests.
@@ -580,6 +666,118 @@ func TestValidateInvalidSettings(t *testing.T) {
 			},
 			wantErr: "invalid defaultExpandLevel value",
 		},
+		{
+			name: "negative autoReadDwellSeconds",
+			settings: &Settings{
+				AutoReadDwellSeconds: -1,
+			},
+			wantErr: "invalid autoReadDwellSeconds value",
+		},
+		{
+			name: "negative activeCountWarning",
+			settings: &Settings{
+				ActiveCountWarning: -1,
+			},
+			wantErr: "invalid activeCountWarning value",
+		},
+		{
+			name: "invalid weekStart",
+			settings: &Settings{
+				WeekStart: "friday",
+			},
+			wantErr: "invalid weekStart value",
+		},
+		{
+			name: "invalid searchEnter",
+			settings: &Settings{
+				SearchEnter: "close",
+			},
+			wantErr: "invalid searchEnter value",
+		},
+		{
+			name: "negative autoRefreshSeconds",
+			settings: &Settings{
+				AutoRefreshSeconds: -1,
+			},
+			wantErr: "invalid autoRefreshSeconds value",
+		},
+		{
+			name: "negative autoSaveSeconds",
+			settings: &Settings{
+				AutoSaveSeconds: -1,
+			},
+			wantErr: "invalid autoSaveSeconds value",
+		},
+		{
+			name: "negative maxTreeDepth",
+			settings: &Settings{
+				MaxTreeDepth: -1,
+			},
+			wantErr: "invalid maxTreeDepth value",
+		},
+		{
+			name: "recursive search alias",
+			settings: &Settings{
+				SearchAliases: map[string]string{"a": "@b", "b": "@a"},
+			},
+			wantErr: "search alias @a -> @b -> @a expands to itself",
+		},
+		{
+			name: "invalid notifyMinLevel",
+			settings: &Settings{
+				NotifyMinLevel: "loud",
+			},
+			wantErr: "invalid notifyMinLevel value",
+		},
+		{
+			name: "unknown levelRouting level",
+			settings: &Settings{
+				LevelRouting: map[string][]string{"loud": {"sound"}},
+			},
+			wantErr: `invalid levelRouting level: "loud"`,
+		},
+		{
+			name: "unknown levelRouting channel",
+			settings: &Settings{
+				LevelRouting: map[string][]string{"error": {"sound", "pager"}},
+			},
+			wantErr: `invalid levelRouting channel for error: "pager"`,
+		},
+		{
+			name: "levelRouting none with channels",
+			settings: &Settings{
+				LevelRouting: map[string][]string{"info": {"none", "sound"}},
+			},
+			wantErr: `"none" cannot be combined with other channels`,
+		},
+		{
+			name: "double-width collapsed glyph",
+			settings: &Settings{
+				GroupHeader: GroupHeaderOptions{CollapsedGlyph: "📁"},
+			},
+			wantErr: "collapsed glyph \"📁\" must be a single-width character",
+		},
+		{
+			name: "multi-character expanded glyph",
+			settings: &Settings{
+				GroupHeader: GroupHeaderOptions{ExpandedGlyph: "v>"},
+			},
+			wantErr: "expanded glyph \"v>\" must be a single-width character",
+		},
+		{
+			name: "double-width cursor glyph",
+			settings: &Settings{
+				Selection: SelectionOptions{CursorGlyph: "👉"},
+			},
+			wantErr: "cursor glyph \"👉\" must be a single-width character",
+		},
+		{
+			name: "invalid defaultLevel",
+			settings: &Settings{
+				DefaultLevel: "loud",
+			},
+			wantErr: "invalid defaultLevel value",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1129,3 +1327,25 @@ sort_order = "desc"
 		assert.Equal(t, SortOrderAsc, loaded.SortOrder)
 	})
 }
+
+func TestWeekStartDay(t *testing.T) {
+	assert.Equal(t, time.Monday, WeekStartDay(""))
+	assert.Equal(t, time.Monday, WeekStartDay(WeekStartMonday))
+	assert.Equal(t, time.Sunday, WeekStartDay(WeekStartSunday))
+}
+
+func TestChannelsForLevel(t *testing.T) {
+	routing := map[string][]string{
+		"info":     {"none"},
+		"warning":  {},
+		"error":    {"sound", "desktop"},
+		"critical": {"sound", "desktop", "webhook"},
+	}
+
+	assert.Equal(t, []string{}, ChannelsForLevel(routing, "info"))
+	assert.Equal(t, []string{}, ChannelsForLevel(routing, "warning"))
+	assert.Equal(t, []string{"sound", "desktop"}, ChannelsForLevel(routing, "error"))
+	assert.Equal(t, []string{"sound", "desktop", "webhook"}, ChannelsForLevel(routing, "critical"))
+	assert.Equal(t, []string{"sound", "desktop", "webhook"}, ChannelsForLevel(map[string][]string{"error": {"sound"}}, "info"), "unrouted levels use every channel")
+	assert.Equal(t, []string{"sound", "desktop", "webhook"}, ChannelsForLevel(nil, "info"))
+}
@@ -1,6 +1,13 @@
 package settings
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/search"
+)
 
 // Validate checks that settings values are valid.
 // Preconditions: settings must be non-nil.
@@ -18,32 +25,73 @@ func Validate(settings *Settings) error {
 	}
 
 	settings.GroupHeader.normalize()
-	if err := settings.GroupHeader.Validate(); err != nil {
-		return fmt.Errorf("invalid groupHeader options: %w", err)
+	if problems := checkValues(settings); len(problems) > 0 {
+		return problems[0].err
+	}
+
+	return nil
+}
+
+// valueProblem is an invalid settings value and the TOML key it was read from.
+type valueProblem struct {
+	key string
+	err error
+}
+
+// checkValues runs every value check and returns all failures in the order
+// Validate reports them. Normalization must already have been applied.
+func checkValues(settings *Settings) []valueProblem {
+	var problems []valueProblem
+	add := func(key string, err error) {
+		if err != nil {
+			problems = append(problems, valueProblem{key: key, err: err})
+		}
 	}
-	if err := validateColumns(settings.Columns); err != nil {
-		return err
+
+	if key, err := settings.GroupHeader.Validate(); err != nil {
+		add("group_header."+key, fmt.Errorf("invalid groupHeader options: %w", err))
 	}
-	if err := validateSortBy(settings.SortBy); err != nil {
-		return err
+	if key, err := settings.Selection.Validate(); err != nil {
+		add("selection."+key, fmt.Errorf("invalid selection options: %w", err))
 	}
-	if err := validateSortOrder(settings.SortOrder); err != nil {
-		return err
+	add("columns", validateColumns(settings.Columns))
+	add("sort_by", validateSortBy(settings.SortBy))
+	add("sort_order", validateSortOrder(settings.SortOrder))
+	add("view_mode", validateViewMode(settings.ViewMode))
+	add("group_by", validateGroupBySetting(settings.GroupBy))
+	add("default_expand_level", validateExpandLevel(settings.DefaultExpandLevel))
+	add("filters.level", validateFilters(Filter{Level: settings.Filters.Level}))
+	add("filters.state", validateFilters(Filter{State: settings.Filters.State}))
+	add("filters.read", validateFilters(Filter{Read: settings.Filters.Read}))
+	if settings.AutoReadDwellSeconds < 0 {
+		add("auto_read_dwell_seconds", fmt.Errorf("invalid autoReadDwellSeconds value: %d (must be >= 0)", settings.AutoReadDwellSeconds))
 	}
-	if err := validateViewMode(settings.ViewMode); err != nil {
-		return err
+	add("default_level", validateDefaultLevel(settings.DefaultLevel))
+	add("week_start", validateWeekStart(settings.WeekStart))
+	add("search_enter", validateSearchEnter(settings.SearchEnter))
+	add("notify_min_level", validateNotifyMinLevel(settings.NotifyMinLevel))
+	levels := make([]string, 0, len(settings.LevelRouting))
+	for level := range settings.LevelRouting {
+		levels = append(levels, level)
 	}
-	if err := validateGroupBySetting(settings.GroupBy); err != nil {
-		return err
+	sort.Strings(levels)
+	for _, level := range levels {
+		add("level_routing."+level, validateLevelRoute(level, settings.LevelRouting[level]))
 	}
-	if err := validateExpandLevel(settings.DefaultExpandLevel); err != nil {
-		return err
+	if settings.ActiveCountWarning < 0 {
+		add("active_count_warning", fmt.Errorf("invalid activeCountWarning value: %d (must be >= 0)", settings.ActiveCountWarning))
 	}
-	if err := validateFilters(settings.Filters); err != nil {
-		return err
+	if settings.AutoRefreshSeconds < 0 {
+		add("auto_refresh_seconds", fmt.Errorf("invalid autoRefreshSeconds value: %d (must be >= 0)", settings.AutoRefreshSeconds))
 	}
-
-	return nil
+	if settings.AutoSaveSeconds < 0 {
+		add("auto_save_seconds", fmt.Errorf("invalid autoSaveSeconds value: %d (must be >= 0)", settings.AutoSaveSeconds))
+	}
+	if settings.MaxTreeDepth < 0 {
+		add("max_tree_depth", fmt.Errorf("invalid maxTreeDepth value: %d (must be >= 0)", settings.MaxTreeDepth))
+	}
+	add("search_aliases", search.ValidateAliases(settings.SearchAliases))
+	return problems
 }
 
 func validateColumns(columns []string) error {
@@ -69,7 +117,7 @@ func validateSortBy(sortBy string) error {
 	}
 	validSortBy := map[string]bool{
 		SortByID: true, SortByTimestamp: true, SortByState: true,
-		SortByLevel: true, SortBySession: true,
+		SortByLevel: true, SortBySession: true, SortByPriority: true,
 	}
 	if !validSortBy[sortBy] {
 		return fmt.Errorf("invalid sortBy value: %s", sortBy)
@@ -92,7 +140,7 @@ func validateViewMode(mode string) error {
 		return nil
 	}
 	switch mode {
-	case ViewModeDetailed, ViewModeGrouped, ViewModeSearch:
+	case ViewModeDetailed, ViewModeGrouped, ViewModeSearch, ViewModeSummary:
 		return nil
 	default:
 		return fmt.Errorf("invalid viewMode value: %s", mode)
@@ -116,6 +164,73 @@ func validateExpandLevel(level int) error {
 	return nil
 }
 
+func validateDefaultLevel(level string) error {
+	switch level {
+	case "", LevelFilterInfo, LevelFilterWarning, LevelFilterError, LevelFilterCritical:
+		return nil
+	default:
+		return fmt.Errorf("invalid defaultLevel value: %s", level)
+	}
+}
+
+func validateNotifyMinLevel(level string) error {
+	switch level {
+	case "", LevelFilterInfo, LevelFilterWarning, LevelFilterError, LevelFilterCritical:
+		return nil
+	default:
+		return fmt.Errorf("invalid notifyMinLevel value: %s", level)
+	}
+}
+
+// validateLevelRoute checks one level_routing entry: a known level routed to
+// known channels, or to "none" alone.
+func validateLevelRoute(level string, channels []string) error {
+	switch level {
+	case LevelFilterInfo, LevelFilterWarning, LevelFilterError, LevelFilterCritical:
+	default:
+		return fmt.Errorf("invalid levelRouting level: %q (must be info, warning, error or critical)", level)
+	}
+	for _, channel := range channels {
+		if channel == domain.ChannelNone {
+			if len(channels) > 1 {
+				return fmt.Errorf("invalid levelRouting for %s: %q cannot be combined with other channels", level, domain.ChannelNone)
+			}
+			continue
+		}
+		if !domain.IsValidChannel(channel) {
+			return fmt.Errorf("invalid levelRouting channel for %s: %q (must be sound, desktop, webhook or none)", level, channel)
+		}
+	}
+	return nil
+}
+
+func validateWeekStart(weekStart string) error {
+	switch weekStart {
+	case "", WeekStartMonday, WeekStartSunday:
+		return nil
+	default:
+		return fmt.Errorf("invalid weekStart value: %s (must be %q or %q)", weekStart, WeekStartMonday, WeekStartSunday)
+	}
+}
+
+func validateSearchEnter(searchEnter string) error {
+	switch searchEnter {
+	case "", SearchEnterStay, SearchEnterExit:
+		return nil
+	default:
+		return fmt.Errorf("invalid searchEnter value: %s (must be %q or %q)", searchEnter, SearchEnterStay, SearchEnterExit)
+	}
+}
+
+// WeekStartDay returns the weekday that opens a week for the given week_start
+// setting. Anything other than "sunday" starts weeks on Monday (ISO 8601).
+func WeekStartDay(weekStart string) time.Weekday {
+	if weekStart == WeekStartSunday {
+		return time.Sunday
+	}
+	return time.Monday
+}
+
 func validateFilters(filter Filter) error {
 	validLevels := map[string]bool{
 		"": true, LevelFilterInfo: true, LevelFilterWarning: true,
@@ -147,7 +262,7 @@ func IsValidGroupBy(groupBy string) bool {
 	switch groupBy {
 	case GroupByNone, GroupBySession, GroupByWindow, GroupByPane, GroupByMessage:
 		return true
-	case GroupByPaneMessage:
+	case GroupByPaneMessage, GroupByDay, GroupByWeek:
 		return true
 	default:
 		return false
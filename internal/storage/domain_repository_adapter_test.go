@@ -45,6 +45,11 @@ func (m *MockStorage) DismissByFilter(session, window, pane string) error {
 	return args.Error(0)
 }
 
+func (m *MockStorage) UndismissNotification(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockStorage) MarkNotificationRead(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -55,11 +60,42 @@ func (m *MockStorage) MarkNotificationUnread(id string) error {
 	return args.Error(0)
 }
 
+func (m *MockStorage) SetImportant(id string, important bool) error {
+	args := m.Called(id, important)
+	return args.Error(0)
+}
+
+func (m *MockStorage) AssignNotification(id, owner string) error {
+	args := m.Called(id, owner)
+	return args.Error(0)
+}
+
 func (m *MockStorage) CleanupOldNotifications(daysThreshold int, dryRun bool) error {
 	args := m.Called(daysThreshold, dryRun)
 	return args.Error(0)
 }
 
+func (m *MockStorage) MuteSession(session string) error {
+	args := m.Called(session)
+	return args.Error(0)
+}
+
+func (m *MockStorage) UnmuteSession(session string) error {
+	args := m.Called(session)
+	return args.Error(0)
+}
+
+func (m *MockStorage) ListMutedSessions() ([]string, error) {
+	args := m.Called()
+	sessions, _ := args.Get(0).([]string)
+	return sessions, args.Error(1)
+}
+
+func (m *MockStorage) EscalateStale() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockStorage) GetActiveCount() int {
 	args := m.Called()
 	return args.Int(0)
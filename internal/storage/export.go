@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/notification"
+)
+
+// csvHeader names the ExportCSV columns. It follows the TSV field order and
+// must only ever grow at the end, so scripts can rely on column positions.
+var csvHeader = []string{
+	"id", "timestamp", "state", "session", "window", "pane", "message", "pane_created",
+	"level", "read_timestamp", "important", "color", "owner", "snoozed_until", "priority", "tags",
+	"occurrences",
+}
+
+// ExportJSON writes the notifications matching f as a JSON array of
+// notification.Notification objects using the default storage backend.
+// Messages are written unescaped. Each notification is encoded and written on
+// its own line as it is reached, rather than building the whole document.
+func ExportJSON(w io.Writer, f ListFilter) error {
+	notifications, err := listForExport(f)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return fmt.Errorf("export json: %w", err)
+	}
+	for i, n := range notifications {
+		data, err := json.Marshal(n)
+		if err != nil {
+			return fmt.Errorf("export json: notification %d: %w", n.ID, err)
+		}
+		separator := ",\n  "
+		if i == 0 {
+			separator = "\n  "
+		}
+		if _, err := io.WriteString(w, separator+string(data)); err != nil {
+			return fmt.Errorf("export json: %w", err)
+		}
+	}
+	closing := "]\n"
+	if len(notifications) > 0 {
+		closing = "\n]\n"
+	}
+	if _, err := io.WriteString(w, closing); err != nil {
+		return fmt.Errorf("export json: %w", err)
+	}
+	return nil
+}
+
+// ExportCSV writes the notifications matching f as CSV using the default
+// storage backend. The first row is the csvHeader column names. Messages are
+// written unescaped, important is "true" or "false", and tags are joined with
+// commas.
+func ExportCSV(w io.Writer, f ListFilter) error {
+	notifications, err := listForExport(f)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("export csv: %w", err)
+	}
+	for _, n := range notifications {
+		if err := writer.Write(csvRecord(n)); err != nil {
+			return fmt.Errorf("export csv: notification %d: %w", n.ID, err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("export csv: %w", err)
+	}
+	return nil
+}
+
+// importStore is implemented by storage backends that can append exported
+// notifications.
+type importStore interface {
+	ImportNotifications(notifications []domain.Notification, regenerateIDs bool) (int, error)
+}
+
+// ImportJSON reads a JSON array written by ExportJSON and appends its
+// notifications using the default storage backend, returning how many were
+// imported. Each notification is validated like a new one, and the import
+// stores either all of them or none. With regenerateIDs every notification
+// gets a fresh ID; otherwise exported IDs are kept and an ID that already
+// exists fails the import.
+func ImportJSON(r io.Reader, regenerateIDs bool) (int, error) {
+	var notifications []notification.Notification
+	if err := json.NewDecoder(r).Decode(&notifications); err != nil {
+		return 0, fmt.Errorf("import json: %w", err)
+	}
+
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	importer, ok := store.(importStore)
+	if !ok {
+		return 0, fmt.Errorf("import json: storage does not support imports")
+	}
+
+	records := make([]domain.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		records = append(records, *notification.ToDomainUnsafe(n))
+	}
+	count, err := importer.ImportNotifications(records, regenerateIDs)
+	if err != nil {
+		return 0, fmt.Errorf("import json: %w", err)
+	}
+	return count, nil
+}
+
+// listForExport lists and parses the notifications matching f.
+func listForExport(f ListFilter) ([]notification.Notification, error) {
+	lines, err := ListWithFilter(f)
+	if err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+	return notification.ParseNotifications(lines), nil
+}
+
+func csvRecord(n notification.Notification) []string {
+	return []string{
+		strconv.Itoa(n.ID),
+		n.Timestamp,
+		n.State,
+		n.Session,
+		n.Window,
+		n.Pane,
+		n.Message,
+		n.PaneCreated,
+		n.Level,
+		n.ReadTimestamp,
+		strconv.FormatBool(n.Important),
+		n.Color,
+		n.Owner,
+		n.SnoozedUntil,
+		strconv.Itoa(n.Priority),
+		strings.Join(n.Tags, ","),
+		strconv.Itoa(n.Occurrences),
+	}
+}
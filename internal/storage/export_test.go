@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportJSONWritesFilteredNotifications(t *testing.T) {
+	setupStorageTest(t)
+
+	failed, err := AddNotification("build failed\n\tsee log", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	_, err = AddNotification("tests passed", "2025-01-02T10:00:00Z", "$2", "@2", "%2", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, MarkNotificationRead(failed))
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportJSON(&buf, ListFilter{Level: "error"}))
+
+	var exported []notification.Notification
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &exported))
+	require.Len(t, exported, 1)
+	assert.Equal(t, 1, exported[0].ID)
+	assert.Equal(t, "build failed\n\tsee log", exported[0].Message, "messages are unescaped")
+	assert.Equal(t, "$1", exported[0].Session)
+	assert.NotEmpty(t, exported[0].ReadTimestamp)
+
+	buf.Reset()
+	require.NoError(t, ExportJSON(&buf, ListFilter{}))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &exported))
+	assert.Len(t, exported, 2)
+
+	buf.Reset()
+	require.NoError(t, ExportJSON(&buf, ListFilter{Session: "$9"}))
+	assert.Equal(t, "[]\n", buf.String())
+
+	assert.Error(t, ExportJSON(&buf, ListFilter{Level: "bogus"}))
+}
+
+func TestExportCSVWritesHeaderAndRows(t *testing.T) {
+	setupStorageTest(t)
+
+	_, err := AddNotificationWithOptions("deploy \"prod\", step 2\nfailed", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "error", domain.AddOptions{Tags: []string{"deploy", "ci"}})
+	require.NoError(t, err)
+	_, err = AddNotification("tests passed", "2025-01-02T10:00:00Z", "$2", "@2", "%2", "", "info")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportCSV(&buf, ListFilter{Session: "$1"}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, csvHeader, records[0])
+	assert.Len(t, records[0], NumFields, "one column per TSV field")
+	assert.Equal(t, []string{
+		"1", "2025-01-01T10:00:00Z", "active", "$1", "@1", "%1", "deploy \"prod\", step 2\nfailed", "",
+		"error", "", "false", "", "", "", "0", "ci,deploy", "0",
+	}, records[1])
+
+	buf.Reset()
+	require.NoError(t, ExportCSV(&buf, ListFilter{Level: "critical"}))
+	assert.Equal(t, "id,timestamp,state,session,window,pane,message,pane_created,level,read_timestamp,important,color,owner,snoozed_until,priority,tags,occurrences\n", buf.String())
+}
+
+func TestImportJSONRoundTripsExport(t *testing.T) {
+	setupStorageTest(t)
+
+	added, err := AddNotificationWithOptions("deploy\tfailed", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "error", domain.AddOptions{Tags: []string{"deploy"}})
+	require.NoError(t, err)
+	first := added.ID
+	_, err = AddNotification("tests passed", "2025-01-02T10:00:00Z", "$2", "@2", "%2", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, MarkNotificationRead(first))
+	require.NoError(t, DismissNotification(first))
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportJSON(&buf, ListFilter{State: "all"}))
+	exported := buf.String()
+
+	_, err = ImportJSON(strings.NewReader(exported), false)
+	require.ErrorContains(t, err, "id already exists")
+
+	count, err := ImportJSON(strings.NewReader(exported), true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	imported, err := ListNotificationsParsed("all", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Len(t, imported, 4)
+	copied := imported[2]
+	assert.Equal(t, 3, copied.ID, "regenerated ids follow the existing ones")
+	assert.Equal(t, "deploy\tfailed", copied.Message)
+	assert.Equal(t, "dismissed", copied.State)
+	assert.NotEmpty(t, copied.ReadTimestamp)
+	assert.Equal(t, []string{"deploy"}, copied.Tags)
+	assert.Equal(t, 4, imported[3].ID)
+}
+
+func TestImportJSONKeepsIDsAndRejectsInvalidRecords(t *testing.T) {
+	setupStorageTest(t)
+
+	count, err := ImportJSON(strings.NewReader(`[{"ID": 7, "Timestamp": "2025-01-01T10:00:00Z", "Message": "restored", "Level": "warning"}]`), false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	line, err := GetNotificationByID("7")
+	require.NoError(t, err)
+	restored, err := notification.ParseNotification(line)
+	require.NoError(t, err)
+	assert.Equal(t, "active", restored.State, "missing state imports as active")
+
+	id, err := AddNotification("next", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	assert.Equal(t, "8", id, "new notifications continue after imported ids")
+
+	invalid := []string{
+		`[{"ID": 20, "Message": "ok", "Level": "info"}, {"ID": 21, "Message": " ", "Level": "info"}]`,
+		`[{"ID": 20, "Message": "ok", "Level": "loud"}]`,
+		`[{"ID": 20, "Message": "ok", "Level": "info", "State": "archived"}]`,
+		`[{"ID": 20, "Message": "ok", "Level": "info", "Timestamp": "yesterday"}]`,
+		`[{"ID": 0, "Message": "ok", "Level": "info"}]`,
+		`[{"ID": 20, "Message": "ok", "Level": "info"}, {"ID": 20, "Message": "twice", "Level": "info"}]`,
+		`{"ID": 20}`,
+	}
+	for _, input := range invalid {
+		_, err := ImportJSON(strings.NewReader(input), false)
+		assert.Error(t, err, input)
+	}
+	_, err = GetNotificationByID("20")
+	assert.Error(t, err, "a failed import stores nothing")
+}
@@ -3,7 +3,6 @@ package storage
 
 import (
 	"fmt"
-	"path/filepath"
 
 	"github.com/cristianoliveira/tmux-intray/internal/config"
 	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite"
@@ -17,20 +16,31 @@ const (
 
 var _ Storage = (*sqlite.SQLiteStorage)(nil)
 
+// Backends lists the storage backends this build supports.
+var Backends = []string{BackendSQLite}
+
 // NewFromConfig creates a storage backend based on configuration.
 func NewFromConfig() (Storage, error) {
 	config.Load()
 	backend := config.Get("storage_backend", BackendSQLite)
-	return NewForBackend(backend)
+	return newForBackend(backend)
 }
 
-// NewForBackend creates a storage backend for the provided backend name.
+// NewForBackend creates a storage backend for the provided backend name. It
+// loads the configuration once here; the backend keeps those settings for its
+// lifetime instead of reading the config file on every operation.
 func NewForBackend(backend string) (Storage, error) {
+	config.Load()
+	return newForBackend(backend)
+}
+
+// newForBackend creates the named backend from the configuration already loaded.
+func newForBackend(backend string) (Storage, error) {
 	switch backend {
 	case BackendSQLite:
-		dbPath := filepath.Join(GetStateDir(), "notifications.db")
+		dbPath := GetNotificationsPath()
 		sqlite.SetTmuxClient(tmux.NewDefaultClient())
-		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath)
+		sqliteStorage, err := sqlite.NewSQLiteStorage(dbPath, sqlite.OptionsFromConfig())
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize sqlite backend: %w", err)
 		}
@@ -39,3 +49,10 @@ func NewForBackend(backend string) (Storage, error) {
 		return nil, fmt.Errorf("unknown storage backend '%s' (only 'sqlite' is supported)", backend)
 	}
 }
+
+// FlushStatus hands a zero active count still waiting out status_zero_grace to
+// the tmux server. The CLI calls it before exiting so the tmux status never
+// keeps a stale count.
+func FlushStatus() {
+	sqlite.FlushStatus()
+}
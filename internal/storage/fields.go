@@ -2,8 +2,17 @@
 package storage
 
 // Field indices for the notification schema used in TSV output format:
-// id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp.
-// read_timestamp is RFC3339 when read, empty when unread.
+// id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp, important, color, owner, snoozed_until, priority, tags, occurrences.
+// read_timestamp is RFC3339 when read, empty when unread. important is "1" when
+// the user flagged the notification, empty otherwise. color is the row color
+// override, empty for the level color. owner is who claimed the notification,
+// empty when unassigned. snoozed_until is the RFC3339 time a snoozed
+// notification comes back, empty when it is not snoozed. priority is an integer
+// that orders notifications when sorting by priority, empty for the default 0.
+// tags is a comma-separated list of lowercase labels, empty when untagged.
+// occurrences is how many adds dedup.collapse_window collapsed into the
+// notification, empty when it was added once.
+// Lines written before a trailing field existed are padded with empty values.
 const (
 	FieldID = iota
 	FieldTimestamp
@@ -15,6 +24,13 @@ const (
 	FieldPaneCreated
 	FieldLevel
 	FieldReadTimestamp
+	FieldImportant
+	FieldColor
+	FieldOwner
+	FieldSnoozedUntil
+	FieldPriority
+	FieldTags
+	FieldOccurrences
 	NumFields
-	MinFields = NumFields - 1
+	MinFields = FieldReadTimestamp
 )
@@ -0,0 +1,105 @@
+// Package storage provides the storage interface for tmux-intray.
+package storage
+
+import (
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite"
+)
+
+// ListFilter selects notifications for ListWithFilter, ListNotificationsPaged
+// and RelevelByFilter. Each field narrows the result; an empty field matches
+// every notification.
+type ListFilter = sqlite.ListFilter
+
+// filterListStore is implemented by storage backends that list notifications
+// by ListFilter, tags included.
+type filterListStore interface {
+	ListWithFilter(f ListFilter) (string, error)
+}
+
+// ListWithFilter returns TSV lines for the notifications matching f using the
+// default storage backend. Without Tags it is equivalent to ListNotifications
+// with each field passed positionally.
+func ListWithFilter(f ListFilter) (string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return "", fmt.Errorf("failed to get storage: %w", err)
+	}
+	return ListWithFilterFrom(store, f)
+}
+
+// ListWithFilterFrom returns TSV lines for the notifications in store matching f.
+func ListWithFilterFrom(store Storage, f ListFilter) (string, error) {
+	if filtered, ok := store.(filterListStore); ok {
+		return filtered.ListWithFilter(f)
+	}
+	if f.Tags != "" {
+		return "", fmt.Errorf("list: storage does not support tags")
+	}
+	return store.ListNotifications(f.State, f.Level, f.Session, f.Window, f.Pane, f.OlderThan, f.NewerThan, f.Read)
+}
+
+// relevelStore is implemented by storage backends that can change the level of
+// many notifications at once.
+type relevelStore interface {
+	RelevelByFilter(f ListFilter, newLevel string) (int, error)
+}
+
+// RelevelByFilter sets the level of every notification matching f to newLevel
+// using the default storage backend, and returns how many changed. All matches
+// change together or not at all; an invalid newLevel changes nothing.
+func RelevelByFilter(f ListFilter, newLevel string) (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	relevel, ok := store.(relevelStore)
+	if !ok {
+		return 0, fmt.Errorf("relevel: storage does not support changing levels")
+	}
+	return relevel.RelevelByFilter(f, newLevel)
+}
+
+// pagedStore is implemented by storage backends that can list notifications
+// one page at a time.
+type pagedStore interface {
+	ListNotificationsPaged(f ListFilter, offset, limit int) ([]string, int, error)
+}
+
+// ListNotificationsPaged returns one page of the TSV lines ListWithFilter
+// returns for f, plus the total number of matches, using the default storage
+// backend. offset and limit apply after filtering and sorting; a limit of
+// zero or less means no limit.
+func ListNotificationsPaged(f ListFilter, offset, limit int) ([]string, int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	paged, ok := store.(pagedStore)
+	if !ok {
+		return nil, 0, fmt.Errorf("list paged: storage does not support pagination")
+	}
+	return paged.ListNotificationsPaged(f, offset, limit)
+}
+
+// sinceIDStore is implemented by storage backends that can list notifications
+// added after a known ID.
+type sinceIDStore interface {
+	ListSinceID(afterID int, stateFilter string) (string, error)
+}
+
+// ListSinceID returns TSV lines for notifications with an ID greater than
+// afterID using the default storage backend. Each notification appears once,
+// in its current state. Zero returns every notification matching stateFilter.
+func ListSinceID(afterID int, stateFilter string) (string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return "", fmt.Errorf("failed to get storage: %w", err)
+	}
+	since, ok := store.(sinceIDStore)
+	if !ok {
+		return "", fmt.Errorf("list since id: storage does not support incremental listing")
+	}
+	return since.ListSinceID(afterID, stateFilter)
+}
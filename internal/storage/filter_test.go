@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWithFilterMatchesPositionalList(t *testing.T) {
+	setupStorageTest(t)
+
+	first, err := AddNotification("build failed", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	_, err = AddNotification("tests passed", "2025-01-02T10:00:00Z", "$1", "@2", "%2", "", "info")
+	require.NoError(t, err)
+	third, err := AddNotification("deploy started", "2025-01-03T10:00:00Z", "$2", "@3", "%3", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, MarkNotificationRead(first))
+	require.NoError(t, DismissNotification(third))
+
+	filters := []ListFilter{
+		{},
+		{State: "active"},
+		{State: "all"},
+		{State: "dismissed"},
+		{Level: "error"},
+		{Session: "$1", Window: "@2"},
+		{Pane: "%3", State: "all"},
+		{OlderThan: "2025-01-02T12:00:00Z"},
+		{NewerThan: "2025-01-01T12:00:00Z", State: "all"},
+		{Read: "read"},
+		{Read: "unread", Session: "$1"},
+		{Level: "critical"},
+	}
+	for _, f := range filters {
+		want, err := ListNotifications(f.State, f.Level, f.Session, f.Window, f.Pane, f.OlderThan, f.NewerThan, f.Read)
+		require.NoError(t, err)
+
+		got, err := ListWithFilter(f)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "filter %+v", f)
+
+		store, err := getDefaultStorage()
+		require.NoError(t, err)
+		got, err = ListWithFilterFrom(store, f)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "filter %+v", f)
+	}
+
+	list, err := ListWithFilter(ListFilter{Session: "$1", Read: "unread"})
+	require.NoError(t, err)
+	assert.Contains(t, list, "tests passed")
+	assert.NotContains(t, list, "build failed")
+}
+
+func TestRelevelByFilterChangesOnlyMatches(t *testing.T) {
+	setupStorageTest(t)
+
+	_, err := AddNotification("disk almost full", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = AddNotification("disk full", "2025-01-02T10:00:00Z", "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	_, err = AddNotification("tests passed", "2025-01-03T10:00:00Z", "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+
+	changed, err := RelevelByFilter(ListFilter{Session: "$1"}, "error")
+	require.NoError(t, err)
+	assert.Equal(t, 2, changed)
+
+	relevelled, err := ListWithFilter(ListFilter{Level: "error"})
+	require.NoError(t, err)
+	assert.Contains(t, relevelled, "disk almost full")
+	assert.Contains(t, relevelled, "disk full")
+	assert.NotContains(t, relevelled, "tests passed")
+
+	unchanged, err := ListWithFilter(ListFilter{Level: "info"})
+	require.NoError(t, err)
+	assert.Contains(t, unchanged, "tests passed")
+	assert.NotContains(t, unchanged, "disk")
+
+	changed, err = RelevelByFilter(ListFilter{Session: "$1"}, "error")
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed, "notifications already at the level are not counted")
+}
+
+func TestRelevelByFilterRejectsInvalidLevel(t *testing.T) {
+	setupStorageTest(t)
+
+	_, err := AddNotification("build failed", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	changed, err := RelevelByFilter(ListFilter{}, "urgent")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid level 'urgent'")
+	assert.Equal(t, 0, changed)
+
+	list, err := ListWithFilter(ListFilter{Level: "info"})
+	require.NoError(t, err)
+	assert.Contains(t, list, "build failed")
+}
+
+func TestListNotificationsPagedWindowsFilteredResults(t *testing.T) {
+	setupStorageTest(t)
+
+	var ids []string
+	for _, message := range []string{"one", "two", "three", "four", "five"} {
+		id, err := AddNotification(message, "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "info")
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.NoError(t, DismissNotification(ids[1]))
+
+	all, err := ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	everything, total, err := ListNotificationsPaged(ListFilter{State: "active"}, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	assert.Equal(t, strings.Split(all, "\n"), everything, "no limit returns the same lines as ListNotifications")
+
+	page, total, err := ListNotificationsPaged(ListFilter{State: "active"}, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total, "the total counts every match, not just the page")
+	require.Len(t, page, 2)
+	assert.True(t, strings.HasPrefix(page[0], ids[2]+"\t"), "offset applies after filtering out the dismissed one")
+	assert.True(t, strings.HasPrefix(page[1], ids[3]+"\t"))
+
+	tail, _, err := ListNotificationsPaged(ListFilter{State: "active"}, 3, -1)
+	require.NoError(t, err)
+	require.Len(t, tail, 1)
+	assert.True(t, strings.HasPrefix(tail[0], ids[4]+"\t"))
+
+	past, total, err := ListNotificationsPaged(ListFilter{State: "active"}, 10, 2)
+	require.NoError(t, err)
+	assert.Empty(t, past)
+	assert.Equal(t, 4, total)
+
+	_, _, err = ListNotificationsPaged(ListFilter{}, -1, 2)
+	assert.Error(t, err)
+}
+
+func TestListSinceIDReturnsOnlyNewerNotifications(t *testing.T) {
+	setupStorageTest(t)
+
+	first, err := AddNotification("build failed", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	second, err := AddNotification("tests passed", "2025-01-02T10:00:00Z", "$1", "@2", "%2", "", "info")
+	require.NoError(t, err)
+	third, err := AddNotification("deploy started", "2025-01-03T10:00:00Z", "$2", "@3", "%3", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, DismissNotification(third))
+
+	all, err := ListSinceID(0, "all")
+	require.NoError(t, err)
+	everything, err := ListWithFilter(ListFilter{State: "all"})
+	require.NoError(t, err)
+	assert.Equal(t, everything, all, "zero returns every notification")
+
+	newer, err := ListSinceID(1, "all")
+	require.NoError(t, err)
+	lines := strings.Split(newer, "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], second+"\t"))
+	assert.True(t, strings.HasPrefix(lines[1], third+"\t"))
+	assert.NotContains(t, newer, "build failed")
+
+	active, err := ListSinceID(1, "active")
+	require.NoError(t, err)
+	assert.Contains(t, active, "tests passed")
+	assert.NotContains(t, active, "deploy started")
+
+	none, err := ListSinceID(3, "")
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	require.NoError(t, MarkNotificationRead(first))
+	again, err := ListSinceID(0, "all")
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(again, "\n"), 3, "each notification appears once in its current state")
+
+	_, err = ListSinceID(-1, "")
+	assert.Error(t, err)
+}
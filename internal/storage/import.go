@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/notification"
+)
+
+// ColumnMapping describes the columns of a TSV file read by ImportTSV.
+type ColumnMapping struct {
+	// Columns names the schema field held by each file column, in file order,
+	// using the csvHeader names such as "message" or "level". An empty name
+	// skips that column. When Columns is empty the header line names them.
+	Columns []string
+	// HasHeader reports whether the first line is a header rather than a
+	// notification.
+	HasHeader bool
+}
+
+// ImportTSV reads notifications from TSV whose columns may be in any order and
+// appends them using the default storage backend, returning how many were
+// imported. mapping places each column in the canonical schema; fields it does
+// not name are left empty. Messages use the escaped TSV form, important
+// accepts "1" or "true", and tags are comma-separated. Every notification gets
+// a fresh ID and is validated like a new one, and the import stores either all
+// of them or none.
+func ImportTSV(r io.Reader, mapping ColumnMapping) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	columns := mapping.Columns
+	if mapping.HasHeader {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return 0, fmt.Errorf("import tsv: %w", err)
+			}
+			return 0, fmt.Errorf("import tsv: missing header line")
+		}
+		if len(columns) == 0 {
+			columns = strings.Split(strings.TrimRight(scanner.Text(), "\r"), "\t")
+		}
+	}
+	fieldIndexes, err := columnFieldIndexes(columns)
+	if err != nil {
+		return 0, fmt.Errorf("import tsv: %w", err)
+	}
+
+	var records []domain.Notification
+	lineNumber := 0
+	if mapping.HasHeader {
+		lineNumber++
+	}
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		n, err := remapTSVLine(line, fieldIndexes)
+		if err != nil {
+			return 0, fmt.Errorf("import tsv: line %d: %w", lineNumber, err)
+		}
+		records = append(records, *notification.ToDomainUnsafe(n))
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("import tsv: %w", err)
+	}
+
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	importer, ok := store.(importStore)
+	if !ok {
+		return 0, fmt.Errorf("import tsv: storage does not support imports")
+	}
+	count, err := importer.ImportNotifications(records, true)
+	if err != nil {
+		return 0, fmt.Errorf("import tsv: %w", err)
+	}
+	return count, nil
+}
+
+// columnFieldIndexes resolves column names to schema field indexes. Skipped
+// columns map to -1.
+func columnFieldIndexes(columns []string) ([]int, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns mapped")
+	}
+	indexes := make([]int, len(columns))
+	seen := make(map[int]bool, len(columns))
+	for i, name := range columns {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			indexes[i] = -1
+			continue
+		}
+		field := slices.Index(csvHeader, name)
+		if field < 0 {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("column %q mapped twice", name)
+		}
+		seen[field] = true
+		indexes[i] = field
+	}
+	if !seen[FieldMessage] || !seen[FieldLevel] {
+		return nil, fmt.Errorf("message and level columns are required")
+	}
+	return indexes, nil
+}
+
+// remapTSVLine moves the columns of line into the canonical field order and
+// parses the result.
+func remapTSVLine(line string, fieldIndexes []int) (notification.Notification, error) {
+	values := strings.Split(line, "\t")
+	if len(values) != len(fieldIndexes) {
+		return notification.Notification{}, fmt.Errorf("got %d columns, want %d", len(values), len(fieldIndexes))
+	}
+	fields := make([]string, NumFields)
+	for i, field := range fieldIndexes {
+		if field >= 0 {
+			fields[field] = values[i]
+		}
+	}
+	fields[FieldID] = ""
+	if important := fields[FieldImportant]; important != "" {
+		flagged, err := strconv.ParseBool(important)
+		if err != nil {
+			return notification.Notification{}, fmt.Errorf("invalid important value %q", important)
+		}
+		fields[FieldImportant] = ""
+		if flagged {
+			fields[FieldImportant] = "1"
+		}
+	}
+	if priority := fields[FieldPriority]; priority != "" {
+		if _, err := strconv.Atoi(priority); err != nil {
+			return notification.Notification{}, fmt.Errorf("invalid priority value %q", priority)
+		}
+	}
+	if occurrences := fields[FieldOccurrences]; occurrences != "" {
+		if count, err := strconv.Atoi(occurrences); err != nil || count < 0 {
+			return notification.Notification{}, fmt.Errorf("invalid occurrences value %q", occurrences)
+		}
+	}
+	return notification.ParseNotification(strings.Join(fields, "\t"))
+}
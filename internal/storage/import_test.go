@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportTSVRemapsReorderedColumns(t *testing.T) {
+	setupStorageTest(t)
+	_, err := AddNotification("already here", "2025-01-01T09:00:00Z", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	input := strings.Join([]string{
+		"level\tmessage\tsession\twindow\tpane\ttimestamp\timportant\ttags\tid\tsource_tool",
+		"error\tbuild failed\\n\\tsee log\t$2\t@2\t%2\t2025-01-02T10:00:00Z\ttrue\tCI,deploy\t1\tjenkins",
+		"warning\tdisk 90%\t$3\t@3\t%3\t2025-01-03T10:00:00Z\t\t\t2\tnagios",
+		"",
+	}, "\n")
+	_, err = ImportTSV(strings.NewReader(input), ColumnMapping{HasHeader: true})
+	require.ErrorContains(t, err, `unknown column "source_tool"`)
+
+	mapping := ColumnMapping{
+		Columns:   []string{"level", "message", "session", "window", "pane", "timestamp", "important", "tags", "", ""},
+		HasHeader: true,
+	}
+	count, err := ImportTSV(strings.NewReader(input), mapping)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	imported, err := ListNotificationsParsed("all", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Len(t, imported, 3)
+
+	failed := imported[1]
+	assert.Equal(t, 2, failed.ID, "ids are assigned fresh, ignoring the file")
+	assert.Equal(t, "error", failed.Level)
+	assert.Equal(t, "build failed\n\tsee log", failed.Message)
+	assert.Equal(t, "$2", failed.Session)
+	assert.Equal(t, "@2", failed.Window)
+	assert.Equal(t, "%2", failed.Pane)
+	assert.Equal(t, "2025-01-02T10:00:00Z", failed.Timestamp)
+	assert.Equal(t, "active", failed.State)
+	assert.True(t, failed.Important)
+	assert.Equal(t, []string{"ci", "deploy"}, failed.Tags)
+
+	disk := imported[2]
+	assert.Equal(t, 3, disk.ID)
+	assert.Equal(t, "warning", disk.Level)
+	assert.Equal(t, "disk 90%", disk.Message)
+	assert.False(t, disk.Important)
+	assert.Empty(t, disk.Tags)
+}
+
+func TestImportTSVRejectsBadInput(t *testing.T) {
+	setupStorageTest(t)
+	order := ColumnMapping{Columns: []string{"message", "level"}}
+
+	invalid := map[string]struct {
+		input   string
+		mapping ColumnMapping
+		wantErr string
+	}{
+		"column count":    {"ok\tinfo\textra\n", order, "line 1: got 3 columns, want 2"},
+		"invalid level":   {"ok\tinfo\nok\tloud\n", order, "invalid level"},
+		"empty message":   {"\tinfo\n", order, "message cannot be empty"},
+		"missing level":   {"ok\n", ColumnMapping{Columns: []string{"message"}}, "message and level columns are required"},
+		"repeated column": {"ok\tinfo\tinfo\n", ColumnMapping{Columns: []string{"message", "level", "level"}}, `column "level" mapped twice`},
+		"missing header":  {"", ColumnMapping{HasHeader: true}, "missing header line"},
+	}
+	for name, tt := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := ImportTSV(strings.NewReader(tt.input), tt.mapping)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	imported, err := ListNotificationsParsed("all", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	assert.Empty(t, imported, "failed imports store nothing")
+}
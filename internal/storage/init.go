@@ -4,6 +4,7 @@ package storage
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/cristianoliveira/tmux-intray/internal/config"
 )
 
+// NotificationsFilename is the default notifications database file name inside the state directory.
+const NotificationsFilename = "notifications.db"
+
 // File permission constants
 const (
 	// FileModeDir is the permission for directories (rwxr-xr-x)
@@ -54,6 +58,16 @@ func Init() error {
 			err = fmt.Errorf("failed to create state directory: %w", err)
 			return
 		}
+		if err = os.MkdirAll(filepath.Dir(GetNotificationsPath()), FileModeDir); err != nil {
+			err = fmt.Errorf("failed to create notifications directory: %w", err)
+			return
+		}
+		if lockDir := GetLockDir(); lockDir != "" {
+			if err = os.MkdirAll(lockDir, FileModeDir); err != nil {
+				err = fmt.Errorf("failed to create lock directory: %w", err)
+				return
+			}
+		}
 
 		// Mark initialization error for retry logic
 		initMu.Lock()
@@ -93,6 +107,30 @@ func GetStateDir() string {
 	return config.Get("state_dir", "")
 }
 
+// GetNotificationsPath returns the notifications database path.
+// It respects the optional notifications_path override and otherwise
+// places the database inside the state directory.
+func GetNotificationsPath() string {
+	if override := notificationsPathOverride(); override != "" {
+		return override
+	}
+	return filepath.Join(GetStateDir(), NotificationsFilename)
+}
+
+// GetLockDir returns the directory where lock directories are created.
+// It respects the optional lock_path override and returns an empty string
+// when lock directories should stay next to the files they guard. Like every
+// config key, lock_path can be set with TMUX_INTRAY_LOCK_PATH.
+func GetLockDir() string {
+	return config.Get("lock_path", "")
+}
+
+// notificationsPathOverride returns the notifications_path config key, which
+// TMUX_INTRAY_NOTIFICATIONS_PATH also sets.
+func notificationsPathOverride() string {
+	return config.Get("notifications_path", "")
+}
+
 // Reset resets the storage package state for testing.
 func Reset() {
 	initMu.Lock()
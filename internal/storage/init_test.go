@@ -136,8 +136,80 @@ func TestReset_ClearsDefaultStorage(t *testing.T) {
 	Reset()
 
 	// After reset, GetActiveCount returns 0 because the default storage is nil
-	// (it returns 0 on error, and without a configured state dir, it will error)
+	// (it returns 0 on error, and without a configured state dir, it will error).
+	// Any database opened on the fallback path stays inside the test directory.
 	t.Setenv("TMUX_INTRAY_STATE_DIR", "")
+	t.Setenv("TMUX_INTRAY_NOTIFICATIONS_PATH", filepath.Join(t.TempDir(), "notifications.db"))
 	count = GetActiveCount()
 	assert.Equal(t, 0, count)
 }
+
+func TestInit_UsesCustomNotificationsPath(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	stateDir := t.TempDir()
+	notificationsPath := filepath.Join(t.TempDir(), "ramdisk", "intray", "custom.db")
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+	t.Setenv("TMUX_INTRAY_NOTIFICATIONS_PATH", notificationsPath)
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+
+	require.NoError(t, Init())
+	assert.Equal(t, notificationsPath, GetNotificationsPath())
+
+	_, err := AddNotification("on ramdisk", "2025-01-01T12:00:00Z", "sess", "win", "pane", "", "info")
+	require.NoError(t, err)
+
+	list, err := ListNotifications("", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	assert.Contains(t, list, "on ramdisk")
+
+	_, err = os.Stat(notificationsPath)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(stateDir, NotificationsFilename))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestInit_CreatesLockDirectory(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	lockDir := filepath.Join(t.TempDir(), "locks", "intray")
+	t.Setenv("TMUX_INTRAY_STATE_DIR", t.TempDir())
+	t.Setenv("TMUX_INTRAY_LOCK_PATH", lockDir)
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+
+	require.NoError(t, Init())
+	assert.Equal(t, lockDir, GetLockDir())
+
+	info, err := os.Stat(lockDir)
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+}
+
+func TestInit_ErrorsWhenNotificationsDirNotCreatable(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	blocker := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(blocker, []byte("x"), FileModeFile))
+	t.Setenv("TMUX_INTRAY_STATE_DIR", t.TempDir())
+	t.Setenv("TMUX_INTRAY_NOTIFICATIONS_PATH", filepath.Join(blocker, "notifications.db"))
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+
+	err := Init()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create notifications directory")
+}
+
+func TestGetNotificationsPath_DefaultsToStateDir(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	stateDir := t.TempDir()
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
+
+	require.NoError(t, Init())
+	assert.Equal(t, filepath.Join(stateDir, NotificationsFilename), GetNotificationsPath())
+}
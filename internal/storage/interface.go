@@ -9,8 +9,15 @@ type Storage interface {
 	DismissNotification(id string) error
 	DismissAll() error
 	DismissByFilter(session, window, pane string) error
+	UndismissNotification(id string) error
 	MarkNotificationRead(id string) error
 	MarkNotificationUnread(id string) error
+	SetImportant(id string, important bool) error
+	AssignNotification(id, owner string) error
 	CleanupOldNotifications(daysThreshold int, dryRun bool) error
+	MuteSession(session string) error
+	UnmuteSession(session string) error
+	ListMutedSessions() ([]string, error)
+	EscalateStale() (int, error)
 	GetActiveCount() int
 }
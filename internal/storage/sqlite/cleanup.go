@@ -12,10 +12,15 @@ import (
 )
 
 // CleanupOldNotifications removes dismissed notifications older than threshold days.
+// When auto_dismiss_stale_days is set, active notifications older than that are
+// dismissed first, so the same run can remove them.
 func (s *SQLiteStorage) CleanupOldNotifications(daysThreshold int, dryRun bool) error {
 	if daysThreshold < 0 {
 		return fmt.Errorf("sqlite storage: days threshold must be >= 0")
 	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
 	cutoff := time.Now().UTC().AddDate(0, 0, -daysThreshold).Format("2006-01-02T15:04:05Z")
 	envVars := []string{
 		fmt.Sprintf("CLEANUP_DAYS=%d", daysThreshold),
@@ -26,6 +31,15 @@ func (s *SQLiteStorage) CleanupOldNotifications(daysThreshold int, dryRun bool)
 		return fmt.Errorf("pre-cleanup hook failed: %w", err)
 	}
 
+	staleCount, err := s.dismissStaleNotifications(dryRun)
+	if err != nil {
+		return err
+	}
+	if !dryRun {
+		s.rotateEvents()
+	}
+	envVars = append(envVars, fmt.Sprintf("STALE_DISMISSED_COUNT=%d", staleCount))
+
 	countCutoff := cutoff
 	if daysThreshold == 0 {
 		countCutoff = ""
@@ -56,9 +70,25 @@ func (s *SQLiteStorage) CleanupOldNotifications(daysThreshold int, dryRun bool)
 		deleteCutoff = ""
 	}
 
-	if err := s.queries.DeleteDismissedForCleanup(context.Background(), deleteCutoff); err != nil {
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqlite storage: begin cleanup: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+	// Remember the highest ID before its row can go, so it is never reused.
+	if err := recordIDHighWater(ctx, q); err != nil {
+		return err
+	}
+	// The notifications_delete_extras trigger removes what was stored beside
+	// each deleted notification in the same statement.
+	if err := q.DeleteDismissedForCleanup(ctx, deleteCutoff); err != nil {
 		return fmt.Errorf("sqlite storage: cleanup old notifications: %w", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite storage: commit cleanup: %w", err)
+	}
 	postEnv := append(envVars, fmt.Sprintf("DELETED_COUNT=%d", deletedCount))
 	if err := hooks.Run("post-cleanup", postEnv...); err != nil {
 		return fmt.Errorf("post-cleanup hook failed: %w", err)
@@ -66,3 +96,67 @@ func (s *SQLiteStorage) CleanupOldNotifications(daysThreshold int, dryRun bool)
 
 	return nil
 }
+
+// dismissStaleNotifications dismisses active notifications older than
+// auto_dismiss_stale_days, running the usual dismiss hooks for each. It returns
+// how many were (or, in a dry run, would be) dismissed. Zero disables it.
+func (s *SQLiteStorage) dismissStaleNotifications(dryRun bool) (int, error) {
+	staleDays := s.options.AutoDismissStaleDays
+	if staleDays <= 0 {
+		return 0, nil
+	}
+	cutoff := nowFunc().UTC().AddDate(0, 0, -staleDays).Format("2006-01-02T15:04:05Z")
+	return s.dismissActiveBefore("", cutoff, dryRun)
+}
+
+// ExpireActiveOlderThan dismisses active notifications of level whose
+// timestamp is more than age ago, running the usual dismiss hooks for each,
+// and returns how many expired. An empty level expires every level. Unlike
+// CleanupOldNotifications it never deletes anything.
+func (s *SQLiteStorage) ExpireActiveOlderThan(level string, age time.Duration) (int, error) {
+	if level != "" && !validLevels[level] {
+		return 0, fmt.Errorf("invalid level '%s', must be one of: info, warning, error, critical, or empty", level)
+	}
+	if age <= 0 {
+		return 0, fmt.Errorf("sqlite storage: expiry age must be > 0")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	cutoff := nowFunc().UTC().Add(-age).Format("2006-01-02T15:04:05Z")
+	return s.dismissActiveBefore(level, cutoff, false)
+}
+
+// dismissActiveBefore dismisses active notifications of level, or of every
+// level when it is empty, whose timestamp is before cutoff, running the usual
+// dismiss hooks for each. It returns how many were (or, in a dry run, would be)
+// dismissed.
+func (s *SQLiteStorage) dismissActiveBefore(level, cutoff string, dryRun bool) (int, error) {
+	active, err := s.listActiveNotificationsForHooks()
+	if err != nil {
+		return 0, err
+	}
+	dismissed := 0
+	for _, notification := range active {
+		if notification.timestamp == "" || notification.timestamp >= cutoff {
+			continue
+		}
+		if level != "" && notification.level != level {
+			continue
+		}
+		if !dryRun {
+			changed, err := s.dismissSingleNotification(notification)
+			if err != nil {
+				return dismissed, err
+			}
+			if !changed {
+				continue
+			}
+		}
+		dismissed++
+	}
+	if dismissed > 0 && !dryRun {
+		s.syncTmuxStatusOption()
+	}
+	return dismissed, nil
+}
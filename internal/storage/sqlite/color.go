@@ -0,0 +1,26 @@
+// File: color.go
+// Purpose: Stores per-notification row colors that override the level color
+// in the TUI.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// recordColor stores the row color for a notification. An empty color stores
+// nothing, so the level color applies.
+func recordColor(ctx context.Context, q *sqlcgen.Queries, id int64, color string) error {
+	if color == "" {
+		return nil
+	}
+	if err := q.InsertNotificationColor(ctx, sqlcgen.InsertNotificationColorParams{
+		NotificationID: id,
+		Color:          color,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: record color: %w", err)
+	}
+	return nil
+}
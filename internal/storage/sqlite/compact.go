@@ -0,0 +1,19 @@
+// File: compact.go
+// Purpose: Reclaims the space left behind by deleted notifications so the
+// database file shrinks back after cleanup.
+package sqlite
+
+import "fmt"
+
+// Compact rebuilds the database file without its free pages. SQLite updates
+// rows in place, so only deleted rows leave space behind; VACUUM returns it to
+// the filesystem. Other processes wait on the busy timeout while it runs.
+func (s *SQLiteStorage) Compact() error {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+	if _, err := s.db.Exec("VACUUM"); err != nil {
+		return fmt.Errorf("sqlite storage: compact: %w", err)
+	}
+	return nil
+}
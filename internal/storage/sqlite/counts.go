@@ -0,0 +1,63 @@
+// File: counts.go
+// Purpose: Aggregates notification counts by level and session in SQL, so
+// status line badges need no full listing.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// CountByLevel returns how many notifications in stateFilter have each level.
+// stateFilter takes the values ListNotifications accepts, including hiding
+// snoozed notifications from "active". Levels with no notifications are left
+// out. The counts come from one query, so they are consistent with each other.
+func (s *SQLiteStorage) CountByLevel(stateFilter string) (map[string]int, error) {
+	if err := validateListInputs(stateFilter, "", "", ""); err != nil {
+		return nil, err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.CountNotificationsByLevel(context.Background(), sqlcgen.CountNotificationsByLevelParams{
+		StateFilter: stateFilter,
+		Now:         utcNow(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqlite storage: count by level: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Level] = int(row.Count)
+	}
+	return counts, nil
+}
+
+// CountBySession returns how many notifications in stateFilter each session
+// has, like CountByLevel. Notifications without a session count under "".
+func (s *SQLiteStorage) CountBySession(stateFilter string) (map[string]int, error) {
+	if err := validateListInputs(stateFilter, "", "", ""); err != nil {
+		return nil, err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.CountNotificationsBySession(context.Background(), sqlcgen.CountNotificationsBySessionParams{
+		StateFilter: stateFilter,
+		Now:         utcNow(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqlite storage: count by session: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Session] = int(row.Count)
+	}
+	return counts, nil
+}
@@ -1,6 +1,6 @@
 // File: dismiss.go
 // Purpose: Implements notification dismissal logic with hook integration,
-// supporting single dismissals and filtered bulk operations.
+// supporting single dismissals, filtered bulk operations, and undismissal.
 package sqlite
 
 import (
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cristianoliveira/tmux-intray/internal/events"
 	"github.com/cristianoliveira/tmux-intray/internal/hooks"
 	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
 )
@@ -19,6 +20,9 @@ func (s *SQLiteStorage) DismissNotification(id string) error {
 	if err != nil {
 		return err
 	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
 	notification, err := s.getNotificationForHooks(idInt)
 	if err != nil {
 		return err
@@ -26,16 +30,51 @@ func (s *SQLiteStorage) DismissNotification(id string) error {
 	if notification.state == "dismissed" {
 		return fmt.Errorf("sqlite storage: dismiss notification: %w: id %s", ErrNotificationAlreadyDismissed, id)
 	}
-	if err := s.dismissSingleNotification(notification); err != nil {
+	dismissed, err := s.dismissSingleNotification(notification)
+	if err != nil {
 		return err
 	}
+	if !dismissed {
+		return fmt.Errorf("sqlite storage: dismiss notification: %w: id %s", ErrNotificationAlreadyDismissed, id)
+	}
 	s.syncTmuxStatusOption()
 	return nil
 }
 
-// dismissSingleNotification dismisses a single notification with hooks.
-func (s *SQLiteStorage) dismissSingleNotification(notification hookNotification) error {
-	envVars := buildNotificationHookEnv(
+// resolvingLevels are the levels whose dismissal also runs the resolve hook,
+// so integrations can close the alert they opened for the notification.
+var resolvingLevels = map[string]bool{"error": true, "critical": true}
+
+// dismissSingleNotification dismisses a single notification with hooks and
+// reports whether it changed. Error and critical notifications also run the
+// resolve hook. A notification dismissed by someone else after it was read
+// is left alone: no event is recorded and the post hooks do not run.
+func (s *SQLiteStorage) dismissSingleNotification(notification hookNotification) (bool, error) {
+	envVars := dismissHookEnv(notification)
+	if err := hooks.Run("pre-dismiss", envVars...); err != nil {
+		return false, err
+	}
+	result, err := s.queries.DismissNotificationByID(context.Background(), sqlcgen.DismissNotificationByIDParams{
+		UpdatedAt: utcNow(),
+		ID:        notification.id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: dismiss notification: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: dismiss notification: %w", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+	s.recordEvents(notificationEvent(events.TypeDismiss, notification))
+	return true, afterDismiss(notification, envVars)
+}
+
+// dismissHookEnv builds the hook environment for dismissing notification.
+func dismissHookEnv(notification hookNotification) []string {
+	return buildNotificationHookEnv(
 		notification.id,
 		notification.level,
 		notification.message,
@@ -46,23 +85,120 @@ func (s *SQLiteStorage) dismissSingleNotification(notification hookNotification)
 		notification.pane,
 		notification.paneCreated,
 	)
-	if err := hooks.Run("pre-dismiss", envVars...); err != nil {
-		return err
-	}
-	if _, err := s.queries.DismissNotificationByID(context.Background(), sqlcgen.DismissNotificationByIDParams{
-		UpdatedAt: utcNow(),
-		ID:        notification.id,
-	}); err != nil {
-		return fmt.Errorf("sqlite storage: dismiss notification: %w", err)
-	}
+}
+
+// afterDismiss runs the post-dismiss hook, and the resolve hook for error and
+// critical notifications.
+func afterDismiss(notification hookNotification, envVars []string) error {
 	if err := hooks.Run("post-dismiss", envVars...); err != nil {
 		return err
 	}
+	if resolvingLevels[notification.level] {
+		if err := hooks.Run("resolve", envVars...); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// DismissByIDs dismisses the given active notifications in one transaction and
+// returns how many changed. Every pre-dismiss hook runs before the transaction,
+// so a hook that aborts dismisses nothing; post-dismiss and resolve hooks run
+// after it commits, only for the notifications the transaction changed.
+// Missing and already dismissed IDs are skipped, including ones dismissed
+// between the hooks and the transaction.
+func (s *SQLiteStorage) DismissByIDs(ids []string) (int, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	pending := make([]hookNotification, 0, len(ids))
+	for _, id := range ids {
+		idInt, err := parseID(id)
+		if err != nil {
+			return 0, err
+		}
+		notification, err := s.getNotificationForHooks(idInt)
+		if errors.Is(err, ErrNotificationNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if notification.state == "dismissed" {
+			continue
+		}
+		pending = append(pending, notification)
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	envs := make([][]string, len(pending))
+	for i, notification := range pending {
+		envs[i] = dismissHookEnv(notification)
+		if err := hooks.Run("pre-dismiss", envs[i]...); err != nil {
+			return 0, err
+		}
+	}
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: begin dismiss notifications: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+	now := utcNow()
+	changed := make([]bool, len(pending))
+	count := 0
+	for i, notification := range pending {
+		result, err := q.DismissNotificationByID(ctx, sqlcgen.DismissNotificationByIDParams{
+			UpdatedAt: now,
+			ID:        notification.id,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("sqlite storage: dismiss notifications: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("sqlite storage: dismiss notifications: %w", err)
+		}
+		if affected > 0 {
+			changed[i] = true
+			count++
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite storage: commit dismiss notifications: %w", err)
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	s.syncTmuxStatusOption()
+
+	dismissed := make([]events.Event, 0, count)
+	for i, notification := range pending {
+		if changed[i] {
+			dismissed = append(dismissed, notificationEvent(events.TypeDismiss, notification))
+		}
+	}
+	s.recordEvents(dismissed...)
+	for i, notification := range pending {
+		if !changed[i] {
+			continue
+		}
+		if err := afterDismiss(notification, envs[i]); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
 // DismissAll marks all active notifications as dismissed.
 func (s *SQLiteStorage) DismissAll() error {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
 	if err := hooks.Run("pre-clear"); err != nil {
 		return err
 	}
@@ -71,7 +207,7 @@ func (s *SQLiteStorage) DismissAll() error {
 		return err
 	}
 	for _, notification := range activeNotifications {
-		if err := s.dismissSingleNotification(notification); err != nil {
+		if _, err := s.dismissSingleNotification(notification); err != nil {
 			return err
 		}
 	}
@@ -82,6 +218,9 @@ func (s *SQLiteStorage) DismissAll() error {
 // DismissByFilter marks active notifications matching the provided filters as dismissed.
 // Empty string in a field means "match any value".
 func (s *SQLiteStorage) DismissByFilter(session, window, pane string) error {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
 	// Get all notifications matching the filters before dismissal to run hooks
 	activeNotifications, err := s.listActiveNotificationsByFilter(session, window, pane)
 	if err != nil {
@@ -94,7 +233,7 @@ func (s *SQLiteStorage) DismissByFilter(session, window, pane string) error {
 
 	// Run pre-dismiss hooks and dismiss each notification
 	for _, notification := range activeNotifications {
-		if err := s.dismissSingleNotification(notification); err != nil {
+		if _, err := s.dismissSingleNotification(notification); err != nil {
 			return err
 		}
 	}
@@ -103,6 +242,52 @@ func (s *SQLiteStorage) DismissByFilter(session, window, pane string) error {
 	return nil
 }
 
+// UndismissNotification marks a dismissed notification as active again,
+// running the pre-undismiss and post-undismiss hooks around the change.
+func (s *SQLiteStorage) UndismissNotification(id string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+	notification, err := s.getNotificationForHooks(idInt)
+	if err != nil {
+		return err
+	}
+	if notification.state != "dismissed" {
+		return fmt.Errorf("sqlite storage: undismiss notification: %w: id %s", ErrNotificationNotDismissed, id)
+	}
+
+	envVars := buildNotificationHookEnv(
+		notification.id,
+		notification.level,
+		notification.message,
+		escapeMessage(notification.message),
+		notification.timestamp,
+		notification.session,
+		notification.window,
+		notification.pane,
+		notification.paneCreated,
+	)
+	if err := hooks.Run("pre-undismiss", envVars...); err != nil {
+		return err
+	}
+	if _, err := s.queries.UndismissNotificationByID(context.Background(), sqlcgen.UndismissNotificationByIDParams{
+		UpdatedAt: utcNow(),
+		ID:        idInt,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: undismiss notification: %w", err)
+	}
+	if err := hooks.Run("post-undismiss", envVars...); err != nil {
+		return err
+	}
+
+	s.syncTmuxStatusOption()
+	return nil
+}
+
 type hookNotification struct {
 	id          int64
 	timestamp   string
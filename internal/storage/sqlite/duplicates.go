@@ -0,0 +1,95 @@
+// File: duplicates.go
+// Purpose: Collapses repeated adds of the same notification within
+// dedup.collapse_window into the existing entry instead of storing a new row.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// collapseDuplicate looks for an active notification with the same message,
+// level, session, window and pane whose timestamp is no more than
+// options.CollapseWindow before timestamp. dedup.criteria is not used: it only
+// groups notifications for display, and collapsing across levels or sources
+// would hide a distinct alert. When a duplicate exists it is bumped as a new
+// occurrence: its timestamp moves up to timestamp, its occurrence count goes up
+// by one, its previous timestamp is recorded as the last time it was seen, and
+// its snooze is cleared. Its read timestamp is set to readTimestamp, empty to
+// make it unread again. The ID of the duplicate is returned, or 0 when
+// options.CollapseWindow is unset or nothing matches.
+//
+// It runs before the pre-add hooks, so a collapsed repeat is not delivered
+// again.
+func (s *SQLiteStorage) collapseDuplicate(message, timestamp, session, window, pane, level, readTimestamp string) (int64, error) {
+	within := s.options.CollapseWindow
+	if within <= 0 {
+		return 0, nil
+	}
+	added, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: find duplicate: %w", err)
+	}
+	since := added.UTC().Add(-within).Format("2006-01-02T15:04:05Z")
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: begin collapse duplicate: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+
+	duplicate, err := q.FindActiveDuplicate(ctx, sqlcgen.FindActiveDuplicateParams{
+		Message: message,
+		Level:   level,
+		Session: session,
+		Window:  window,
+		Pane:    pane,
+		Since:   since,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: find duplicate: %w", err)
+	}
+
+	now := utcNow()
+	if err := q.UpdateNotificationTimestamp(ctx, sqlcgen.UpdateNotificationTimestampParams{
+		Timestamp: timestamp,
+		UpdatedAt: now,
+		ID:        duplicate.ID,
+	}); err != nil {
+		return 0, fmt.Errorf("sqlite storage: bump duplicate timestamp: %w", err)
+	}
+	if err := q.IncrementNotificationOccurrences(ctx, duplicate.ID); err != nil {
+		return 0, fmt.Errorf("sqlite storage: count duplicate: %w", err)
+	}
+	if err := q.InsertPreviousOccurrence(ctx, sqlcgen.InsertPreviousOccurrenceParams{
+		NotificationID:    duplicate.ID,
+		PreviousID:        duplicate.ID,
+		PreviousTimestamp: duplicate.Timestamp,
+	}); err != nil {
+		return 0, fmt.Errorf("sqlite storage: record duplicate occurrence: %w", err)
+	}
+	if _, err := q.UpdateReadTimestampByID(ctx, sqlcgen.UpdateReadTimestampByIDParams{
+		ReadTimestamp: readTimestamp,
+		UpdatedAt:     now,
+		ID:            duplicate.ID,
+	}); err != nil {
+		return 0, fmt.Errorf("sqlite storage: reset duplicate read state: %w", err)
+	}
+	if err := q.DeleteNotificationSnooze(ctx, duplicate.ID); err != nil {
+		return 0, fmt.Errorf("sqlite storage: wake duplicate: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite storage: commit collapse duplicate: %w", err)
+	}
+	return duplicate.ID, nil
+}
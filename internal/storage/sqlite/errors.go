@@ -12,6 +12,12 @@ var (
 	ErrNotificationNotFound = errors.New("notification not found")
 	// ErrNotificationAlreadyDismissed indicates the notification is already dismissed.
 	ErrNotificationAlreadyDismissed = errors.New("notification already dismissed")
+	// ErrNotificationNotDismissed indicates the notification is already active.
+	ErrNotificationNotDismissed = errors.New("notification not dismissed")
+	// ErrInvalidTimestamp indicates a timestamp that is not in RFC3339 format.
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	// ErrSessionNotMuted indicates the session is not muted.
+	ErrSessionNotMuted = errors.New("session not muted")
 )
 
 var validLevels = map[string]bool{
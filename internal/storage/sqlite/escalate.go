@@ -0,0 +1,59 @@
+// File: escalate.go
+// Purpose: Implements the escalation sweep that re-fires hooks for critical
+// notifications left unread past the configured threshold.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/hooks"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// EscalateStale runs the escalate hook for every active, unread critical notification
+// older than escalate_after and returns how many were escalated. Each notification
+// escalates at most once. The sweep is a no-op when escalate_after is unset.
+func (s *SQLiteStorage) EscalateStale() (int, error) {
+	threshold := s.options.EscalateAfter
+	if threshold <= 0 {
+		return 0, nil
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+
+	cutoff := nowFunc().UTC().Add(-threshold).Format("2006-01-02T15:04:05Z")
+	rows, err := s.queries.ListEscalationCandidates(context.Background(), cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: list escalation candidates: %w", err)
+	}
+
+	escalated := 0
+	for _, row := range rows {
+		envVars := buildNotificationHookEnv(
+			row.ID,
+			row.Level,
+			row.Message,
+			escapeMessage(row.Message),
+			row.Timestamp,
+			row.Session,
+			row.Window,
+			row.Pane,
+			row.PaneCreated,
+		)
+		envVars = append(envVars, fmt.Sprintf("ESCALATE_AFTER=%s", threshold))
+		if err := hooks.Run("escalate", envVars...); err != nil {
+			return escalated, fmt.Errorf("escalate hook failed: %w", err)
+		}
+		if err := s.queries.InsertEscalation(context.Background(), sqlcgen.InsertEscalationParams{
+			NotificationID: row.ID,
+			EscalatedAt:    utcNow(),
+		}); err != nil {
+			return escalated, fmt.Errorf("sqlite storage: record escalation: %w", err)
+		}
+		escalated++
+	}
+
+	return escalated, nil
+}
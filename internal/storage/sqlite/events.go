@@ -0,0 +1,58 @@
+// File: events.go
+// Purpose: Appends add, dismiss and read events to the events.jsonl stream
+// as notifications change, so `events` and `replay` see real activity.
+package sqlite
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
+)
+
+// recordEvents appends recorded to the stream at options.EventsPath in one
+// write. Nothing is recorded without an EventsPath. The stream is a side
+// channel, so a failed write is logged and never fails the storage operation.
+func (s *SQLiteStorage) recordEvents(recorded ...events.Event) {
+	if s.options.EventsPath == "" || len(recorded) == 0 {
+		return
+	}
+	if err := events.AppendTo(s.options.EventsPath, recorded...); err != nil {
+		colors.Debug(fmt.Sprintf("sqlite storage: record %s event: %v", recorded[0].Type, err))
+	}
+}
+
+// notificationEvent returns an eventType event carrying the fields of
+// notification.
+func notificationEvent(eventType string, notification hookNotification) events.Event {
+	return events.Event{
+		Type:           eventType,
+		NotificationID: strconv.FormatInt(notification.id, 10),
+		Session:        notification.session,
+		Window:         notification.window,
+		Pane:           notification.pane,
+		Message:        notification.message,
+		Level:          notification.level,
+	}
+}
+
+// recordReadEvents appends a read event for each of ids.
+func (s *SQLiteStorage) recordReadEvents(ids []int64) {
+	recorded := make([]events.Event, len(ids))
+	for i, id := range ids {
+		recorded[i] = events.Event{Type: events.TypeRead, NotificationID: strconv.FormatInt(id, 10)}
+	}
+	s.recordEvents(recorded...)
+}
+
+// rotateEvents moves the stream aside once it grows past
+// events.MaxStreamSize, so it does not grow without bound.
+func (s *SQLiteStorage) rotateEvents() {
+	if s.options.EventsPath == "" {
+		return
+	}
+	if _, err := events.Rotate(s.options.EventsPath, events.MaxStreamSize); err != nil {
+		colors.Warning(fmt.Sprintf("failed to rotate %s: %v", s.options.EventsPath, err))
+	}
+}
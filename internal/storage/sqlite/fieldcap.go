@@ -0,0 +1,45 @@
+// File: fieldcap.go
+// Purpose: Guards against pathological field sizes, such as a multi-megabyte
+// message, that would bloat the database and every TSV line built from it.
+package sqlite
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+)
+
+// maxFieldLength is the most bytes any single notification field may hold.
+// It applies whether or not max_message_length is configured.
+const maxFieldLength = 64 * 1024
+
+// capField cuts value to at most maxFieldLength bytes, ending with the
+// truncation indicator. The cut never splits a multi-byte rune. It reports
+// whether value was cut.
+func capField(value string) (string, bool) {
+	if len(value) <= maxFieldLength {
+		return value, false
+	}
+	end := maxFieldLength - len(truncationIndicator)
+	for end > 0 && !utf8.RuneStart(value[end]) {
+		end--
+	}
+	return value[:end] + truncationIndicator, true
+}
+
+// capMessageForWrite caps a message before it is stored and warns when it
+// had to be cut.
+func capMessageForWrite(message string) string {
+	capped, cut := capField(message)
+	if cut {
+		colors.Warning(fmt.Sprintf("message is %d bytes; storing the first %d", len(message), maxFieldLength))
+	}
+	return capped
+}
+
+// warnOversizedFields reports a stored notification whose fields were cut
+// on read. Only rows written before the write-time cap can trigger it.
+func warnOversizedFields(id int64) {
+	colors.Warning(fmt.Sprintf("notification %d has a field over %d bytes; showing it truncated", id, maxFieldLength))
+}
@@ -0,0 +1,32 @@
+// File: filter.go
+// Purpose: Defines the filter that selects notifications for listing, paging
+// and bulk level changes.
+package sqlite
+
+// ListFilter selects notifications. Each field narrows the result; an empty
+// field matches every notification.
+type ListFilter struct {
+	State     string // "active", "dismissed", or "all"
+	Level     string // "info", "warning", "error", or "critical"
+	Session   string
+	Window    string
+	Pane      string
+	OlderThan string // only notifications timestamped before this RFC3339 cutoff
+	NewerThan string // only notifications timestamped after this RFC3339 cutoff
+	Read      string // "read" or "unread"
+	Tags      string // comma-separated; notifications carrying any of these tags
+}
+
+// normalized validates f and returns it with Tags normalized the way tags are
+// stored.
+func (f ListFilter) normalized() (ListFilter, error) {
+	if err := validateListInputs(f.State, f.Level, f.OlderThan, f.NewerThan); err != nil {
+		return ListFilter{}, err
+	}
+	tags, err := normalizeTagFilter(f.Tags)
+	if err != nil {
+		return ListFilter{}, err
+	}
+	f.Tags = tags
+	return f, nil
+}
@@ -0,0 +1,179 @@
+// File: import.go
+// Purpose: Appends previously exported notifications, keeping their state,
+// read status and per-notification extras.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// ImportNotifications appends notifications and returns how many were stored.
+// Every notification is validated like a new one before anything is written,
+// and all of them are written in one transaction, so an import stores either
+// everything or nothing. With regenerateIDs each notification gets the next
+// free ID; otherwise the given IDs are kept and an ID that already exists is
+// rejected. Imports run no hooks.
+func (s *SQLiteStorage) ImportNotifications(notifications []domain.Notification, regenerateIDs bool) (int, error) {
+	prepared := make([]domain.Notification, 0, len(notifications))
+	for i, notif := range notifications {
+		normalized, err := prepareImport(notif, regenerateIDs)
+		if err != nil {
+			return 0, fmt.Errorf("import notification %d: %w", i+1, err)
+		}
+		prepared = append(prepared, normalized)
+	}
+	if len(prepared) == 0 {
+		return 0, nil
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: begin import: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+
+	now := utcNow()
+	for _, notif := range prepared {
+		id := int64(notif.ID)
+		if regenerateIDs {
+			id, err = q.NextNotificationID(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("sqlite storage: get next id: %w", err)
+			}
+		} else if _, err := q.GetNotificationLineByID(ctx, id); err == nil {
+			return 0, fmt.Errorf("import notification %d: id already exists", id)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("sqlite storage: import notification: %w", err)
+		}
+		if err := importNotification(ctx, q, id, notif, now); err != nil {
+			return 0, err
+		}
+		if err := q.RecordNotificationIDHighWater(ctx); err != nil {
+			return 0, fmt.Errorf("sqlite storage: record id high-water mark: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite storage: commit import: %w", err)
+	}
+	s.syncTmuxStatusOption()
+	return len(prepared), nil
+}
+
+// prepareImport validates an imported notification and fills the defaults a
+// new notification would get.
+func prepareImport(notif domain.Notification, regenerateIDs bool) (domain.Notification, error) {
+	if !regenerateIDs && notif.ID <= 0 {
+		return notif, fmt.Errorf("validation error: invalid id %d", notif.ID)
+	}
+	if err := validateNotificationInputs(notif.Message, notif.Timestamp, notif.Session, notif.Window, notif.Pane, string(notif.Level)); err != nil {
+		return notif, err
+	}
+	switch notif.State {
+	case "":
+		notif.State = domain.StateActive
+	case domain.StateActive, domain.StateDismissed:
+	default:
+		return notif, fmt.Errorf("validation error: invalid state '%s', must be one of: active, dismissed", notif.State)
+	}
+	if err := validateImportTime("read_timestamp", notif.ReadTimestamp); err != nil {
+		return notif, err
+	}
+	if err := validateImportTime("snoozed_until", notif.SnoozedUntil); err != nil {
+		return notif, err
+	}
+	if notif.Occurrences < 0 {
+		return notif, fmt.Errorf("validation error: invalid occurrences %d", notif.Occurrences)
+	}
+	if notif.Color != "" && !domain.IsValidColor(notif.Color) {
+		return notif, fmt.Errorf("validation error: invalid color '%s'", notif.Color)
+	}
+	tags, err := domain.NormalizeTags(notif.Tags)
+	if err != nil {
+		return notif, fmt.Errorf("validation error: %w", err)
+	}
+	notif.Tags = tags
+	notif.Message = capMessageForWrite(notif.Message)
+	if notif.Timestamp == "" {
+		notif.Timestamp = utcNow()
+	}
+	return notif, nil
+}
+
+// validateImportTime checks that an optional imported time is RFC3339.
+func validateImportTime(name, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return fmt.Errorf("validation error: invalid %s format '%s', expected RFC3339 format", name, value)
+	}
+	return nil
+}
+
+// importNotification writes one prepared notification and its extras under id.
+func importNotification(ctx context.Context, q *sqlcgen.Queries, id int64, notif domain.Notification, now string) error {
+	if err := q.ImportNotification(ctx, sqlcgen.ImportNotificationParams{
+		ID:            id,
+		Timestamp:     notif.Timestamp,
+		State:         string(notif.State),
+		Session:       notif.Session,
+		Window:        notif.Window,
+		Pane:          notif.Pane,
+		Message:       notif.Message,
+		PaneCreated:   notif.PaneCreated,
+		Level:         string(notif.Level),
+		ReadTimestamp: notif.ReadTimestamp,
+		UpdatedAt:     now,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: import notification: %w", err)
+	}
+	if notif.Important {
+		if err := q.MarkImportant(ctx, sqlcgen.MarkImportantParams{NotificationID: id, MarkedAt: now}); err != nil {
+			return fmt.Errorf("sqlite storage: import important flag: %w", err)
+		}
+	}
+	if notif.Color != "" {
+		if err := q.InsertNotificationColor(ctx, sqlcgen.InsertNotificationColorParams{NotificationID: id, Color: notif.Color}); err != nil {
+			return fmt.Errorf("sqlite storage: import color: %w", err)
+		}
+	}
+	if notif.Owner != "" {
+		if err := q.AssignNotificationOwner(ctx, sqlcgen.AssignNotificationOwnerParams{NotificationID: id, Owner: notif.Owner, AssignedAt: now}); err != nil {
+			return fmt.Errorf("sqlite storage: import owner: %w", err)
+		}
+	}
+	if notif.SnoozedUntil != "" {
+		if err := q.UpsertNotificationSnooze(ctx, sqlcgen.UpsertNotificationSnoozeParams{NotificationID: id, SnoozedUntil: notif.SnoozedUntil}); err != nil {
+			return fmt.Errorf("sqlite storage: import snooze: %w", err)
+		}
+	}
+	if notif.Priority != 0 {
+		if err := q.InsertNotificationPriority(ctx, sqlcgen.InsertNotificationPriorityParams{NotificationID: id, Priority: int64(notif.Priority)}); err != nil {
+			return fmt.Errorf("sqlite storage: import priority: %w", err)
+		}
+	}
+	if notif.Occurrences > 1 {
+		if err := q.InsertNotificationOccurrences(ctx, sqlcgen.InsertNotificationOccurrencesParams{NotificationID: id, Occurrences: int64(notif.Occurrences)}); err != nil {
+			return fmt.Errorf("sqlite storage: import occurrences: %w", err)
+		}
+	}
+	for _, tag := range notif.Tags {
+		if err := q.InsertNotificationTag(ctx, sqlcgen.InsertNotificationTagParams{NotificationID: id, Tag: tag}); err != nil {
+			return fmt.Errorf("sqlite storage: import tag: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// File: important.go
+// Purpose: Manages the user-set "important" flag, which is kept apart from the
+// notification row so state transitions never touch it.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// importantFlag is the value of the important TSV field for flagged notifications.
+const importantFlag = "1"
+
+// SetImportant flags or unflags a notification as important. The flag is
+// independent of state and read status, so dismissing keeps it.
+func (s *SQLiteStorage) SetImportant(id string, important bool) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: set important: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: set important: %w", err)
+	}
+
+	if important {
+		err = s.queries.MarkImportant(context.Background(), sqlcgen.MarkImportantParams{
+			NotificationID: idInt,
+			MarkedAt:       utcNow(),
+		})
+	} else {
+		err = s.queries.UnmarkImportant(context.Background(), idInt)
+	}
+	if err != nil {
+		return fmt.Errorf("sqlite storage: set important: %w", err)
+	}
+	return nil
+}
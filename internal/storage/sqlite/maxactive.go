@@ -0,0 +1,59 @@
+// File: maxactive.go
+// Purpose: Caps the number of active notifications by dismissing the oldest
+// ones when an add goes over max_active.
+package sqlite
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+)
+
+// AddNotificationWithResult adds a notification like AddNotification and also
+// reports the notifications dismissed to stay within max_active.
+func (s *SQLiteStorage) AddNotificationWithResult(message, timestamp, session, window, pane, paneCreated, level string) (domain.AddNotificationResult, error) {
+	return s.addNotificationResult(message, timestamp, session, window, pane, paneCreated, level, domain.AddOptions{})
+}
+
+// evictOverMaxActive dismisses the oldest active notifications, by timestamp,
+// until no more than max_active remain, running the usual dismiss hooks for
+// each. The notification just added, keep, is never evicted. It returns the
+// evicted IDs, and does nothing when max_active is 0.
+func (s *SQLiteStorage) evictOverMaxActive(keep int64) ([]string, error) {
+	limit := s.options.MaxActive
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	active, err := s.listActiveNotificationsByFilter("", "", "")
+	if err != nil {
+		return nil, err
+	}
+	excess := len(active) - limit
+	if excess <= 0 {
+		return nil, nil
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].timestamp < active[j].timestamp
+	})
+
+	var evicted []string
+	for _, notification := range active {
+		if len(evicted) == excess {
+			break
+		}
+		if notification.id == keep {
+			continue
+		}
+		changed, err := s.dismissSingleNotification(notification)
+		if err != nil {
+			return evicted, err
+		}
+		if !changed {
+			continue
+		}
+		evicted = append(evicted, strconv.FormatInt(notification.id, 10))
+	}
+	return evicted, nil
+}
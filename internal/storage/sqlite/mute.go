@@ -0,0 +1,74 @@
+// File: mute.go
+// Purpose: Implements muted sessions, whose new notifications are stored
+// already read and skip add hooks.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// MuteSession mutes notifications for a session. Muting an already muted session is a no-op.
+func (s *SQLiteStorage) MuteSession(session string) error {
+	if strings.TrimSpace(session) == "" {
+		return fmt.Errorf("validation error: session cannot be empty")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+	if err := s.queries.MuteSession(context.Background(), sqlcgen.MuteSessionParams{
+		Session: session,
+		MutedAt: utcNow(),
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: mute session: %w", err)
+	}
+	return nil
+}
+
+// UnmuteSession restores normal notification behavior for a session.
+func (s *SQLiteStorage) UnmuteSession(session string) error {
+	if strings.TrimSpace(session) == "" {
+		return fmt.Errorf("validation error: session cannot be empty")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+	res, err := s.queries.UnmuteSession(context.Background(), session)
+	if err != nil {
+		return fmt.Errorf("sqlite storage: unmute session: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("sqlite storage: read rows affected: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("sqlite storage: unmute session: %w: %s", ErrSessionNotMuted, session)
+	}
+	return nil
+}
+
+// ListMutedSessions returns muted sessions sorted by name.
+func (s *SQLiteStorage) ListMutedSessions() ([]string, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, err
+	}
+	sessions, err := s.queries.ListMutedSessions(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite storage: list muted sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+func (s *SQLiteStorage) isSessionMuted(session string) (bool, error) {
+	if session == "" {
+		return false, nil
+	}
+	count, err := s.queries.IsSessionMuted(context.Background(), session)
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: check muted session: %w", err)
+	}
+	return count > 0, nil
+}
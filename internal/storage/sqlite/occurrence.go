@@ -0,0 +1,84 @@
+// File: occurrence.go
+// Purpose: Links repeated notifications to the previous occurrence of the same
+// message so callers can show when it was last seen.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/dedup"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// recordPreviousOccurrence links a new notification to the most recent earlier
+// notification that shares its dedup key, as built with criteria.
+// Nothing is recorded for the first occurrence of a message.
+func recordPreviousOccurrence(ctx context.Context, q *sqlcgen.Queries, criteria dedup.Options, id int64, message, session, window, pane, level string) error {
+	candidates, err := q.ListPriorOccurrences(ctx, sqlcgen.ListPriorOccurrencesParams{
+		Message: message,
+		ID:      id,
+	})
+	if err != nil {
+		return fmt.Errorf("sqlite storage: find previous occurrence: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	current := dedup.Record{Message: message, Level: level, Session: session, Window: window, Pane: pane, State: "active"}
+	for _, candidate := range candidates {
+		previous := dedup.Record{
+			Message: message,
+			Level:   candidate.Level,
+			Session: candidate.Session,
+			Window:  candidate.Window,
+			Pane:    candidate.Pane,
+			State:   candidate.State,
+		}
+		keys := dedup.BuildKeys([]dedup.Record{current, previous}, criteria)
+		if keys[0] != keys[1] {
+			continue
+		}
+		if err := q.InsertPreviousOccurrence(ctx, sqlcgen.InsertPreviousOccurrenceParams{
+			NotificationID:    id,
+			PreviousID:        candidate.ID,
+			PreviousTimestamp: candidate.Timestamp,
+		}); err != nil {
+			return fmt.Errorf("sqlite storage: record previous occurrence: %w", err)
+		}
+		return nil
+	}
+	return nil
+}
+
+// GetPreviousOccurrence returns the timestamp of the occurrence that preceded
+// a notification with the same message. It returns an empty string for the
+// first occurrence.
+func (s *SQLiteStorage) GetPreviousOccurrence(id string) (string, error) {
+	idInt, err := parseID(id)
+	if err != nil {
+		return "", err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return "", err
+	}
+
+	previous, err := s.queries.GetPreviousOccurrence(context.Background(), idInt)
+	if err == nil {
+		return previous.PreviousTimestamp, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("sqlite storage: get previous occurrence: %w", err)
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("sqlite storage: get previous occurrence: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return "", fmt.Errorf("sqlite storage: get previous occurrence: %w", err)
+	}
+	return "", nil
+}
@@ -0,0 +1,70 @@
+// File: options.go
+// Purpose: Holds the configuration SQLiteStorage reads once at construction,
+// so operations never reload the config file.
+package sqlite
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/dedup"
+	"github.com/cristianoliveira/tmux-intray/internal/dedupconfig"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
+)
+
+// Options configures the optional behavior of SQLiteStorage. The zero value
+// turns every optional behavior off and writes tmux options once.
+type Options struct {
+	// MaxMessageLength cuts longer messages; 0 keeps them whole.
+	MaxMessageLength int
+	// KeepFullMessage stores the original of a cut message.
+	KeepFullMessage bool
+	// MaxActive caps the active notifications; 0 means no cap.
+	MaxActive int
+	// AutoDismissStaleDays dismisses active notifications older than this many
+	// days on cleanup; 0 disables it.
+	AutoDismissStaleDays int
+	// AutoDismissLowerLevels dismisses lower levels for a pane when a higher
+	// level arrives for it.
+	AutoDismissLowerLevels bool
+	// EscalateAfter is how long a critical notification stays unread before it
+	// escalates; 0 disables escalation.
+	EscalateAfter time.Duration
+	// Dedup decides which notifications count as the same one.
+	Dedup dedup.Options
+	// CollapseWindow collapses an add into a matching active notification this
+	// recent; 0 disables collapsing.
+	CollapseWindow time.Duration
+	// StatusZeroGrace delays writing a zero active count to tmux.
+	StatusZeroGrace time.Duration
+	// TmuxOptionRetries is the number of attempts for a tmux option write;
+	// values below 1 mean one attempt.
+	TmuxOptionRetries int
+	// TmuxOptionRetryInterval is the first wait between those attempts.
+	TmuxOptionRetryInterval time.Duration
+	// EventsPath is the events.jsonl stream adds, dismissals and reads are
+	// appended to; empty records nothing.
+	EventsPath string
+}
+
+// OptionsFromConfig returns the options set in the loaded configuration. It
+// does not load the configuration itself; call config.Load first.
+func OptionsFromConfig() Options {
+	criteria := dedupconfig.Current()
+	criteria.Window = 0
+	return Options{
+		MaxMessageLength:        config.GetInt("max_message_length", 0),
+		KeepFullMessage:         config.GetBool("keep_full_message", false),
+		MaxActive:               config.GetInt("max_active", 0),
+		AutoDismissStaleDays:    config.GetInt("auto_dismiss_stale_days", 0),
+		AutoDismissLowerLevels:  config.GetBool("auto_dismiss_lower_levels", false),
+		EscalateAfter:           config.GetDuration("escalate_after", 0),
+		Dedup:                   criteria,
+		CollapseWindow:          config.GetDuration("dedup.collapse_window", 0),
+		StatusZeroGrace:         config.GetDuration("status_zero_grace", 0),
+		TmuxOptionRetries:       config.GetInt("tmux_option_retries", 3),
+		TmuxOptionRetryInterval: config.GetDuration("tmux_option_retry_interval", 20*time.Millisecond),
+		EventsPath:              filepath.Join(config.Get("state_dir", ""), events.FileName),
+	}
+}
@@ -0,0 +1,52 @@
+// File: owner.go
+// Purpose: Records who claimed a notification in a shared tray. The owner is
+// kept apart from the notification row so state transitions never touch it.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// AssignNotification sets the owner of a notification. An empty owner clears
+// the assignment. The owner is independent of state and read status, so
+// dismissing keeps it.
+func (s *SQLiteStorage) AssignNotification(id, owner string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	owner = strings.TrimSpace(owner)
+	if strings.ContainsAny(owner, "\t\n\r") {
+		return fmt.Errorf("validation error: owner cannot contain tabs or newlines")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: assign notification: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: assign notification: %w", err)
+	}
+
+	if owner == "" {
+		err = s.queries.ClearNotificationOwner(context.Background(), idInt)
+	} else {
+		err = s.queries.AssignNotificationOwner(context.Background(), sqlcgen.AssignNotificationOwnerParams{
+			NotificationID: idInt,
+			Owner:          owner,
+			AssignedAt:     utcNow(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("sqlite storage: assign notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// File: pane_badges.go
+// Purpose: Publishes per-pane unread counts as tmux options so pane borders
+// can show pending notifications.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+)
+
+// paneBadgePrefix starts the name of every pane badge option.
+const paneBadgePrefix = "@tmux_intray_pane_"
+
+// paneBadgeOption returns the tmux option holding the unread count for a pane.
+// The leading "%" of pane IDs is dropped, so pane %3 maps to @tmux_intray_pane_3_count.
+func paneBadgeOption(pane string) string {
+	return fmt.Sprintf("%s%s_count", paneBadgePrefix, strings.TrimPrefix(pane, "%"))
+}
+
+// syncTmuxPaneBadges refreshes pane badges when tmux is running.
+func (s *SQLiteStorage) syncTmuxPaneBadges() {
+	running, err := tmuxClient.HasSession()
+	if err != nil || !running {
+		return
+	}
+	if err := s.updatePaneBadges(); err != nil {
+		colors.Error(fmt.Sprintf("failed to update tmux pane badges: %v", err))
+	}
+}
+
+// updatePaneBadges sets the badge option for every pane whose unread count
+// differs from the one tmux holds, and unsets the badges of panes with none.
+// It diffs against the options the tmux server holds now rather than a copy
+// of what was last published, so a restarted server gets every badge again.
+// The counts are read before any tmux call, so no transaction waits on tmux.
+func (s *SQLiteStorage) updatePaneBadges() error {
+	counts, err := s.queries.CountUnreadByPane(context.Background(), utcNow())
+	if err != nil {
+		return fmt.Errorf("sqlite storage: count unread by pane: %w", err)
+	}
+	published, err := tmuxClient.ListStatusOptions(paneBadgePrefix)
+	if err != nil {
+		return fmt.Errorf("list pane badges: %w", err)
+	}
+
+	for _, row := range counts {
+		name := paneBadgeOption(row.Pane)
+		value := fmt.Sprintf("%d", row.Count)
+		current, ok := published[name]
+		delete(published, name)
+		if ok && current == value {
+			continue
+		}
+		if err := tmuxClient.SetStatusOption(name, value); err != nil {
+			return fmt.Errorf("set pane badge for %s: %w", row.Pane, err)
+		}
+	}
+
+	stale := make([]string, 0, len(published))
+	for name := range published {
+		if strings.HasSuffix(name, "_count") {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	for _, name := range stale {
+		if err := tmuxClient.UnsetStatusOption(name); err != nil {
+			return fmt.Errorf("unset pane badge %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// File: pane_context.go
+// Purpose: Stores the snapshot of recent pane output captured when a
+// notification was added.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// SetPaneContext stores captured pane output for a notification, replacing any
+// earlier capture.
+func (s *SQLiteStorage) SetPaneContext(id, content string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: set pane context: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: set pane context: %w", err)
+	}
+	if err := s.queries.InsertPaneContext(context.Background(), sqlcgen.InsertPaneContextParams{
+		NotificationID: idInt,
+		Content:        content,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: set pane context: %w", err)
+	}
+	return nil
+}
+
+// GetPaneContext returns the pane output captured when a notification was added.
+// It returns an empty string when nothing was captured.
+func (s *SQLiteStorage) GetPaneContext(id string) (string, error) {
+	idInt, err := parseID(id)
+	if err != nil {
+		return "", err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return "", err
+	}
+
+	content, err := s.queries.GetPaneContext(context.Background(), idInt)
+	if err == nil {
+		return content, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("sqlite storage: get pane context: %w", err)
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("sqlite storage: get pane context: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return "", fmt.Errorf("sqlite storage: get pane context: %w", err)
+	}
+	return "", nil
+}
@@ -0,0 +1,82 @@
+// File: pending_actions.go
+// Purpose: Queues actions, such as jumps, requested while tmux was not
+// running so they can run once tmux is available.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/ports"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// QueuePendingAction queues action for the notification with id.
+func (s *SQLiteStorage) QueuePendingAction(action, id string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: queue pending action: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: queue pending action: %w", err)
+	}
+	if err := s.queries.InsertPendingAction(context.Background(), sqlcgen.InsertPendingActionParams{
+		Action:         action,
+		NotificationID: idInt,
+		QueuedAt:       time.Now().UTC().Format(time.RFC3339),
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: queue pending action: %w", err)
+	}
+	return nil
+}
+
+// ListPendingActions returns queued actions, oldest first.
+func (s *SQLiteStorage) ListPendingActions() ([]ports.PendingAction, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListPendingActions(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite storage: list pending actions: %w", err)
+	}
+	actions := make([]ports.PendingAction, 0, len(rows))
+	for _, row := range rows {
+		actions = append(actions, ports.PendingAction{
+			ID:             row.ID,
+			Action:         row.Action,
+			NotificationID: strconv.FormatInt(row.NotificationID, 10),
+			QueuedAt:       row.QueuedAt,
+		})
+	}
+	return actions, nil
+}
+
+// ClaimPendingAction removes a queued action and reports whether this call
+// removed it. Only the caller that claims an action may run it, so two
+// commands draining the queue at once never run the same action twice.
+func (s *SQLiteStorage) ClaimPendingAction(actionID int64) (bool, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return false, err
+	}
+	result, err := s.queries.DeletePendingAction(context.Background(), actionID)
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: claim pending action: %w", err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: claim pending action: %w", err)
+	}
+	return removed == 1, nil
+}
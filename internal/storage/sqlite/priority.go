@@ -0,0 +1,26 @@
+// File: priority.go
+// Purpose: Stores per-notification priorities that order notifications of the
+// same level in the TUI.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// recordPriority stores the priority for a notification. A priority of 0
+// stores nothing, so the notification keeps the default.
+func recordPriority(ctx context.Context, q *sqlcgen.Queries, id int64, priority int) error {
+	if priority == 0 {
+		return nil
+	}
+	if err := q.InsertNotificationPriority(ctx, sqlcgen.InsertNotificationPriorityParams{
+		NotificationID: id,
+		Priority:       int64(priority),
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: record priority: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,13 @@
 -- name: NextNotificationID :one
-SELECT COALESCE(MAX(id), 0) + 1 AS next_id
-FROM notifications;
+SELECT MAX(
+    COALESCE((SELECT MAX(id) FROM notifications), 0),
+    COALESCE((SELECT last_id FROM id_high_water WHERE name = 'notifications'), 0)
+) + 1 AS next_id;
+
+-- name: RecordNotificationIDHighWater :exec
+INSERT INTO id_high_water (name, last_id)
+SELECT 'notifications', COALESCE(MAX(id), 0) FROM notifications WHERE true
+ON CONFLICT(name) DO UPDATE SET last_id = MAX(last_id, excluded.last_id);
 
 -- name: CreateNotification :exec
 INSERT INTO notifications (
@@ -16,11 +23,28 @@ INSERT INTO notifications (
     read_timestamp,
     updated_at
 )
-VALUES (?, ?, 'active', ?, ?, ?, ?, ?, ?, '', ?);
+VALUES (?, ?, 'active', ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ImportNotification :exec
+INSERT INTO notifications (
+    id,
+    timestamp,
+    state,
+    session,
+    window,
+    pane,
+    message,
+    pane_created,
+    level,
+    read_timestamp,
+    updated_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetNotificationLineByID :one
-SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp
-FROM notifications
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
 WHERE id = ?;
 
 -- name: GetNotificationForHooksByID :one
@@ -35,7 +59,42 @@ WHERE state = 'active'
 ORDER BY id ASC;
 
 -- name: ListNotifications :many
-SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND (sqlc.arg(level_filter) = '' OR level = sqlc.arg(level_filter))
+  AND (sqlc.arg(session_filter) = '' OR session = sqlc.arg(session_filter))
+  AND (sqlc.arg(window_filter) = '' OR window = sqlc.arg(window_filter))
+  AND (sqlc.arg(pane_filter) = '' OR pane = sqlc.arg(pane_filter))
+  AND (sqlc.arg(older_than_cutoff) = '' OR timestamp < sqlc.arg(older_than_cutoff))
+  AND (sqlc.arg(newer_than_cutoff) = '' OR timestamp > sqlc.arg(newer_than_cutoff))
+  AND (sqlc.arg(read_filter) = '' OR (sqlc.arg(read_filter) = 'read' AND read_timestamp != '') OR (sqlc.arg(read_filter) = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (sqlc.arg(state_filter) != 'active' OR snoozed_until <= sqlc.arg(now))
+  AND (sqlc.arg(tag_filter) = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notification_lines.id AND instr(',' || sqlc.arg(tag_filter) || ',', ',' || tag || ',') > 0))
+ORDER BY id ASC;
+
+-- name: ListNotificationsPage :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND (sqlc.arg(level_filter) = '' OR level = sqlc.arg(level_filter))
+  AND (sqlc.arg(session_filter) = '' OR session = sqlc.arg(session_filter))
+  AND (sqlc.arg(window_filter) = '' OR window = sqlc.arg(window_filter))
+  AND (sqlc.arg(pane_filter) = '' OR pane = sqlc.arg(pane_filter))
+  AND (sqlc.arg(older_than_cutoff) = '' OR timestamp < sqlc.arg(older_than_cutoff))
+  AND (sqlc.arg(newer_than_cutoff) = '' OR timestamp > sqlc.arg(newer_than_cutoff))
+  AND (sqlc.arg(read_filter) = '' OR (sqlc.arg(read_filter) = 'read' AND read_timestamp != '') OR (sqlc.arg(read_filter) = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (sqlc.arg(state_filter) != 'active' OR snoozed_until <= sqlc.arg(now))
+  AND (sqlc.arg(tag_filter) = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notification_lines.id AND instr(',' || sqlc.arg(tag_filter) || ',', ',' || tag || ',') > 0))
+ORDER BY id ASC
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CountNotifications :one
+SELECT COUNT(1)
 FROM notifications
 WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
   AND (sqlc.arg(level_filter) = '' OR level = sqlc.arg(level_filter))
@@ -45,11 +104,28 @@ WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state =
   AND (sqlc.arg(older_than_cutoff) = '' OR timestamp < sqlc.arg(older_than_cutoff))
   AND (sqlc.arg(newer_than_cutoff) = '' OR timestamp > sqlc.arg(newer_than_cutoff))
   AND (sqlc.arg(read_filter) = '' OR (sqlc.arg(read_filter) = 'read' AND read_timestamp != '') OR (sqlc.arg(read_filter) = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (sqlc.arg(state_filter) != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > sqlc.arg(now)))
+  AND (sqlc.arg(tag_filter) = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notifications.id AND instr(',' || sqlc.arg(tag_filter) || ',', ',' || tag || ',') > 0));
+
+-- name: ListNotificationsSinceID :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE id > sqlc.arg(after_id)
+  AND (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (sqlc.arg(state_filter) != 'active' OR snoozed_until <= sqlc.arg(now))
 ORDER BY id ASC;
 
 -- name: DismissNotificationByID :execresult
 UPDATE notifications
 SET state = 'dismissed', updated_at = sqlc.arg(updated_at)
+WHERE id = sqlc.arg(id) AND state = 'active';
+
+-- name: UndismissNotificationByID :execresult
+UPDATE notifications
+SET state = 'active', updated_at = sqlc.arg(updated_at)
 WHERE id = sqlc.arg(id);
 
 -- name: UpdateReadTimestampByID :execresult
@@ -57,6 +133,17 @@ UPDATE notifications
 SET read_timestamp = sqlc.arg(read_timestamp), updated_at = sqlc.arg(updated_at)
 WHERE id = sqlc.arg(id);
 
+-- name: MarkAllActiveRead :many
+UPDATE notifications
+SET read_timestamp = sqlc.arg(read_timestamp), updated_at = sqlc.arg(updated_at)
+WHERE state = 'active' AND read_timestamp = ''
+RETURNING id;
+
+-- name: MarkAllActiveUnread :execresult
+UPDATE notifications
+SET read_timestamp = '', updated_at = sqlc.arg(updated_at)
+WHERE state = 'active' AND read_timestamp != '';
+
 -- name: DismissNotificationsByFilter :execresult
 UPDATE notifications
 SET state = 'dismissed', updated_at = sqlc.arg(updated_at)
@@ -65,6 +152,21 @@ WHERE state = 'active'
   AND (sqlc.arg(window_filter) = '' OR window = sqlc.arg(window_filter))
   AND (sqlc.arg(pane_filter) = '' OR pane = sqlc.arg(pane_filter));
 
+-- name: RelevelNotificationsByFilter :execresult
+UPDATE notifications
+SET level = sqlc.arg(new_level), updated_at = sqlc.arg(updated_at)
+WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND (sqlc.arg(level_filter) = '' OR level = sqlc.arg(level_filter))
+  AND (sqlc.arg(session_filter) = '' OR session = sqlc.arg(session_filter))
+  AND (sqlc.arg(window_filter) = '' OR window = sqlc.arg(window_filter))
+  AND (sqlc.arg(pane_filter) = '' OR pane = sqlc.arg(pane_filter))
+  AND (sqlc.arg(older_than_cutoff) = '' OR timestamp < sqlc.arg(older_than_cutoff))
+  AND (sqlc.arg(newer_than_cutoff) = '' OR timestamp > sqlc.arg(newer_than_cutoff))
+  AND (sqlc.arg(read_filter) = '' OR (sqlc.arg(read_filter) = 'read' AND read_timestamp != '') OR (sqlc.arg(read_filter) = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (sqlc.arg(tag_filter) = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notifications.id AND instr(',' || sqlc.arg(tag_filter) || ',', ',' || tag || ',') > 0))
+  AND level != sqlc.arg(new_level);
+
 -- name: CountDismissedForCleanup :one
 SELECT COUNT(1)
 FROM notifications
@@ -79,7 +181,9 @@ WHERE state = 'dismissed'
 -- name: CountActiveNotifications :one
 SELECT COUNT(1)
 FROM notifications
-WHERE state = 'active';
+WHERE state = 'active'
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > sqlc.arg(now));
 
 -- name: UpsertNotification :exec
 INSERT INTO notifications (
@@ -107,3 +211,225 @@ ON CONFLICT(id) DO UPDATE SET
     level = excluded.level,
     read_timestamp = excluded.read_timestamp,
      updated_at = excluded.updated_at;
+
+-- name: MuteSession :exec
+INSERT INTO muted_sessions (session, muted_at)
+VALUES (?, ?)
+ON CONFLICT(session) DO NOTHING;
+
+-- name: UnmuteSession :execresult
+DELETE FROM muted_sessions
+WHERE session = ?;
+
+-- name: IsSessionMuted :one
+SELECT COUNT(1)
+FROM muted_sessions
+WHERE session = ?;
+
+-- name: ListMutedSessions :many
+SELECT session
+FROM muted_sessions
+ORDER BY session;
+
+-- name: ListEscalationCandidates :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level
+FROM notifications
+WHERE state = 'active'
+  AND level = 'critical'
+  AND read_timestamp = ''
+  AND timestamp < sqlc.arg(cutoff)
+  AND id NOT IN (SELECT notification_id FROM escalations)
+ORDER BY id ASC;
+
+-- name: InsertEscalation :exec
+INSERT INTO escalations (notification_id, escalated_at)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO NOTHING;
+
+-- name: InsertMessageOriginal :exec
+INSERT INTO message_originals (notification_id, message)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET message = excluded.message;
+
+-- name: GetMessageOriginal :one
+SELECT message
+FROM message_originals
+WHERE notification_id = ?;
+
+-- name: DeleteMessageOriginal :exec
+DELETE FROM message_originals
+WHERE notification_id = ?;
+
+-- name: ListPriorOccurrences :many
+SELECT id, timestamp, session, window, pane, level, state
+FROM notifications
+WHERE message = ?
+  AND id < ?
+ORDER BY id DESC;
+
+-- name: ListDismissedSince :many
+SELECT id, session, window, pane, level
+FROM notifications
+WHERE message = ?
+  AND state = 'dismissed'
+  AND updated_at >= ?
+ORDER BY id DESC;
+
+-- name: FindActiveDuplicate :one
+SELECT id, timestamp
+FROM notifications
+WHERE state = 'active'
+  AND message = sqlc.arg(message)
+  AND level = sqlc.arg(level)
+  AND session = sqlc.arg(session)
+  AND window = sqlc.arg(window)
+  AND pane = sqlc.arg(pane)
+  AND timestamp >= sqlc.arg(since)
+ORDER BY timestamp DESC, id DESC
+LIMIT 1;
+
+-- name: UpdateNotificationTimestamp :exec
+UPDATE notifications
+SET timestamp = max(timestamp, sqlc.arg(timestamp)), updated_at = sqlc.arg(updated_at)
+WHERE id = sqlc.arg(id);
+
+-- name: UpdateNotificationMessage :exec
+UPDATE notifications
+SET message = sqlc.arg(message), updated_at = sqlc.arg(updated_at)
+WHERE id = sqlc.arg(id);
+
+-- name: InsertPreviousOccurrence :exec
+INSERT INTO previous_occurrences (notification_id, previous_id, previous_timestamp)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    previous_id = excluded.previous_id,
+    previous_timestamp = excluded.previous_timestamp;
+
+-- name: GetPreviousOccurrence :one
+SELECT previous_id, previous_timestamp
+FROM previous_occurrences
+WHERE notification_id = ?;
+
+-- name: InsertPaneContext :exec
+INSERT INTO pane_contexts (notification_id, content)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET content = excluded.content;
+
+-- name: GetPaneContext :one
+SELECT content
+FROM pane_contexts
+WHERE notification_id = ?;
+
+-- name: InsertNotificationColor :exec
+INSERT INTO notification_colors (notification_id, color)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET color = excluded.color;
+
+-- name: InsertNotificationOccurrences :exec
+INSERT INTO notification_occurrences (notification_id, occurrences)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET occurrences = excluded.occurrences;
+
+-- name: IncrementNotificationOccurrences :exec
+INSERT INTO notification_occurrences (notification_id, occurrences)
+VALUES (?, 2)
+ON CONFLICT(notification_id) DO UPDATE SET occurrences = occurrences + 1;
+
+-- name: InsertNotificationTag :exec
+INSERT OR IGNORE INTO notification_tags (notification_id, tag)
+VALUES (?, ?);
+
+-- name: InsertNotificationPriority :exec
+INSERT INTO notification_priorities (notification_id, priority)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET priority = excluded.priority;
+
+-- name: AssignNotificationOwner :exec
+INSERT INTO notification_owners (notification_id, owner, assigned_at)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET owner = excluded.owner, assigned_at = excluded.assigned_at;
+
+-- name: ClearNotificationOwner :exec
+DELETE FROM notification_owners
+WHERE notification_id = ?;
+
+-- name: MarkImportant :exec
+INSERT INTO important_notifications (notification_id, marked_at)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO NOTHING;
+
+-- name: UnmarkImportant :exec
+DELETE FROM important_notifications
+WHERE notification_id = ?;
+
+-- name: CountNotificationsByLevel :many
+SELECT level, COUNT(1) AS count
+FROM notifications
+WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (sqlc.arg(state_filter) != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > sqlc.arg(now)))
+GROUP BY level
+ORDER BY level;
+
+-- name: CountNotificationsBySession :many
+SELECT session, COUNT(1) AS count
+FROM notifications
+WHERE (sqlc.arg(state_filter) = '' OR sqlc.arg(state_filter) = 'all' OR state = sqlc.arg(state_filter))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (sqlc.arg(state_filter) != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > sqlc.arg(now)))
+GROUP BY session
+ORDER BY session;
+
+-- name: CountUnreadByPane :many
+SELECT pane, COUNT(1) AS count
+FROM notifications
+WHERE state = 'active'
+  AND read_timestamp = ''
+  AND pane != ''
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > sqlc.arg(now))
+GROUP BY pane
+ORDER BY pane;
+
+-- name: InsertPendingAction :exec
+INSERT INTO pending_actions (action, notification_id, queued_at)
+VALUES (?, ?, ?);
+
+-- name: ListPendingActions :many
+SELECT id, action, notification_id, queued_at
+FROM pending_actions
+ORDER BY id;
+
+-- name: DeletePendingAction :execresult
+DELETE FROM pending_actions
+WHERE id = ?;
+
+-- name: UpsertSessionSnooze :exec
+INSERT INTO session_snoozes (notification_id, session, snoozed_at)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    session = excluded.session,
+    snoozed_at = excluded.snoozed_at;
+
+-- name: ListSessionSnoozes :many
+SELECT notification_id, session, snoozed_at
+FROM session_snoozes
+ORDER BY notification_id;
+
+-- name: DeleteSessionSnooze :exec
+DELETE FROM session_snoozes
+WHERE notification_id = ?;
+
+-- name: UpsertNotificationSnooze :exec
+INSERT INTO notification_snoozes (notification_id, snoozed_until)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    snoozed_until = excluded.snoozed_until;
+
+-- name: DeleteNotificationSnooze :exec
+DELETE FROM notification_snoozes
+WHERE notification_id = ?;
+
+-- name: DeleteExpiredNotificationSnoozes :execresult
+DELETE FROM notification_snoozes
+WHERE snoozed_until <= sqlc.arg(now);
@@ -5,7 +5,9 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
 )
@@ -15,16 +17,141 @@ func (s *SQLiteStorage) MarkNotificationRead(id string) error {
 	return s.markNotificationReadState(id, utcNow())
 }
 
+// MarkNotificationReadWithTimestamp sets read_timestamp to the provided RFC3339 time.
+// Invalid timestamps are rejected without mutating the notification.
+func (s *SQLiteStorage) MarkNotificationReadWithTimestamp(id, timestamp string) error {
+	if _, err := time.Parse(time.RFC3339, timestamp); err != nil {
+		return fmt.Errorf("sqlite storage: mark read: %w: '%s', expected RFC3339 format", ErrInvalidTimestamp, timestamp)
+	}
+	return s.markNotificationReadState(id, timestamp)
+}
+
 // MarkNotificationUnread clears read_timestamp.
 func (s *SQLiteStorage) MarkNotificationUnread(id string) error {
 	return s.markNotificationReadState(id, "")
 }
 
+// MarkAllRead marks every unread active notification read in one statement
+// and returns how many changed.
+func (s *SQLiteStorage) MarkAllRead() (int, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	now := utcNow()
+	ids, err := s.queries.MarkAllActiveRead(context.Background(), sqlcgen.MarkAllActiveReadParams{
+		ReadTimestamp: now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: mark all read: %w", err)
+	}
+	if len(ids) > 0 {
+		s.recordReadEvents(ids)
+		s.syncTmuxPaneBadges()
+	}
+	return len(ids), nil
+}
+
+// MarkAllUnread marks every read active notification unread in one statement
+// and returns how many changed.
+func (s *SQLiteStorage) MarkAllUnread() (int, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	res, err := s.queries.MarkAllActiveUnread(context.Background(), utcNow())
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: mark all unread: %w", err)
+	}
+	return s.readStateChanged(res)
+}
+
+// MarkReadByIDs marks the given notifications read in one transaction and
+// returns how many were updated. IDs that no longer exist are skipped.
+func (s *SQLiteStorage) MarkReadByIDs(ids []string) (int, error) {
+	return s.markReadStateByIDs(ids, utcNow())
+}
+
+// MarkUnreadByIDs marks the given notifications unread in one transaction and
+// returns how many were updated. IDs that no longer exist are skipped.
+func (s *SQLiteStorage) MarkUnreadByIDs(ids []string) (int, error) {
+	return s.markReadStateByIDs(ids, "")
+}
+
+func (s *SQLiteStorage) markReadStateByIDs(ids []string, readTimestamp string) (int, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	idInts := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		idInt, err := parseID(id)
+		if err != nil {
+			return 0, err
+		}
+		idInts = append(idInts, idInt)
+	}
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: begin update read state: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+
+	now := utcNow()
+	changed := make([]int64, 0, len(idInts))
+	for _, idInt := range idInts {
+		res, err := q.UpdateReadTimestampByID(ctx, sqlcgen.UpdateReadTimestampByIDParams{
+			ReadTimestamp: readTimestamp,
+			UpdatedAt:     now,
+			ID:            idInt,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("sqlite storage: update read state: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("sqlite storage: read rows affected: %w", err)
+		}
+		if affected > 0 {
+			changed = append(changed, idInt)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("sqlite storage: commit update read state: %w", err)
+	}
+
+	if len(changed) == 0 {
+		return 0, nil
+	}
+	if readTimestamp != "" {
+		s.recordReadEvents(changed)
+	}
+	s.syncTmuxPaneBadges()
+	return len(changed), nil
+}
+
+// readStateChanged returns how many notifications a bulk read state update
+// changed and refreshes the pane badges when any did.
+func (s *SQLiteStorage) readStateChanged(res sql.Result) (int, error) {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: read rows affected: %w", err)
+	}
+	if affected > 0 {
+		s.syncTmuxPaneBadges()
+	}
+	return int(affected), nil
+}
+
 func (s *SQLiteStorage) markNotificationReadState(id, readTimestamp string) error {
 	idInt, err := parseID(id)
 	if err != nil {
 		return err
 	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
 
 	res, err := s.queries.UpdateReadTimestampByID(context.Background(), sqlcgen.UpdateReadTimestampByIDParams{
 		ReadTimestamp: readTimestamp,
@@ -42,6 +169,10 @@ func (s *SQLiteStorage) markNotificationReadState(id, readTimestamp string) erro
 	if affected == 0 {
 		return fmt.Errorf("sqlite storage: mark read state: %w: id %s", ErrNotificationNotFound, id)
 	}
+	if readTimestamp != "" {
+		s.recordReadEvents([]int64{idInt})
+	}
 
+	s.syncTmuxPaneBadges()
 	return nil
 }
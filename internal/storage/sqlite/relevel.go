@@ -0,0 +1,53 @@
+// File: relevel.go
+// Purpose: Implements bulk level changes for notifications matching list filters.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// RelevelByFilter sets the level of every notification matching f to newLevel
+// and returns how many changed. f means the same as in ListWithFilter. The update is a single statement, so concurrent writers
+// see either none or all of the changes. Notifications already at newLevel are
+// not counted.
+func (s *SQLiteStorage) RelevelByFilter(f ListFilter, newLevel string) (int, error) {
+	if !validLevels[newLevel] {
+		return 0, fmt.Errorf("validation error: invalid level '%s', must be one of: info, warning, error, critical", newLevel)
+	}
+	f, err := f.normalized()
+	if err != nil {
+		return 0, err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+
+	res, err := s.queries.RelevelNotificationsByFilter(context.Background(), sqlcgen.RelevelNotificationsByFilterParams{
+		NewLevel:        newLevel,
+		UpdatedAt:       utcNow(),
+		StateFilter:     f.State,
+		LevelFilter:     f.Level,
+		SessionFilter:   f.Session,
+		WindowFilter:    f.Window,
+		PaneFilter:      f.Pane,
+		OlderThanCutoff: f.OlderThan,
+		NewerThanCutoff: f.NewerThan,
+		ReadFilter:      f.Read,
+		TagFilter:       f.Tags,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: relevel notifications: %w", err)
+	}
+	changed, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: relevel notifications: %w", err)
+	}
+
+	if changed > 0 {
+		s.syncTmuxStatusOption()
+	}
+	return int(changed), nil
+}
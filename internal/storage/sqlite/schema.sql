@@ -18,3 +18,120 @@ CREATE INDEX IF NOT EXISTS idx_notifications_session ON notifications(session);
 CREATE INDEX IF NOT EXISTS idx_notifications_timestamp ON notifications(timestamp DESC);
 CREATE INDEX IF NOT EXISTS idx_notifications_state_timestamp ON notifications(state, timestamp DESC);
 CREATE INDEX IF NOT EXISTS idx_notifications_session_state_timestamp ON notifications(session, state, timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_message ON notifications(message);
+
+CREATE TABLE IF NOT EXISTS id_high_water (
+    name TEXT PRIMARY KEY,
+    last_id INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS muted_sessions (
+    session TEXT PRIMARY KEY,
+    muted_at TEXT NOT NULL CHECK (strftime('%s', muted_at) IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS escalations (
+    notification_id INTEGER PRIMARY KEY,
+    escalated_at TEXT NOT NULL CHECK (strftime('%s', escalated_at) IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS message_originals (
+    notification_id INTEGER PRIMARY KEY,
+    message TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS previous_occurrences (
+    notification_id INTEGER PRIMARY KEY,
+    previous_id INTEGER NOT NULL,
+    previous_timestamp TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS important_notifications (
+    notification_id INTEGER PRIMARY KEY,
+    marked_at TEXT NOT NULL CHECK (strftime('%s', marked_at) IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS notification_colors (
+    notification_id INTEGER PRIMARY KEY,
+    color TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notification_priorities (
+    notification_id INTEGER PRIMARY KEY,
+    priority INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notification_occurrences (
+    notification_id INTEGER PRIMARY KEY,
+    occurrences INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notification_tags (
+    notification_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (notification_id, tag)
+);
+
+CREATE TABLE IF NOT EXISTS notification_owners (
+    notification_id INTEGER PRIMARY KEY,
+    owner TEXT NOT NULL,
+    assigned_at TEXT NOT NULL CHECK (strftime('%s', assigned_at) IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS pane_contexts (
+    notification_id INTEGER PRIMARY KEY,
+    content TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pending_actions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action TEXT NOT NULL,
+    notification_id INTEGER NOT NULL,
+    queued_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS session_snoozes (
+    notification_id INTEGER PRIMARY KEY,
+    session TEXT NOT NULL,
+    snoozed_at TEXT NOT NULL CHECK (strftime('%s', snoozed_at) IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS notification_snoozes (
+    notification_id INTEGER PRIMARY KEY,
+    snoozed_until TEXT NOT NULL CHECK (strftime('%s', snoozed_until) IS NOT NULL)
+);
+
+-- notification_lines joins each notification with what is stored beside it,
+-- in TSV field order, for the queries that return whole notification lines.
+-- It is recreated on every open so its definition follows this file.
+DROP VIEW IF EXISTS notification_lines;
+CREATE VIEW notification_lines AS
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    EXISTS (SELECT 1 FROM important_notifications WHERE notification_id = notifications.id) AS important,
+    COALESCE((SELECT color FROM notification_colors WHERE notification_id = notifications.id), '') AS color,
+    COALESCE((SELECT owner FROM notification_owners WHERE notification_id = notifications.id), '') AS owner,
+    COALESCE((SELECT snoozed_until FROM notification_snoozes WHERE notification_id = notifications.id), '') AS snoozed_until,
+    COALESCE((SELECT priority FROM notification_priorities WHERE notification_id = notifications.id), 0) AS priority,
+    COALESCE((SELECT group_concat(tag, ',' ORDER BY tag) FROM notification_tags WHERE notification_id = notifications.id), '') AS tags,
+    COALESCE((SELECT occurrences FROM notification_occurrences WHERE notification_id = notifications.id), 0) AS occurrences
+FROM notifications;
+
+-- Deleting a notification deletes everything stored beside it. A trigger
+-- rather than ON DELETE CASCADE also reaches databases whose tables predate
+-- the foreign keys, and does not depend on PRAGMA foreign_keys.
+CREATE TRIGGER IF NOT EXISTS notifications_delete_extras
+AFTER DELETE ON notifications
+BEGIN
+    DELETE FROM escalations WHERE notification_id = OLD.id;
+    DELETE FROM message_originals WHERE notification_id = OLD.id;
+    DELETE FROM previous_occurrences WHERE notification_id = OLD.id;
+    DELETE FROM important_notifications WHERE notification_id = OLD.id;
+    DELETE FROM notification_colors WHERE notification_id = OLD.id;
+    DELETE FROM notification_priorities WHERE notification_id = OLD.id;
+    DELETE FROM notification_occurrences WHERE notification_id = OLD.id;
+    DELETE FROM notification_tags WHERE notification_id = OLD.id;
+    DELETE FROM notification_owners WHERE notification_id = OLD.id;
+    DELETE FROM pane_contexts WHERE notification_id = OLD.id;
+    DELETE FROM session_snoozes WHERE notification_id = OLD.id;
+    DELETE FROM notification_snoozes WHERE notification_id = OLD.id;
+END;
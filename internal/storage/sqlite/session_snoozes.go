@@ -0,0 +1,86 @@
+// File: session_snoozes.go
+// Purpose: Hides notifications until a tmux session becomes active. Snoozed
+// notifications are left out of listings and counts until the snooze is woken.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/ports"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// SnoozeUntilSessionActive hides the notification with id from the active
+// listing, the active count and the pane badges until session is active.
+// Snoozing again replaces the session.
+func (s *SQLiteStorage) SnoozeUntilSessionActive(id, session string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if session == "" {
+		return fmt.Errorf("sqlite storage: snooze until session active: session cannot be empty")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: snooze until session active: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: snooze until session active: %w", err)
+	}
+	if err := s.queries.UpsertSessionSnooze(context.Background(), sqlcgen.UpsertSessionSnoozeParams{
+		NotificationID: idInt,
+		Session:        session,
+		SnoozedAt:      time.Now().UTC().Format(time.RFC3339),
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: snooze until session active: %w", err)
+	}
+	s.syncTmuxStatusOption()
+	return nil
+}
+
+// ListSessionSnoozes returns every snoozed notification with the session it waits for.
+func (s *SQLiteStorage) ListSessionSnoozes() ([]ports.SessionSnooze, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListSessionSnoozes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("sqlite storage: list session snoozes: %w", err)
+	}
+	snoozes := make([]ports.SessionSnooze, 0, len(rows))
+	for _, row := range rows {
+		snoozes = append(snoozes, ports.SessionSnooze{
+			NotificationID: strconv.FormatInt(row.NotificationID, 10),
+			Session:        row.Session,
+			SnoozedAt:      row.SnoozedAt,
+		})
+	}
+	return snoozes, nil
+}
+
+// WakeSessionSnooze makes a snoozed notification visible again. Waking a
+// notification that is not snoozed is a no-op.
+func (s *SQLiteStorage) WakeSessionSnooze(id string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+	if err := s.queries.DeleteSessionSnooze(context.Background(), idInt); err != nil {
+		return fmt.Errorf("sqlite storage: wake session snooze: %w", err)
+	}
+	s.syncTmuxStatusOption()
+	return nil
+}
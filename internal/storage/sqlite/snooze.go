@@ -0,0 +1,66 @@
+// File: snooze.go
+// Purpose: Hides notifications from the active listing until a given time.
+// Snoozes that have passed are cleared by UnsnoozeExpired.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// SnoozeNotification hides the notification with id from the active listing,
+// the active count and the pane badges until until. Snoozing again replaces
+// the time.
+func (s *SQLiteStorage) SnoozeNotification(id string, until time.Time) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if until.IsZero() {
+		return fmt.Errorf("sqlite storage: snooze notification: until cannot be zero")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	if _, err := s.queries.GetNotificationLineByID(context.Background(), idInt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: snooze notification: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: snooze notification: %w", err)
+	}
+	if err := s.queries.UpsertNotificationSnooze(context.Background(), sqlcgen.UpsertNotificationSnoozeParams{
+		NotificationID: idInt,
+		SnoozedUntil:   until.UTC().Format("2006-01-02T15:04:05Z"),
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: snooze notification: %w", err)
+	}
+	s.syncTmuxStatusOption()
+	return nil
+}
+
+// UnsnoozeExpired clears every snooze whose time has passed and returns how
+// many were cleared. Listings already show expired snoozes, so it is safe to
+// run at any time; it only empties their snoozed_until field.
+func (s *SQLiteStorage) UnsnoozeExpired() (int, error) {
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0, err
+	}
+	result, err := s.queries.DeleteExpiredNotificationSnoozes(context.Background(), utcNow())
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: unsnooze expired: %w", err)
+	}
+	cleared, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("sqlite storage: unsnooze expired: %w", err)
+	}
+	if cleared > 0 {
+		s.syncTmuxStatusOption()
+	}
+	return int(cleared), nil
+}
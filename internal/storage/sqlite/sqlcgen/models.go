@@ -4,6 +4,31 @@
 
 package sqlcgen
 
+type Escalation struct {
+	NotificationID int64
+	EscalatedAt    string
+}
+
+type IdHighWater struct {
+	Name   string
+	LastID int64
+}
+
+type ImportantNotification struct {
+	NotificationID int64
+	MarkedAt       string
+}
+
+type MessageOriginal struct {
+	NotificationID int64
+	Message        string
+}
+
+type MutedSession struct {
+	Session string
+	MutedAt string
+}
+
 type Notification struct {
 	ID            int64
 	Timestamp     string
@@ -17,3 +42,78 @@ type Notification struct {
 	ReadTimestamp string
 	UpdatedAt     string
 }
+
+type NotificationColor struct {
+	NotificationID int64
+	Color          string
+}
+
+type NotificationLine struct {
+	ID            int64
+	Timestamp     string
+	State         string
+	Session       string
+	Window        string
+	Pane          string
+	Message       string
+	PaneCreated   string
+	Level         string
+	ReadTimestamp string
+	Important     int64
+	Color         string
+	Owner         string
+	SnoozedUntil  string
+	Priority      int64
+	Tags          string
+	Occurrences   int64
+}
+
+type NotificationOccurrence struct {
+	NotificationID int64
+	Occurrences    int64
+}
+
+type NotificationOwner struct {
+	NotificationID int64
+	Owner          string
+	AssignedAt     string
+}
+
+type NotificationPriority struct {
+	NotificationID int64
+	Priority       int64
+}
+
+type NotificationSnooze struct {
+	NotificationID int64
+	SnoozedUntil   string
+}
+
+type NotificationTag struct {
+	NotificationID int64
+	Tag            string
+}
+
+type PaneContext struct {
+	NotificationID int64
+	Content        string
+}
+
+type PendingAction struct {
+	ID             int64
+	Action         string
+	NotificationID int64
+	QueuedAt       string
+}
+
+type PreviousOccurrence struct {
+	NotificationID    int64
+	PreviousID        int64
+	PreviousTimestamp string
+}
+
+type SessionSnooze struct {
+	NotificationID int64
+	Session        string
+	SnoozedAt      string
+}
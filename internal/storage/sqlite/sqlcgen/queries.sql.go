@@ -10,14 +10,43 @@ import (
 	"database/sql"
 )
 
+const assignNotificationOwner = `-- name: AssignNotificationOwner :exec
+INSERT INTO notification_owners (notification_id, owner, assigned_at)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET owner = excluded.owner, assigned_at = excluded.assigned_at
+`
+
+type AssignNotificationOwnerParams struct {
+	NotificationID int64
+	Owner          string
+	AssignedAt     string
+}
+
+func (q *Queries) AssignNotificationOwner(ctx context.Context, arg AssignNotificationOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, assignNotificationOwner, arg.NotificationID, arg.Owner, arg.AssignedAt)
+	return err
+}
+
+const clearNotificationOwner = `-- name: ClearNotificationOwner :exec
+DELETE FROM notification_owners
+WHERE notification_id = ?
+`
+
+func (q *Queries) ClearNotificationOwner(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, clearNotificationOwner, notificationID)
+	return err
+}
+
 const countActiveNotifications = `-- name: CountActiveNotifications :one
 SELECT COUNT(1)
 FROM notifications
 WHERE state = 'active'
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > ?1)
 `
 
-func (q *Queries) CountActiveNotifications(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countActiveNotifications)
+func (q *Queries) CountActiveNotifications(ctx context.Context, now interface{}) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveNotifications, now)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -37,6 +66,179 @@ func (q *Queries) CountDismissedForCleanup(ctx context.Context, cutoff interface
 	return count, err
 }
 
+const countNotifications = `-- name: CountNotifications :one
+SELECT COUNT(1)
+FROM notifications
+WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
+  AND (?2 = '' OR level = ?2)
+  AND (?3 = '' OR session = ?3)
+  AND (?4 = '' OR window = ?4)
+  AND (?5 = '' OR pane = ?5)
+  AND (?6 = '' OR timestamp < ?6)
+  AND (?7 = '' OR timestamp > ?7)
+  AND (?8 = '' OR (?8 = 'read' AND read_timestamp != '') OR (?8 = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (?1 != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > ?9))
+  AND (?10 = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notifications.id AND instr(',' || ?10 || ',', ',' || tag || ',') > 0))
+`
+
+type CountNotificationsParams struct {
+	StateFilter     interface{}
+	LevelFilter     interface{}
+	SessionFilter   interface{}
+	WindowFilter    interface{}
+	PaneFilter      interface{}
+	OlderThanCutoff interface{}
+	NewerThanCutoff interface{}
+	ReadFilter      interface{}
+	Now             interface{}
+	TagFilter       interface{}
+}
+
+func (q *Queries) CountNotifications(ctx context.Context, arg CountNotificationsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countNotifications,
+		arg.StateFilter,
+		arg.LevelFilter,
+		arg.SessionFilter,
+		arg.WindowFilter,
+		arg.PaneFilter,
+		arg.OlderThanCutoff,
+		arg.NewerThanCutoff,
+		arg.ReadFilter,
+		arg.Now,
+		arg.TagFilter,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countNotificationsByLevel = `-- name: CountNotificationsByLevel :many
+SELECT level, COUNT(1) AS count
+FROM notifications
+WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (?1 != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > ?2))
+GROUP BY level
+ORDER BY level
+`
+
+type CountNotificationsByLevelParams struct {
+	StateFilter interface{}
+	Now         interface{}
+}
+
+type CountNotificationsByLevelRow struct {
+	Level string
+	Count int64
+}
+
+func (q *Queries) CountNotificationsByLevel(ctx context.Context, arg CountNotificationsByLevelParams) ([]CountNotificationsByLevelRow, error) {
+	rows, err := q.db.QueryContext(ctx, countNotificationsByLevel, arg.StateFilter, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountNotificationsByLevelRow
+	for rows.Next() {
+		var i CountNotificationsByLevelRow
+		if err := rows.Scan(&i.Level, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countNotificationsBySession = `-- name: CountNotificationsBySession :many
+SELECT session, COUNT(1) AS count
+FROM notifications
+WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (?1 != 'active' OR NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > ?2))
+GROUP BY session
+ORDER BY session
+`
+
+type CountNotificationsBySessionParams struct {
+	StateFilter interface{}
+	Now         interface{}
+}
+
+type CountNotificationsBySessionRow struct {
+	Session string
+	Count   int64
+}
+
+func (q *Queries) CountNotificationsBySession(ctx context.Context, arg CountNotificationsBySessionParams) ([]CountNotificationsBySessionRow, error) {
+	rows, err := q.db.QueryContext(ctx, countNotificationsBySession, arg.StateFilter, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountNotificationsBySessionRow
+	for rows.Next() {
+		var i CountNotificationsBySessionRow
+		if err := rows.Scan(&i.Session, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countUnreadByPane = `-- name: CountUnreadByPane :many
+SELECT pane, COUNT(1) AS count
+FROM notifications
+WHERE state = 'active'
+  AND read_timestamp = ''
+  AND pane != ''
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND NOT EXISTS (SELECT 1 FROM notification_snoozes WHERE notification_id = notifications.id AND snoozed_until > ?1)
+GROUP BY pane
+ORDER BY pane
+`
+
+type CountUnreadByPaneRow struct {
+	Pane  string
+	Count int64
+}
+
+func (q *Queries) CountUnreadByPane(ctx context.Context, now interface{}) ([]CountUnreadByPaneRow, error) {
+	rows, err := q.db.QueryContext(ctx, countUnreadByPane, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountUnreadByPaneRow
+	for rows.Next() {
+		var i CountUnreadByPaneRow
+		if err := rows.Scan(&i.Pane, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createNotification = `-- name: CreateNotification :exec
 INSERT INTO notifications (
     id,
@@ -51,19 +253,20 @@ INSERT INTO notifications (
     read_timestamp,
     updated_at
 )
-VALUES (?, ?, 'active', ?, ?, ?, ?, ?, ?, '', ?)
+VALUES (?, ?, 'active', ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateNotificationParams struct {
-	ID          int64
-	Timestamp   string
-	Session     string
-	Window      string
-	Pane        string
-	Message     string
-	PaneCreated string
-	Level       string
-	UpdatedAt   string
+	ID            int64
+	Timestamp     string
+	Session       string
+	Window        string
+	Pane          string
+	Message       string
+	PaneCreated   string
+	Level         string
+	ReadTimestamp string
+	UpdatedAt     string
 }
 
 func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
@@ -76,6 +279,7 @@ func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotification
 		arg.Message,
 		arg.PaneCreated,
 		arg.Level,
+		arg.ReadTimestamp,
 		arg.UpdatedAt,
 	)
 	return err
@@ -92,10 +296,58 @@ func (q *Queries) DeleteDismissedForCleanup(ctx context.Context, cutoff interfac
 	return err
 }
 
+const deleteExpiredNotificationSnoozes = `-- name: DeleteExpiredNotificationSnoozes :execresult
+DELETE FROM notification_snoozes
+WHERE snoozed_until <= ?1
+`
+
+func (q *Queries) DeleteExpiredNotificationSnoozes(ctx context.Context, now string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteExpiredNotificationSnoozes, now)
+}
+
+const deleteMessageOriginal = `-- name: DeleteMessageOriginal :exec
+DELETE FROM message_originals
+WHERE notification_id = ?
+`
+
+func (q *Queries) DeleteMessageOriginal(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteMessageOriginal, notificationID)
+	return err
+}
+
+const deleteNotificationSnooze = `-- name: DeleteNotificationSnooze :exec
+DELETE FROM notification_snoozes
+WHERE notification_id = ?
+`
+
+func (q *Queries) DeleteNotificationSnooze(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteNotificationSnooze, notificationID)
+	return err
+}
+
+const deletePendingAction = `-- name: DeletePendingAction :execresult
+DELETE FROM pending_actions
+WHERE id = ?
+`
+
+func (q *Queries) DeletePendingAction(ctx context.Context, id int64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deletePendingAction, id)
+}
+
+const deleteSessionSnooze = `-- name: DeleteSessionSnooze :exec
+DELETE FROM session_snoozes
+WHERE notification_id = ?
+`
+
+func (q *Queries) DeleteSessionSnooze(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSessionSnooze, notificationID)
+	return err
+}
+
 const dismissNotificationByID = `-- name: DismissNotificationByID :execresult
 UPDATE notifications
 SET state = 'dismissed', updated_at = ?1
-WHERE id = ?2
+WHERE id = ?2 AND state = 'active'
 `
 
 type DismissNotificationByIDParams struct {
@@ -132,6 +384,61 @@ func (q *Queries) DismissNotificationsByFilter(ctx context.Context, arg DismissN
 	)
 }
 
+const findActiveDuplicate = `-- name: FindActiveDuplicate :one
+SELECT id, timestamp
+FROM notifications
+WHERE state = 'active'
+  AND message = ?1
+  AND level = ?2
+  AND session = ?3
+  AND window = ?4
+  AND pane = ?5
+  AND timestamp >= ?6
+ORDER BY timestamp DESC, id DESC
+LIMIT 1
+`
+
+type FindActiveDuplicateParams struct {
+	Message string
+	Level   string
+	Session string
+	Window  string
+	Pane    string
+	Since   string
+}
+
+type FindActiveDuplicateRow struct {
+	ID        int64
+	Timestamp string
+}
+
+func (q *Queries) FindActiveDuplicate(ctx context.Context, arg FindActiveDuplicateParams) (FindActiveDuplicateRow, error) {
+	row := q.db.QueryRowContext(ctx, findActiveDuplicate,
+		arg.Message,
+		arg.Level,
+		arg.Session,
+		arg.Window,
+		arg.Pane,
+		arg.Since,
+	)
+	var i FindActiveDuplicateRow
+	err := row.Scan(&i.ID, &i.Timestamp)
+	return i, err
+}
+
+const getMessageOriginal = `-- name: GetMessageOriginal :one
+SELECT message
+FROM message_originals
+WHERE notification_id = ?
+`
+
+func (q *Queries) GetMessageOriginal(ctx context.Context, notificationID int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getMessageOriginal, notificationID)
+	var message string
+	err := row.Scan(&message)
+	return message, err
+}
+
 const getNotificationForHooksByID = `-- name: GetNotificationForHooksByID :one
 SELECT id, timestamp, state, session, window, pane, message, pane_created, level
 FROM notifications
@@ -168,8 +475,9 @@ func (q *Queries) GetNotificationForHooksByID(ctx context.Context, id int64) (Ge
 }
 
 const getNotificationLineByID = `-- name: GetNotificationLineByID :one
-SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp
-FROM notifications
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
 WHERE id = ?
 `
 
@@ -184,6 +492,13 @@ type GetNotificationLineByIDRow struct {
 	PaneCreated   string
 	Level         string
 	ReadTimestamp string
+	Important     int64
+	Color         string
+	Owner         string
+	SnoozedUntil  string
+	Priority      int64
+	Tags          string
+	Occurrences   int64
 }
 
 func (q *Queries) GetNotificationLineByID(ctx context.Context, id int64) (GetNotificationLineByIDRow, error) {
@@ -200,88 +515,683 @@ func (q *Queries) GetNotificationLineByID(ctx context.Context, id int64) (GetNot
 		&i.PaneCreated,
 		&i.Level,
 		&i.ReadTimestamp,
+		&i.Important,
+		&i.Color,
+		&i.Owner,
+		&i.SnoozedUntil,
+		&i.Priority,
+		&i.Tags,
+		&i.Occurrences,
 	)
 	return i, err
 }
 
-const listActiveNotificationsForHooks = `-- name: ListActiveNotificationsForHooks :many
-SELECT id, timestamp, state, session, window, pane, message, pane_created, level
-FROM notifications
-WHERE state = 'active'
-ORDER BY id ASC
+const getPaneContext = `-- name: GetPaneContext :one
+SELECT content
+FROM pane_contexts
+WHERE notification_id = ?
 `
 
-type ListActiveNotificationsForHooksRow struct {
-	ID          int64
-	Timestamp   string
-	State       string
-	Session     string
-	Window      string
-	Pane        string
-	Message     string
-	PaneCreated string
-	Level       string
+func (q *Queries) GetPaneContext(ctx context.Context, notificationID int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getPaneContext, notificationID)
+	var content string
+	err := row.Scan(&content)
+	return content, err
 }
 
-func (q *Queries) ListActiveNotificationsForHooks(ctx context.Context) ([]ListActiveNotificationsForHooksRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActiveNotificationsForHooks)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []ListActiveNotificationsForHooksRow
-	for rows.Next() {
-		var i ListActiveNotificationsForHooksRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Timestamp,
-			&i.State,
-			&i.Session,
-			&i.Window,
-			&i.Pane,
-			&i.Message,
-			&i.PaneCreated,
-			&i.Level,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+const getPreviousOccurrence = `-- name: GetPreviousOccurrence :one
+SELECT previous_id, previous_timestamp
+FROM previous_occurrences
+WHERE notification_id = ?
+`
+
+type GetPreviousOccurrenceRow struct {
+	PreviousID        int64
+	PreviousTimestamp string
 }
 
-const listNotifications = `-- name: ListNotifications :many
-SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp
-FROM notifications
-WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
-  AND (?2 = '' OR level = ?2)
-  AND (?3 = '' OR session = ?3)
-  AND (?4 = '' OR window = ?4)
-  AND (?5 = '' OR pane = ?5)
-  AND (?6 = '' OR timestamp < ?6)
-  AND (?7 = '' OR timestamp > ?7)
-  AND (?8 = '' OR (?8 = 'read' AND read_timestamp != '') OR (?8 = 'unread' AND read_timestamp = ''))
-ORDER BY id ASC
-`
+func (q *Queries) GetPreviousOccurrence(ctx context.Context, notificationID int64) (GetPreviousOccurrenceRow, error) {
+	row := q.db.QueryRowContext(ctx, getPreviousOccurrence, notificationID)
+	var i GetPreviousOccurrenceRow
+	err := row.Scan(&i.PreviousID, &i.PreviousTimestamp)
+	return i, err
+}
 
-type ListNotificationsParams struct {
-	StateFilter     interface{}
-	LevelFilter     interface{}
+const importNotification = `-- name: ImportNotification :exec
+INSERT INTO notifications (
+    id,
+    timestamp,
+    state,
+    session,
+    window,
+    pane,
+    message,
+    pane_created,
+    level,
+    read_timestamp,
+    updated_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type ImportNotificationParams struct {
+	ID            int64
+	Timestamp     string
+	State         string
+	Session       string
+	Window        string
+	Pane          string
+	Message       string
+	PaneCreated   string
+	Level         string
+	ReadTimestamp string
+	UpdatedAt     string
+}
+
+func (q *Queries) ImportNotification(ctx context.Context, arg ImportNotificationParams) error {
+	_, err := q.db.ExecContext(ctx, importNotification,
+		arg.ID,
+		arg.Timestamp,
+		arg.State,
+		arg.Session,
+		arg.Window,
+		arg.Pane,
+		arg.Message,
+		arg.PaneCreated,
+		arg.Level,
+		arg.ReadTimestamp,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const incrementNotificationOccurrences = `-- name: IncrementNotificationOccurrences :exec
+INSERT INTO notification_occurrences (notification_id, occurrences)
+VALUES (?, 2)
+ON CONFLICT(notification_id) DO UPDATE SET occurrences = occurrences + 1
+`
+
+func (q *Queries) IncrementNotificationOccurrences(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, incrementNotificationOccurrences, notificationID)
+	return err
+}
+
+const insertEscalation = `-- name: InsertEscalation :exec
+INSERT INTO escalations (notification_id, escalated_at)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO NOTHING
+`
+
+type InsertEscalationParams struct {
+	NotificationID int64
+	EscalatedAt    string
+}
+
+func (q *Queries) InsertEscalation(ctx context.Context, arg InsertEscalationParams) error {
+	_, err := q.db.ExecContext(ctx, insertEscalation, arg.NotificationID, arg.EscalatedAt)
+	return err
+}
+
+const insertMessageOriginal = `-- name: InsertMessageOriginal :exec
+INSERT INTO message_originals (notification_id, message)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET message = excluded.message
+`
+
+type InsertMessageOriginalParams struct {
+	NotificationID int64
+	Message        string
+}
+
+func (q *Queries) InsertMessageOriginal(ctx context.Context, arg InsertMessageOriginalParams) error {
+	_, err := q.db.ExecContext(ctx, insertMessageOriginal, arg.NotificationID, arg.Message)
+	return err
+}
+
+const insertNotificationColor = `-- name: InsertNotificationColor :exec
+INSERT INTO notification_colors (notification_id, color)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET color = excluded.color
+`
+
+type InsertNotificationColorParams struct {
+	NotificationID int64
+	Color          string
+}
+
+func (q *Queries) InsertNotificationColor(ctx context.Context, arg InsertNotificationColorParams) error {
+	_, err := q.db.ExecContext(ctx, insertNotificationColor, arg.NotificationID, arg.Color)
+	return err
+}
+
+const insertNotificationOccurrences = `-- name: InsertNotificationOccurrences :exec
+INSERT INTO notification_occurrences (notification_id, occurrences)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET occurrences = excluded.occurrences
+`
+
+type InsertNotificationOccurrencesParams struct {
+	NotificationID int64
+	Occurrences    int64
+}
+
+func (q *Queries) InsertNotificationOccurrences(ctx context.Context, arg InsertNotificationOccurrencesParams) error {
+	_, err := q.db.ExecContext(ctx, insertNotificationOccurrences, arg.NotificationID, arg.Occurrences)
+	return err
+}
+
+const insertNotificationPriority = `-- name: InsertNotificationPriority :exec
+INSERT INTO notification_priorities (notification_id, priority)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET priority = excluded.priority
+`
+
+type InsertNotificationPriorityParams struct {
+	NotificationID int64
+	Priority       int64
+}
+
+func (q *Queries) InsertNotificationPriority(ctx context.Context, arg InsertNotificationPriorityParams) error {
+	_, err := q.db.ExecContext(ctx, insertNotificationPriority, arg.NotificationID, arg.Priority)
+	return err
+}
+
+const insertNotificationTag = `-- name: InsertNotificationTag :exec
+INSERT OR IGNORE INTO notification_tags (notification_id, tag)
+VALUES (?, ?)
+`
+
+type InsertNotificationTagParams struct {
+	NotificationID int64
+	Tag            string
+}
+
+func (q *Queries) InsertNotificationTag(ctx context.Context, arg InsertNotificationTagParams) error {
+	_, err := q.db.ExecContext(ctx, insertNotificationTag, arg.NotificationID, arg.Tag)
+	return err
+}
+
+const insertPaneContext = `-- name: InsertPaneContext :exec
+INSERT INTO pane_contexts (notification_id, content)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET content = excluded.content
+`
+
+type InsertPaneContextParams struct {
+	NotificationID int64
+	Content        string
+}
+
+func (q *Queries) InsertPaneContext(ctx context.Context, arg InsertPaneContextParams) error {
+	_, err := q.db.ExecContext(ctx, insertPaneContext, arg.NotificationID, arg.Content)
+	return err
+}
+
+const insertPendingAction = `-- name: InsertPendingAction :exec
+INSERT INTO pending_actions (action, notification_id, queued_at)
+VALUES (?, ?, ?)
+`
+
+type InsertPendingActionParams struct {
+	Action         string
+	NotificationID int64
+	QueuedAt       string
+}
+
+func (q *Queries) InsertPendingAction(ctx context.Context, arg InsertPendingActionParams) error {
+	_, err := q.db.ExecContext(ctx, insertPendingAction, arg.Action, arg.NotificationID, arg.QueuedAt)
+	return err
+}
+
+const insertPreviousOccurrence = `-- name: InsertPreviousOccurrence :exec
+INSERT INTO previous_occurrences (notification_id, previous_id, previous_timestamp)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    previous_id = excluded.previous_id,
+    previous_timestamp = excluded.previous_timestamp
+`
+
+type InsertPreviousOccurrenceParams struct {
+	NotificationID    int64
+	PreviousID        int64
+	PreviousTimestamp string
+}
+
+func (q *Queries) InsertPreviousOccurrence(ctx context.Context, arg InsertPreviousOccurrenceParams) error {
+	_, err := q.db.ExecContext(ctx, insertPreviousOccurrence, arg.NotificationID, arg.PreviousID, arg.PreviousTimestamp)
+	return err
+}
+
+const isSessionMuted = `-- name: IsSessionMuted :one
+SELECT COUNT(1)
+FROM muted_sessions
+WHERE session = ?
+`
+
+func (q *Queries) IsSessionMuted(ctx context.Context, session string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, isSessionMuted, session)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listActiveNotificationsForHooks = `-- name: ListActiveNotificationsForHooks :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level
+FROM notifications
+WHERE state = 'active'
+ORDER BY id ASC
+`
+
+type ListActiveNotificationsForHooksRow struct {
+	ID          int64
+	Timestamp   string
+	State       string
+	Session     string
+	Window      string
+	Pane        string
+	Message     string
+	PaneCreated string
+	Level       string
+}
+
+func (q *Queries) ListActiveNotificationsForHooks(ctx context.Context) ([]ListActiveNotificationsForHooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveNotificationsForHooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveNotificationsForHooksRow
+	for rows.Next() {
+		var i ListActiveNotificationsForHooksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.State,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Message,
+			&i.PaneCreated,
+			&i.Level,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDismissedSince = `-- name: ListDismissedSince :many
+SELECT id, session, window, pane, level
+FROM notifications
+WHERE message = ?
+  AND state = 'dismissed'
+  AND updated_at >= ?
+ORDER BY id DESC
+`
+
+type ListDismissedSinceParams struct {
+	Message   string
+	UpdatedAt string
+}
+
+type ListDismissedSinceRow struct {
+	ID      int64
+	Session string
+	Window  string
+	Pane    string
+	Level   string
+}
+
+func (q *Queries) ListDismissedSince(ctx context.Context, arg ListDismissedSinceParams) ([]ListDismissedSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDismissedSince, arg.Message, arg.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDismissedSinceRow
+	for rows.Next() {
+		var i ListDismissedSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Level,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEscalationCandidates = `-- name: ListEscalationCandidates :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level
+FROM notifications
+WHERE state = 'active'
+  AND level = 'critical'
+  AND read_timestamp = ''
+  AND timestamp < ?1
+  AND id NOT IN (SELECT notification_id FROM escalations)
+ORDER BY id ASC
+`
+
+type ListEscalationCandidatesRow struct {
+	ID          int64
+	Timestamp   string
+	State       string
+	Session     string
+	Window      string
+	Pane        string
+	Message     string
+	PaneCreated string
+	Level       string
+}
+
+func (q *Queries) ListEscalationCandidates(ctx context.Context, cutoff string) ([]ListEscalationCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEscalationCandidates, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEscalationCandidatesRow
+	for rows.Next() {
+		var i ListEscalationCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.State,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Message,
+			&i.PaneCreated,
+			&i.Level,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMutedSessions = `-- name: ListMutedSessions :many
+SELECT session
+FROM muted_sessions
+ORDER BY session
+`
+
+func (q *Queries) ListMutedSessions(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listMutedSessions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var session string
+		if err := rows.Scan(&session); err != nil {
+			return nil, err
+		}
+		items = append(items, session)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
+  AND (?2 = '' OR level = ?2)
+  AND (?3 = '' OR session = ?3)
+  AND (?4 = '' OR window = ?4)
+  AND (?5 = '' OR pane = ?5)
+  AND (?6 = '' OR timestamp < ?6)
+  AND (?7 = '' OR timestamp > ?7)
+  AND (?8 = '' OR (?8 = 'read' AND read_timestamp != '') OR (?8 = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (?1 != 'active' OR snoozed_until <= ?9)
+  AND (?10 = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notification_lines.id AND instr(',' || ?10 || ',', ',' || tag || ',') > 0))
+ORDER BY id ASC
+`
+
+type ListNotificationsParams struct {
+	StateFilter     interface{}
+	LevelFilter     interface{}
+	SessionFilter   interface{}
+	WindowFilter    interface{}
+	PaneFilter      interface{}
+	OlderThanCutoff interface{}
+	NewerThanCutoff interface{}
+	ReadFilter      interface{}
+	Now             interface{}
+	TagFilter       interface{}
+}
+
+type ListNotificationsRow struct {
+	ID            int64
+	Timestamp     string
+	State         string
+	Session       string
+	Window        string
+	Pane          string
+	Message       string
+	PaneCreated   string
+	Level         string
+	ReadTimestamp string
+	Important     int64
+	Color         string
+	Owner         string
+	SnoozedUntil  string
+	Priority      int64
+	Tags          string
+	Occurrences   int64
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNotifications,
+		arg.StateFilter,
+		arg.LevelFilter,
+		arg.SessionFilter,
+		arg.WindowFilter,
+		arg.PaneFilter,
+		arg.OlderThanCutoff,
+		arg.NewerThanCutoff,
+		arg.ReadFilter,
+		arg.Now,
+		arg.TagFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationsRow
+	for rows.Next() {
+		var i ListNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.State,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Message,
+			&i.PaneCreated,
+			&i.Level,
+			&i.ReadTimestamp,
+			&i.Important,
+			&i.Color,
+			&i.Owner,
+			&i.SnoozedUntil,
+			&i.Priority,
+			&i.Tags,
+			&i.Occurrences,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationsPage = `-- name: ListNotificationsPage :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE (?1 = '' OR ?1 = 'all' OR state = ?1)
+  AND (?2 = '' OR level = ?2)
+  AND (?3 = '' OR session = ?3)
+  AND (?4 = '' OR window = ?4)
+  AND (?5 = '' OR pane = ?5)
+  AND (?6 = '' OR timestamp < ?6)
+  AND (?7 = '' OR timestamp > ?7)
+  AND (?8 = '' OR (?8 = 'read' AND read_timestamp != '') OR (?8 = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (?1 != 'active' OR snoozed_until <= ?9)
+  AND (?10 = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notification_lines.id AND instr(',' || ?10 || ',', ',' || tag || ',') > 0))
+ORDER BY id ASC
+LIMIT ?11 OFFSET ?12
+`
+
+type ListNotificationsPageParams struct {
+	StateFilter     interface{}
+	LevelFilter     interface{}
 	SessionFilter   interface{}
 	WindowFilter    interface{}
 	PaneFilter      interface{}
 	OlderThanCutoff interface{}
 	NewerThanCutoff interface{}
 	ReadFilter      interface{}
+	Now             interface{}
+	TagFilter       interface{}
+	PageLimit       int64
+	PageOffset      int64
+}
+
+type ListNotificationsPageRow struct {
+	ID            int64
+	Timestamp     string
+	State         string
+	Session       string
+	Window        string
+	Pane          string
+	Message       string
+	PaneCreated   string
+	Level         string
+	ReadTimestamp string
+	Important     int64
+	Color         string
+	Owner         string
+	SnoozedUntil  string
+	Priority      int64
+	Tags          string
+	Occurrences   int64
+}
+
+func (q *Queries) ListNotificationsPage(ctx context.Context, arg ListNotificationsPageParams) ([]ListNotificationsPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNotificationsPage,
+		arg.StateFilter,
+		arg.LevelFilter,
+		arg.SessionFilter,
+		arg.WindowFilter,
+		arg.PaneFilter,
+		arg.OlderThanCutoff,
+		arg.NewerThanCutoff,
+		arg.ReadFilter,
+		arg.Now,
+		arg.TagFilter,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationsPageRow
+	for rows.Next() {
+		var i ListNotificationsPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.State,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Message,
+			&i.PaneCreated,
+			&i.Level,
+			&i.ReadTimestamp,
+			&i.Important,
+			&i.Color,
+			&i.Owner,
+			&i.SnoozedUntil,
+			&i.Priority,
+			&i.Tags,
+			&i.Occurrences,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type ListNotificationsRow struct {
+const listNotificationsSinceID = `-- name: ListNotificationsSinceID :many
+SELECT id, timestamp, state, session, window, pane, message, pane_created, level, read_timestamp,
+    important, color, owner, snoozed_until, priority, tags, occurrences
+FROM notification_lines
+WHERE id > ?1
+  AND (?2 = '' OR ?2 = 'all' OR state = ?2)
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notification_lines.id)
+  AND (?2 != 'active' OR snoozed_until <= ?3)
+ORDER BY id ASC
+`
+
+type ListNotificationsSinceIDParams struct {
+	AfterID     int64
+	StateFilter interface{}
+	Now         interface{}
+}
+
+type ListNotificationsSinceIDRow struct {
 	ID            int64
 	Timestamp     string
 	State         string
@@ -292,26 +1202,24 @@ type ListNotificationsRow struct {
 	PaneCreated   string
 	Level         string
 	ReadTimestamp string
+	Important     int64
+	Color         string
+	Owner         string
+	SnoozedUntil  string
+	Priority      int64
+	Tags          string
+	Occurrences   int64
 }
 
-func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listNotifications,
-		arg.StateFilter,
-		arg.LevelFilter,
-		arg.SessionFilter,
-		arg.WindowFilter,
-		arg.PaneFilter,
-		arg.OlderThanCutoff,
-		arg.NewerThanCutoff,
-		arg.ReadFilter,
-	)
+func (q *Queries) ListNotificationsSinceID(ctx context.Context, arg ListNotificationsSinceIDParams) ([]ListNotificationsSinceIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNotificationsSinceID, arg.AfterID, arg.StateFilter, arg.Now)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListNotificationsRow
+	var items []ListNotificationsSinceIDRow
 	for rows.Next() {
-		var i ListNotificationsRow
+		var i ListNotificationsSinceIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Timestamp,
@@ -323,6 +1231,13 @@ func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsPa
 			&i.PaneCreated,
 			&i.Level,
 			&i.ReadTimestamp,
+			&i.Important,
+			&i.Color,
+			&i.Owner,
+			&i.SnoozedUntil,
+			&i.Priority,
+			&i.Tags,
+			&i.Occurrences,
 		); err != nil {
 			return nil, err
 		}
@@ -337,9 +1252,209 @@ func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsPa
 	return items, nil
 }
 
-const nextNotificationID = `-- name: NextNotificationID :one
-SELECT COALESCE(MAX(id), 0) + 1 AS next_id
+const listPendingActions = `-- name: ListPendingActions :many
+SELECT id, action, notification_id, queued_at
+FROM pending_actions
+ORDER BY id
+`
+
+func (q *Queries) ListPendingActions(ctx context.Context) ([]PendingAction, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingActions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PendingAction
+	for rows.Next() {
+		var i PendingAction
+		if err := rows.Scan(
+			&i.ID,
+			&i.Action,
+			&i.NotificationID,
+			&i.QueuedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPriorOccurrences = `-- name: ListPriorOccurrences :many
+SELECT id, timestamp, session, window, pane, level, state
 FROM notifications
+WHERE message = ?
+  AND id < ?
+ORDER BY id DESC
+`
+
+type ListPriorOccurrencesParams struct {
+	Message string
+	ID      int64
+}
+
+type ListPriorOccurrencesRow struct {
+	ID        int64
+	Timestamp string
+	Session   string
+	Window    string
+	Pane      string
+	Level     string
+	State     string
+}
+
+func (q *Queries) ListPriorOccurrences(ctx context.Context, arg ListPriorOccurrencesParams) ([]ListPriorOccurrencesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPriorOccurrences, arg.Message, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPriorOccurrencesRow
+	for rows.Next() {
+		var i ListPriorOccurrencesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.Session,
+			&i.Window,
+			&i.Pane,
+			&i.Level,
+			&i.State,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionSnoozes = `-- name: ListSessionSnoozes :many
+SELECT notification_id, session, snoozed_at
+FROM session_snoozes
+ORDER BY notification_id
+`
+
+func (q *Queries) ListSessionSnoozes(ctx context.Context) ([]SessionSnooze, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionSnoozes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionSnooze
+	for rows.Next() {
+		var i SessionSnooze
+		if err := rows.Scan(
+			&i.NotificationID,
+			&i.Session,
+			&i.SnoozedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllActiveRead = `-- name: MarkAllActiveRead :many
+UPDATE notifications
+SET read_timestamp = ?1, updated_at = ?2
+WHERE state = 'active' AND read_timestamp = ''
+RETURNING id
+`
+
+type MarkAllActiveReadParams struct {
+	ReadTimestamp string
+	UpdatedAt     string
+}
+
+func (q *Queries) MarkAllActiveRead(ctx context.Context, arg MarkAllActiveReadParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, markAllActiveRead, arg.ReadTimestamp, arg.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllActiveUnread = `-- name: MarkAllActiveUnread :execresult
+UPDATE notifications
+SET read_timestamp = '', updated_at = ?1
+WHERE state = 'active' AND read_timestamp != ''
+`
+
+func (q *Queries) MarkAllActiveUnread(ctx context.Context, updatedAt string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, markAllActiveUnread, updatedAt)
+}
+
+const markImportant = `-- name: MarkImportant :exec
+INSERT INTO important_notifications (notification_id, marked_at)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO NOTHING
+`
+
+type MarkImportantParams struct {
+	NotificationID int64
+	MarkedAt       string
+}
+
+func (q *Queries) MarkImportant(ctx context.Context, arg MarkImportantParams) error {
+	_, err := q.db.ExecContext(ctx, markImportant, arg.NotificationID, arg.MarkedAt)
+	return err
+}
+
+const muteSession = `-- name: MuteSession :exec
+INSERT INTO muted_sessions (session, muted_at)
+VALUES (?, ?)
+ON CONFLICT(session) DO NOTHING
+`
+
+type MuteSessionParams struct {
+	Session string
+	MutedAt string
+}
+
+func (q *Queries) MuteSession(ctx context.Context, arg MuteSessionParams) error {
+	_, err := q.db.ExecContext(ctx, muteSession, arg.Session, arg.MutedAt)
+	return err
+}
+
+const nextNotificationID = `-- name: NextNotificationID :one
+SELECT MAX(
+    COALESCE((SELECT MAX(id) FROM notifications), 0),
+    COALESCE((SELECT last_id FROM id_high_water WHERE name = 'notifications'), 0)
+) + 1 AS next_id
 `
 
 func (q *Queries) NextNotificationID(ctx context.Context) (int64, error) {
@@ -349,6 +1464,131 @@ func (q *Queries) NextNotificationID(ctx context.Context) (int64, error) {
 	return next_id, err
 }
 
+const recordNotificationIDHighWater = `-- name: RecordNotificationIDHighWater :exec
+INSERT INTO id_high_water (name, last_id)
+SELECT 'notifications', COALESCE(MAX(id), 0) FROM notifications WHERE true
+ON CONFLICT(name) DO UPDATE SET last_id = MAX(last_id, excluded.last_id)
+`
+
+func (q *Queries) RecordNotificationIDHighWater(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, recordNotificationIDHighWater)
+	return err
+}
+
+const relevelNotificationsByFilter = `-- name: RelevelNotificationsByFilter :execresult
+UPDATE notifications
+SET level = ?1, updated_at = ?2
+WHERE (?3 = '' OR ?3 = 'all' OR state = ?3)
+  AND (?4 = '' OR level = ?4)
+  AND (?5 = '' OR session = ?5)
+  AND (?6 = '' OR window = ?6)
+  AND (?7 = '' OR pane = ?7)
+  AND (?8 = '' OR timestamp < ?8)
+  AND (?9 = '' OR timestamp > ?9)
+  AND (?10 = '' OR (?10 = 'read' AND read_timestamp != '') OR (?10 = 'unread' AND read_timestamp = ''))
+  AND NOT EXISTS (SELECT 1 FROM session_snoozes WHERE notification_id = notifications.id)
+  AND (?11 = '' OR EXISTS (SELECT 1 FROM notification_tags WHERE notification_id = notifications.id AND instr(',' || ?11 || ',', ',' || tag || ',') > 0))
+  AND level != ?1
+`
+
+type RelevelNotificationsByFilterParams struct {
+	NewLevel        string
+	UpdatedAt       string
+	StateFilter     interface{}
+	LevelFilter     interface{}
+	SessionFilter   interface{}
+	WindowFilter    interface{}
+	PaneFilter      interface{}
+	OlderThanCutoff interface{}
+	NewerThanCutoff interface{}
+	ReadFilter      interface{}
+	TagFilter       interface{}
+}
+
+func (q *Queries) RelevelNotificationsByFilter(ctx context.Context, arg RelevelNotificationsByFilterParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, relevelNotificationsByFilter,
+		arg.NewLevel,
+		arg.UpdatedAt,
+		arg.StateFilter,
+		arg.LevelFilter,
+		arg.SessionFilter,
+		arg.WindowFilter,
+		arg.PaneFilter,
+		arg.OlderThanCutoff,
+		arg.NewerThanCutoff,
+		arg.ReadFilter,
+		arg.TagFilter,
+	)
+}
+
+const undismissNotificationByID = `-- name: UndismissNotificationByID :execresult
+UPDATE notifications
+SET state = 'active', updated_at = ?1
+WHERE id = ?2
+`
+
+type UndismissNotificationByIDParams struct {
+	UpdatedAt string
+	ID        int64
+}
+
+func (q *Queries) UndismissNotificationByID(ctx context.Context, arg UndismissNotificationByIDParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, undismissNotificationByID, arg.UpdatedAt, arg.ID)
+}
+
+const unmarkImportant = `-- name: UnmarkImportant :exec
+DELETE FROM important_notifications
+WHERE notification_id = ?
+`
+
+func (q *Queries) UnmarkImportant(ctx context.Context, notificationID int64) error {
+	_, err := q.db.ExecContext(ctx, unmarkImportant, notificationID)
+	return err
+}
+
+const unmuteSession = `-- name: UnmuteSession :execresult
+DELETE FROM muted_sessions
+WHERE session = ?
+`
+
+func (q *Queries) UnmuteSession(ctx context.Context, session string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, unmuteSession, session)
+}
+
+const updateNotificationMessage = `-- name: UpdateNotificationMessage :exec
+UPDATE notifications
+SET message = ?1, updated_at = ?2
+WHERE id = ?3
+`
+
+type UpdateNotificationMessageParams struct {
+	Message   string
+	UpdatedAt string
+	ID        int64
+}
+
+func (q *Queries) UpdateNotificationMessage(ctx context.Context, arg UpdateNotificationMessageParams) error {
+	_, err := q.db.ExecContext(ctx, updateNotificationMessage, arg.Message, arg.UpdatedAt, arg.ID)
+	return err
+}
+
+const updateNotificationTimestamp = `-- name: UpdateNotificationTimestamp :exec
+UPDATE notifications
+SET timestamp = max(timestamp, ?1), updated_at = ?2
+WHERE id = ?3
+`
+
+type UpdateNotificationTimestampParams struct {
+	Timestamp string
+	UpdatedAt string
+	ID        int64
+}
+
+func (q *Queries) UpdateNotificationTimestamp(ctx context.Context, arg UpdateNotificationTimestampParams) error {
+	_, err := q.db.ExecContext(ctx, updateNotificationTimestamp, arg.Timestamp, arg.UpdatedAt, arg.ID)
+	return err
+}
+
 const updateReadTimestampByID = `-- name: UpdateReadTimestampByID :execresult
 UPDATE notifications
 SET read_timestamp = ?1, updated_at = ?2
@@ -423,3 +1663,39 @@ func (q *Queries) UpsertNotification(ctx context.Context, arg UpsertNotification
 	)
 	return err
 }
+
+const upsertNotificationSnooze = `-- name: UpsertNotificationSnooze :exec
+INSERT INTO notification_snoozes (notification_id, snoozed_until)
+VALUES (?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    snoozed_until = excluded.snoozed_until
+`
+
+type UpsertNotificationSnoozeParams struct {
+	NotificationID int64
+	SnoozedUntil   string
+}
+
+func (q *Queries) UpsertNotificationSnooze(ctx context.Context, arg UpsertNotificationSnoozeParams) error {
+	_, err := q.db.ExecContext(ctx, upsertNotificationSnooze, arg.NotificationID, arg.SnoozedUntil)
+	return err
+}
+
+const upsertSessionSnooze = `-- name: UpsertSessionSnooze :exec
+INSERT INTO session_snoozes (notification_id, session, snoozed_at)
+VALUES (?, ?, ?)
+ON CONFLICT(notification_id) DO UPDATE SET
+    session = excluded.session,
+    snoozed_at = excluded.snoozed_at
+`
+
+type UpsertSessionSnoozeParams struct {
+	NotificationID int64
+	Session        string
+	SnoozedAt      string
+}
+
+func (q *Queries) UpsertSessionSnooze(ctx context.Context, arg UpsertSessionSnoozeParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSessionSnooze, arg.NotificationID, arg.Session, arg.SnoozedAt)
+	return err
+}
@@ -0,0 +1,122 @@
+// File: status_grace.go
+// Purpose: Delays writing a zero active count to tmux by status_zero_grace, so
+// a dismiss followed quickly by an add never shows zero in the status bar. A
+// process that exits during the delay leaves the write to the tmux server.
+package sqlite
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+)
+
+// zeroPendingOption holds the token of a zero handed to the tmux server. A
+// non-zero count removes it, which cancels that zero.
+const zeroPendingOption = "@tmux_intray_zero_pending"
+
+// statusTimer is the part of *time.Timer the grace period needs.
+type statusTimer interface {
+	Stop() bool
+}
+
+// afterFunc schedules the deferred zero write; tests replace it to control time.
+var afterFunc = func(d time.Duration, f func()) statusTimer {
+	return time.AfterFunc(d, f)
+}
+
+// pendingZeroStatus is a zero count waiting for its grace period to end.
+type pendingZeroStatus struct {
+	timer    statusTimer
+	store    *SQLiteStorage
+	deadline time.Time
+}
+
+var (
+	pendingZeroMu sync.Mutex
+	pendingZero   *pendingZeroStatus
+)
+
+// deferZeroStatus schedules a zero count write after grace. A zero already
+// waiting keeps its deadline, so repeated dismissals do not push it back.
+func (s *SQLiteStorage) deferZeroStatus(grace time.Duration) {
+	pendingZeroMu.Lock()
+	defer pendingZeroMu.Unlock()
+	if pendingZero != nil {
+		return
+	}
+	pending := &pendingZeroStatus{store: s, deadline: nowFunc().Add(grace)}
+	pending.timer = afterFunc(grace, func() { firePendingZero(pending) })
+	pendingZero = pending
+}
+
+// cancelZeroStatus drops a waiting zero because a non-zero count replaces it.
+func cancelZeroStatus() {
+	pendingZeroMu.Lock()
+	defer pendingZeroMu.Unlock()
+	if pendingZero != nil {
+		pendingZero.timer.Stop()
+		pendingZero = nil
+	}
+}
+
+// firePendingZero writes pending when it is still the zero waiting to be written.
+func firePendingZero(pending *pendingZeroStatus) {
+	pendingZeroMu.Lock()
+	if pendingZero != pending {
+		pendingZeroMu.Unlock()
+		return
+	}
+	pendingZero = nil
+	pendingZeroMu.Unlock()
+	pending.store.writeZeroStatusIfIdle()
+}
+
+// FlushStatus hands a zero count still waiting out its grace period to the
+// tmux server, which writes it when the period ends unless a non-zero count
+// replaces it first. Call it before the process exits, or the status keeps the
+// last non-zero count.
+func FlushStatus() {
+	pendingZeroMu.Lock()
+	pending := pendingZero
+	pendingZero = nil
+	pendingZeroMu.Unlock()
+	if pending == nil {
+		return
+	}
+	pending.timer.Stop()
+	pending.store.handOffZeroStatus(pending.deadline.Sub(nowFunc()))
+}
+
+// handOffZeroStatus has tmux write zero after remaining. Zero is written at
+// once when no time remains or tmux cannot schedule the write.
+func (s *SQLiteStorage) handOffZeroStatus(remaining time.Duration) {
+	if remaining <= 0 {
+		s.writeZeroStatusIfIdle()
+		return
+	}
+	if s.GetActiveCount() != 0 {
+		return
+	}
+	token := strconv.FormatInt(nowFunc().UnixNano(), 10)
+	err := tmuxClient.SetStatusOption(zeroPendingOption, token)
+	if err == nil {
+		err = tmuxClient.SetStatusOptionAfter(remaining, "@tmux_intray_active_count", "0", zeroPendingOption, token)
+	}
+	if err != nil {
+		colors.Debug(fmt.Sprintf("status grace: writing zero now: %v", err))
+		s.writeZeroStatusIfIdle()
+	}
+}
+
+// writeZeroStatusIfIdle writes zero unless a notification arrived meanwhile.
+func (s *SQLiteStorage) writeZeroStatusIfIdle() {
+	if s.GetActiveCount() != 0 {
+		return
+	}
+	if err := s.setStatusOptionWithRetry("@tmux_intray_active_count", "0"); err != nil {
+		colors.Warning(fmt.Sprintf("failed to set @tmux_intray_active_count to 0: %v", err))
+	}
+}
@@ -14,6 +14,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cristianoliveira/tmux-intray/internal/colors"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
 	"github.com/cristianoliveira/tmux-intray/internal/hooks"
 	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
 	_ "modernc.org/sqlite"
@@ -23,30 +26,57 @@ import (
 type SQLiteStorage struct {
 	db      *sql.DB
 	queries *sqlcgen.Queries
+	dbPath  string
+	options Options
 }
 
-// NewSQLiteStorage creates a SQLite-backed storage at the provided path.
-func NewSQLiteStorage(dbPath string) (*SQLiteStorage, error) {
+// NewSQLiteStorage creates a SQLite-backed storage at the provided path that
+// behaves as options configure.
+func NewSQLiteStorage(dbPath string, options Options) (*SQLiteStorage, error) {
 	if strings.TrimSpace(dbPath) == "" {
 		return nil, fmt.Errorf("sqlite storage: db path cannot be empty")
 	}
 
-	if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
-		return nil, fmt.Errorf("sqlite storage: create db directory: %w", err)
+	storage := &SQLiteStorage{dbPath: dbPath, options: options}
+	if err := storage.open(); err != nil {
+		return nil, err
+	}
+
+	return storage, nil
+}
+
+// open creates the db directory, opens the database, and ensures the schema exists.
+func (s *SQLiteStorage) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.dbPath), 0o755); err != nil {
+		return fmt.Errorf("sqlite storage: create db directory: %w", err)
 	}
 
-	db, err := sql.Open("sqlite", dbPath)
+	db, err := sql.Open("sqlite", s.dbPath)
 	if err != nil {
-		return nil, fmt.Errorf("sqlite storage: open db: %w", err)
+		return fmt.Errorf("sqlite storage: open db: %w", err)
 	}
 
-	storage := &SQLiteStorage{db: db, queries: sqlcgen.New(db)}
-	if err := storage.init(); err != nil {
+	s.db = db
+	s.queries = sqlcgen.New(db)
+	if err := s.init(); err != nil {
 		_ = db.Close()
-		return nil, err
+		return err
 	}
+	return nil
+}
 
-	return storage, nil
+// ensureDatabaseFile recreates the database when its file was removed while
+// the storage is open (for example, the state dir was deleted mid-run).
+// The recreated database is empty.
+func (s *SQLiteStorage) ensureDatabaseFile() error {
+	if _, err := os.Stat(s.dbPath); !os.IsNotExist(err) {
+		return nil
+	}
+	colors.Warning(fmt.Sprintf("notifications database %s is missing; recreating it", s.dbPath))
+	if s.db != nil {
+		_ = s.db.Close()
+	}
+	return s.open()
 }
 
 // Close closes the underlying SQLite connection.
@@ -71,60 +101,211 @@ func (s *SQLiteStorage) init() error {
 
 // AddNotification adds a notification and returns its generated ID.
 func (s *SQLiteStorage) AddNotification(message, timestamp, session, window, pane, paneCreated, level string) (string, error) {
+	result, err := s.AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level, domain.AddOptions{})
+	return result.ID, err
+}
+
+// AddNotificationSilently adds a notification without delivering it: post-add
+// hooks do not run and pre-add hooks get an empty $CHANNELS, so the delivery
+// hooks skip it. The notification is stored unread as usual.
+func (s *SQLiteStorage) AddNotificationSilently(message, timestamp, session, window, pane, paneCreated, level string) (string, error) {
+	result, err := s.AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level, domain.AddOptions{Silent: true, Channels: []string{}})
+	return result.ID, err
+}
+
+// AddNotificationWithOptions adds a notification with the color, priority and
+// tags in opts, like AddNotificationWithResult. An invalid color or tag stores
+// nothing.
+func (s *SQLiteStorage) AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (domain.AddNotificationResult, error) {
+	if opts.Color != "" && !domain.IsValidColor(opts.Color) {
+		return domain.AddNotificationResult{}, fmt.Errorf("validation error: invalid color '%s', must be an ANSI color number (0-255) or a hex color (#rgb or #rrggbb)", opts.Color)
+	}
+	tags, err := domain.NormalizeTags(opts.Tags)
+	if err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("validation error: %w", err)
+	}
+	opts.Tags = tags
+	return s.addNotificationResult(message, timestamp, session, window, pane, paneCreated, level, opts)
+}
+
+// addChannelsEnv returns the CHANNELS hook variable: the delivery channels an
+// add routes to, comma-separated. Nil channels route to every channel.
+func addChannelsEnv(channels []string) string {
+	if channels == nil {
+		channels = domain.AllChannels
+	}
+	return "CHANNELS=" + strings.Join(channels, ",")
+}
+
+// addNotificationResult stores a notification and then dismisses the oldest
+// active notifications above max_active, reporting their IDs in the result.
+// The notification and everything stored beside it are written in one
+// transaction, so a failed add leaves nothing behind. When
+// dedup.collapse_window collapses the add into an existing notification, no
+// row is stored, no add hooks run, and the result carries the existing ID.
+func (s *SQLiteStorage) addNotificationResult(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (domain.AddNotificationResult, error) {
 	if err := validateNotificationInputs(message, timestamp, session, window, pane, level); err != nil {
-		return "", err
+		return domain.AddNotificationResult{}, err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return domain.AddNotificationResult{}, err
 	}
+	message = capMessageForWrite(message)
 	if timestamp == "" {
 		timestamp = utcNow()
 	}
 	id, err := s.nextNotificationID()
 	if err != nil {
-		return "", err
+		return domain.AddNotificationResult{}, err
+	}
+	muted, err := s.isSessionMuted(session)
+	if err != nil {
+		return domain.AddNotificationResult{}, err
 	}
+	maxLength, keepFull := s.options.MaxMessageLength, s.options.KeepFullMessage
+	fullMessage := message
+	message, truncated := truncateMessage(message, maxLength)
 	escapedMessage := escapeMessage(message)
+	// Muted sessions keep the notification but store it read.
+	readTimestamp := ""
+	if muted {
+		readTimestamp = utcNow()
+	}
+	duplicateID, err := s.collapseDuplicate(message, timestamp, session, window, pane, level, readTimestamp)
+	if err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if duplicateID != 0 {
+		s.syncTmuxStatusOption()
+		return domain.AddNotificationResult{ID: strconv.FormatInt(duplicateID, 10)}, nil
+	}
+
 	envVars := buildNotificationHookEnv(id, level, message, escapedMessage, timestamp, session, window, pane, paneCreated)
-	if err := hooks.Run("pre-add", envVars...); err != nil {
-		return "", fmt.Errorf("pre-add hook aborted: %w", err)
+	envVars = append(envVars, addChannelsEnv(opts.Channels))
+	if !muted {
+		if err := hooks.Run("pre-add", envVars...); err != nil {
+			return domain.AddNotificationResult{}, fmt.Errorf("pre-add hook aborted: %w", err)
+		}
+	}
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("sqlite storage: begin add notification: %w", err)
 	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
 
 	now := utcNow()
-	err = s.queries.CreateNotification(context.Background(), sqlcgen.CreateNotificationParams{
-		ID:          id,
-		Timestamp:   timestamp,
-		Session:     session,
-		Window:      window,
-		Pane:        pane,
-		Message:     message,
-		PaneCreated: paneCreated,
-		Level:       level,
-		UpdatedAt:   now,
+	err = q.CreateNotification(ctx, sqlcgen.CreateNotificationParams{
+		ID:            id,
+		Timestamp:     timestamp,
+		Session:       session,
+		Window:        window,
+		Pane:          pane,
+		Message:       message,
+		PaneCreated:   paneCreated,
+		Level:         level,
+		ReadTimestamp: readTimestamp,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("sqlite storage: add notification: %w", err)
+	}
+	if err := recordIDHighWater(ctx, q); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if truncated && keepFull {
+		if err := recordFullMessage(ctx, q, id, fullMessage); err != nil {
+			return domain.AddNotificationResult{}, err
+		}
+	}
+	if err := recordColor(ctx, q, id, opts.Color); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if err := recordPriority(ctx, q, id, opts.Priority); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if err := recordTags(ctx, q, id, opts.Tags); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if err := recordPreviousOccurrence(ctx, q, s.options.Dedup, id, message, session, window, pane, level); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("sqlite storage: commit add notification: %w", err)
+	}
+	added := notificationEvent(events.TypeAdd, hookNotification{
+		id:      id,
+		session: session,
+		window:  window,
+		pane:    pane,
+		message: message,
+		level:   level,
 	})
+	if muted {
+		s.recordEvents(added, events.Event{Type: events.TypeRead, NotificationID: added.NotificationID})
+	} else {
+		s.recordEvents(added)
+	}
+	if _, err := s.dismissLowerLevels(pane, level); err != nil {
+		return domain.AddNotificationResult{}, err
+	}
+	result := domain.AddNotificationResult{ID: strconv.FormatInt(id, 10)}
+	result.Evicted, err = s.evictOverMaxActive(id)
 	if err != nil {
-		return "", fmt.Errorf("sqlite storage: add notification: %w", err)
+		return result, err
 	}
 	s.syncTmuxStatusOption()
+	// Muted sessions skip add hooks, which is where desktop and sound delivery happens.
+	if muted || opts.Silent {
+		return result, nil
+	}
 	if err := hooks.Run("post-add", envVars...); err != nil {
-		return strconv.FormatInt(id, 10), fmt.Errorf("post-add hook failed: %w", err)
+		return result, fmt.Errorf("post-add hook failed: %w", err)
 	}
 
-	return strconv.FormatInt(id, 10), nil
+	return result, nil
 }
 
-// ListNotifications returns TSV lines matching all provided filters.
+// ListNotifications returns TSV lines matching all provided filters. The
+// "active" state leaves out notifications snoozed until a time still ahead.
 func (s *SQLiteStorage) ListNotifications(stateFilter, levelFilter, sessionFilter, windowFilter, paneFilter, olderThanCutoff, newerThanCutoff, readFilter string) (string, error) {
-	if err := validateListInputs(stateFilter, levelFilter, olderThanCutoff, newerThanCutoff); err != nil {
+	return s.ListWithFilter(ListFilter{
+		State:     stateFilter,
+		Level:     levelFilter,
+		Session:   sessionFilter,
+		Window:    windowFilter,
+		Pane:      paneFilter,
+		OlderThan: olderThanCutoff,
+		NewerThan: newerThanCutoff,
+		Read:      readFilter,
+	})
+}
+
+// ListWithFilter returns TSV lines for the notifications matching f, like
+// ListNotifications. Tags limits them to notifications carrying at least one
+// of its comma-separated tags.
+func (s *SQLiteStorage) ListWithFilter(f ListFilter) (string, error) {
+	f, err := f.normalized()
+	if err != nil {
+		return "", err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
 		return "", err
 	}
 
 	rows, err := s.queries.ListNotifications(context.Background(), sqlcgen.ListNotificationsParams{
-		StateFilter:     stateFilter,
-		LevelFilter:     levelFilter,
-		SessionFilter:   sessionFilter,
-		WindowFilter:    windowFilter,
-		PaneFilter:      paneFilter,
-		OlderThanCutoff: olderThanCutoff,
-		NewerThanCutoff: newerThanCutoff,
-		ReadFilter:      readFilter,
+		StateFilter:     f.State,
+		LevelFilter:     f.Level,
+		SessionFilter:   f.Session,
+		WindowFilter:    f.Window,
+		PaneFilter:      f.Pane,
+		OlderThanCutoff: f.OlderThan,
+		NewerThanCutoff: f.NewerThan,
+		ReadFilter:      f.Read,
+		Now:             utcNow(),
+		TagFilter:       f.Tags,
 	})
 	if err != nil {
 		return "", fmt.Errorf("sqlite storage: list notifications: %w", err)
@@ -143,6 +324,144 @@ func (s *SQLiteStorage) ListNotifications(stateFilter, levelFilter, sessionFilte
 			row.PaneCreated,
 			row.Level,
 			row.ReadTimestamp,
+			row.Important != 0,
+			row.Color,
+			row.Owner,
+			row.SnoozedUntil,
+			row.Priority,
+			row.Tags,
+			row.Occurrences,
+		))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// ListNotificationsPaged returns one page of the TSV lines ListWithFilter
+// would return for f, plus how many lines match in total. The page starts
+// offset lines into the filtered, ID-ordered result and holds at most limit
+// lines; a limit of zero or less returns everything from offset on.
+func (s *SQLiteStorage) ListNotificationsPaged(f ListFilter, offset, limit int) ([]string, int, error) {
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("invalid offset %d, must be >= 0", offset)
+	}
+	f, err := f.normalized()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return nil, 0, err
+	}
+
+	now := utcNow()
+	total, err := s.queries.CountNotifications(context.Background(), sqlcgen.CountNotificationsParams{
+		StateFilter:     f.State,
+		LevelFilter:     f.Level,
+		SessionFilter:   f.Session,
+		WindowFilter:    f.Window,
+		PaneFilter:      f.Pane,
+		OlderThanCutoff: f.OlderThan,
+		NewerThanCutoff: f.NewerThan,
+		ReadFilter:      f.Read,
+		Now:             now,
+		TagFilter:       f.Tags,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("sqlite storage: count notifications: %w", err)
+	}
+
+	pageLimit := int64(limit)
+	if limit <= 0 {
+		pageLimit = -1 // SQLite reads a negative LIMIT as no limit
+	}
+	rows, err := s.queries.ListNotificationsPage(context.Background(), sqlcgen.ListNotificationsPageParams{
+		StateFilter:     f.State,
+		LevelFilter:     f.Level,
+		SessionFilter:   f.Session,
+		WindowFilter:    f.Window,
+		PaneFilter:      f.Pane,
+		OlderThanCutoff: f.OlderThan,
+		NewerThanCutoff: f.NewerThan,
+		ReadFilter:      f.Read,
+		Now:             now,
+		TagFilter:       f.Tags,
+		PageLimit:       pageLimit,
+		PageOffset:      int64(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("sqlite storage: list notifications page: %w", err)
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		lines = append(lines, formatNotificationLine(
+			row.ID,
+			row.Timestamp,
+			row.State,
+			row.Session,
+			row.Window,
+			row.Pane,
+			row.Message,
+			row.PaneCreated,
+			row.Level,
+			row.ReadTimestamp,
+			row.Important != 0,
+			row.Color,
+			row.Owner,
+			row.SnoozedUntil,
+			row.Priority,
+			row.Tags,
+			row.Occurrences,
+		))
+	}
+
+	return lines, int(total), nil
+}
+
+// ListSinceID returns TSV lines for notifications with an ID greater than
+// afterID, oldest first. Polling clients pass the highest ID they have seen to
+// fetch only what is new; zero returns every notification. stateFilter means
+// the same as in ListNotifications.
+func (s *SQLiteStorage) ListSinceID(afterID int, stateFilter string) (string, error) {
+	if afterID < 0 {
+		return "", fmt.Errorf("invalid afterID %d, must be >= 0", afterID)
+	}
+	if err := validateListInputs(stateFilter, "", "", ""); err != nil {
+		return "", err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return "", err
+	}
+
+	rows, err := s.queries.ListNotificationsSinceID(context.Background(), sqlcgen.ListNotificationsSinceIDParams{
+		AfterID:     int64(afterID),
+		StateFilter: stateFilter,
+		Now:         utcNow(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("sqlite storage: list notifications since id: %w", err)
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		lines = append(lines, formatNotificationLine(
+			row.ID,
+			row.Timestamp,
+			row.State,
+			row.Session,
+			row.Window,
+			row.Pane,
+			row.Message,
+			row.PaneCreated,
+			row.Level,
+			row.ReadTimestamp,
+			row.Important != 0,
+			row.Color,
+			row.Owner,
+			row.SnoozedUntil,
+			row.Priority,
+			row.Tags,
+			row.Occurrences,
 		))
 	}
 
@@ -155,6 +474,9 @@ func (s *SQLiteStorage) GetNotificationByID(id string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return "", err
+	}
 
 	row, err := s.queries.GetNotificationLineByID(context.Background(), idInt)
 	if err != nil {
@@ -175,12 +497,23 @@ func (s *SQLiteStorage) GetNotificationByID(id string) (string, error) {
 		row.PaneCreated,
 		row.Level,
 		row.ReadTimestamp,
+		row.Important != 0,
+		row.Color,
+		row.Owner,
+		row.SnoozedUntil,
+		row.Priority,
+		row.Tags,
+		row.Occurrences,
 	), nil
 }
 
-// GetActiveCount returns the number of active notifications.
+// GetActiveCount returns the number of active notifications, leaving out
+// those snoozed until a time still ahead.
 func (s *SQLiteStorage) GetActiveCount() int {
-	count, err := s.queries.CountActiveNotifications(context.Background())
+	if err := s.ensureDatabaseFile(); err != nil {
+		return 0
+	}
+	count, err := s.queries.CountActiveNotifications(context.Background(), utcNow())
 	if err != nil {
 		return 0
 	}
@@ -235,20 +568,49 @@ func validateListInputs(stateFilter, levelFilter, olderThanCutoff, newerThanCuto
 	return nil
 }
 
-func formatNotificationLine(id int64, timestamp, state, session, window, pane, message, paneCreated, level, readTimestamp string) string {
-	return fmt.Sprintf(
-		"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
+func formatNotificationLine(id int64, timestamp, state, session, window, pane, message, paneCreated, level, readTimestamp string, important bool, color, owner, snoozedUntil string, priority int64, tags string, occurrences int64) string {
+	importantField := ""
+	if important {
+		importantField = importantFlag
+	}
+	priorityField := ""
+	if priority != 0 {
+		priorityField = strconv.FormatInt(priority, 10)
+	}
+	occurrencesField := ""
+	if occurrences != 0 {
+		occurrencesField = strconv.FormatInt(occurrences, 10)
+	}
+	oversized := false
+	field := func(value string) string {
+		capped, cut := capField(value)
+		oversized = oversized || cut
+		return capped
+	}
+	line := fmt.Sprintf(
+		"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
 		id,
-		timestamp,
-		state,
-		session,
-		window,
-		pane,
-		escapeMessage(message),
-		paneCreated,
-		level,
-		readTimestamp,
+		field(timestamp),
+		field(state),
+		field(session),
+		field(window),
+		field(pane),
+		escapeMessage(field(message)),
+		field(paneCreated),
+		field(level),
+		field(readTimestamp),
+		importantField,
+		field(color),
+		field(owner),
+		field(snoozedUntil),
+		priorityField,
+		field(tags),
+		occurrencesField,
 	)
+	if oversized {
+		warnOversizedFields(id)
+	}
+	return line
 }
 
 func parseID(id string) (int64, error) {
@@ -263,6 +625,8 @@ func parseID(id string) (int64, error) {
 	return idInt, nil
 }
 
+// nextNotificationID returns one past the highest ID ever stored, so IDs
+// freed by cleanup are never handed out again.
 func (s *SQLiteStorage) nextNotificationID() (int64, error) {
 	id, err := s.queries.NextNotificationID(context.Background())
 	if err != nil {
@@ -271,6 +635,15 @@ func (s *SQLiteStorage) nextNotificationID() (int64, error) {
 	return id, nil
 }
 
+// recordIDHighWater persists the highest notification ID so it survives the
+// row being deleted.
+func recordIDHighWater(ctx context.Context, q *sqlcgen.Queries) error {
+	if err := q.RecordNotificationIDHighWater(ctx); err != nil {
+		return fmt.Errorf("sqlite storage: record id high-water mark: %w", err)
+	}
+	return nil
+}
+
 func escapeMessage(msg string) string {
 	msg = strings.ReplaceAll(msg, "\\", "\\\\")
 	msg = strings.ReplaceAll(msg, "\t", "\\t")
@@ -278,6 +651,9 @@ func escapeMessage(msg string) string {
 	return msg
 }
 
+// nowFunc is the clock used for stored timestamps; tests replace it.
+var nowFunc = time.Now
+
 func utcNow() string {
-	return time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	return nowFunc().UTC().Format("2006-01-02T15:04:05Z")
 }
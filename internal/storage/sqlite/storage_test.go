@@ -4,10 +4,15 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/events"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -26,11 +31,46 @@ func (m *mockStatusPublisher) SetStatusOption(name, value string) error {
 	return args.Error(0)
 }
 
+func (m *mockStatusPublisher) UnsetStatusOption(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *mockStatusPublisher) SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error {
+	args := m.Called(delay, name, value, guard, guardValue)
+	return args.Error(0)
+}
+
+// ListStatusOptions replays the recorded option writes, so it reports what a
+// tmux server would hold after them.
+func (m *mockStatusPublisher) ListStatusOptions(prefix string) (map[string]string, error) {
+	options := map[string]string{}
+	for _, call := range m.Calls {
+		switch call.Method {
+		case "SetStatusOption":
+			options[call.Arguments.String(0)] = call.Arguments.String(1)
+		case "UnsetStatusOption":
+			delete(options, call.Arguments.String(0))
+		}
+	}
+	for name := range options {
+		if !strings.HasPrefix(name, prefix) {
+			delete(options, name)
+		}
+	}
+	return options, nil
+}
+
 func newTestStorage(t *testing.T) *SQLiteStorage {
 	t.Helper()
 
-	dbPath := filepath.Join(t.TempDir(), "notifications.db")
-	s, err := NewSQLiteStorage(dbPath)
+	stateDir := t.TempDir()
+	// Keeps the events.jsonl stream written by adds, dismissals and reads
+	// inside the test directory.
+	t.Setenv("TMUX_INTRAY_STATE_DIR", stateDir)
+	dbPath := filepath.Join(stateDir, "notifications.db")
+	config.Load()
+	s, err := NewSQLiteStorage(dbPath, OptionsFromConfig())
 	require.NoError(t, err)
 	t.Cleanup(func() {
 		require.NoError(t, s.Close())
@@ -88,169 +128,1787 @@ func TestListNotificationsFilters(t *testing.T) {
 	require.NotContains(t, list, "2026-01-02T01:00:00Z")
 }
 
-func TestDismissNotificationAndDismissAll(t *testing.T) {
-	s := newTestStorage(t)
+func TestDismissNotificationAndDismissAll(t *testing.T) {
+	s := newTestStorage(t)
+
+	id1, err := s.AddNotification("n1", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	id2, err := s.AddNotification("n2", "", "", "", "", "", "warning")
+	require.NoError(t, err)
+
+	require.Equal(t, 2, s.GetActiveCount())
+	require.NoError(t, s.DismissNotification(id1))
+	require.Equal(t, 1, s.GetActiveCount())
+
+	err = s.DismissNotification(id1)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrNotificationAlreadyDismissed))
+
+	require.NoError(t, s.DismissAll())
+	require.Equal(t, 0, s.GetActiveCount())
+
+	line, err := s.GetNotificationByID(id2)
+	require.NoError(t, err)
+	require.Contains(t, line, "\tdismissed\t")
+}
+
+func TestUndismissNotification(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("n", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	require.Equal(t, 0, s.GetActiveCount())
+
+	require.NoError(t, s.UndismissNotification(id))
+	require.Equal(t, 1, s.GetActiveCount())
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Contains(t, line, "\tactive\t")
+
+	err = s.UndismissNotification("999")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrNotificationNotFound))
+
+	err = s.UndismissNotification(id)
+	require.ErrorIs(t, err, ErrNotificationNotDismissed)
+}
+
+func TestUndismissNotificationRunsHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID:$MESSAGE\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-undismiss", "01-pre-undismiss.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-undismiss", "01-post-undismiss.sh", scriptBody)
+
+	s := newTestStorage(t)
+	id, err := s.AddNotification("deploy done", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	require.NoError(t, s.UndismissNotification(id))
+	require.ErrorIs(t, s.UndismissNotification(id), ErrNotificationNotDismissed)
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "pre-undismiss:"+id+":deploy done\npost-undismiss:"+id+":deploy done\n", string(content))
+}
+
+func TestUpdateNotificationMessage(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("build 10%", "2025-01-01T10:00:00Z", "$1", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, s.AssignNotification(id, "alice"))
+	before, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+
+	require.NoError(t, s.UpdateNotificationMessage(id, "build 90%\tlinking"))
+
+	after, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	want := strings.Split(before, "\t")
+	want[6] = "build 90%\\tlinking"
+	require.Equal(t, want, strings.Split(after, "\t"), "only the escaped message changes")
+	require.Equal(t, 1, s.GetActiveCount())
+
+	err = s.UpdateNotificationMessage("999", "gone")
+	require.ErrorIs(t, err, ErrNotificationNotFound)
+	require.Error(t, s.UpdateNotificationMessage(id, "  "))
+}
+
+func TestUpdateNotificationMessageRunsHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID:$PREVIOUS_MESSAGE:$MESSAGE\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-update", "01-pre-update.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-update", "01-post-update.sh", scriptBody)
+
+	s := newTestStorage(t)
+	id, err := s.AddNotification("deploy started", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateNotificationMessage(id, "deploy done"))
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "pre-update:"+id+":deploy started:deploy done\npost-update:"+id+":deploy started:deploy done\n", string(content))
+}
+
+func TestUpdateNotificationMessageReplacesKeptFullMessage(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_MESSAGE_LENGTH", "5")
+	t.Setenv("TMUX_INTRAY_KEEP_FULL_MESSAGE", "true")
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("compiling sources", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateNotificationMessage(id, "done"))
+
+	full, err := s.GetFullMessage(id)
+	require.NoError(t, err)
+	require.Equal(t, "done", full, "the old full message is dropped")
+
+	require.NoError(t, s.UpdateNotificationMessage(id, "linking binaries"))
+	full, err = s.GetFullMessage(id)
+	require.NoError(t, err)
+	require.Equal(t, "linking binaries", full)
+}
+
+func TestDismissRunsResolveHookForErrorAndCritical(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	writeHookScript(t, hooksDir, "resolve", "01-resolve.sh", "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID:$LEVEL\" >> \"$HOOK_LOG\"\n")
+
+	s := newTestStorage(t)
+	info, err := s.AddNotification("deploy done", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	failed, err := s.AddNotification("deploy failed", "", "$1", "@1", "%2", "", "error")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(info))
+	_, err = os.Stat(hookLog)
+	require.True(t, os.IsNotExist(err), "dismissing an info notification does not resolve")
+
+	require.NoError(t, s.DismissNotification(failed))
+	_, err = s.AddNotification("disk full", "", "$2", "@2", "%3", "", "critical")
+	require.NoError(t, err)
+	_, err = s.AddNotification("tests flaky", "", "$2", "@2", "%4", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissAll())
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "resolve:"+failed+":error\nresolve:3:critical\n", string(content))
+}
+
+func TestDismissByIDsDismissesListedNotificationsWithHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-dismiss", "01-pre-dismiss.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-dismiss", "01-post-dismiss.sh", scriptBody)
+
+	s := newTestStorage(t)
+	first, err := s.AddNotification("first", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	second, err := s.AddNotification("second", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	kept, err := s.AddNotification("kept", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	changed, err := s.DismissByIDs([]string{first, second, "999"})
+	require.NoError(t, err)
+	require.Equal(t, 2, changed, "missing IDs are skipped")
+
+	changed, err = s.DismissByIDs([]string{first})
+	require.NoError(t, err)
+	require.Zero(t, changed, "already dismissed IDs are skipped")
+
+	for id, want := range map[string]string{first: "dismissed", second: "dismissed", kept: "active"} {
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		require.Equal(t, want, strings.Split(line, "\t")[2], "notification %s", id)
+	}
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "pre-dismiss:"+first+"\npre-dismiss:"+second+"\npost-dismiss:"+first+"\npost-dismiss:"+second+"\n", string(content),
+		"every pre-dismiss hook runs before the transaction")
+}
+
+func TestDismissSkipsNotificationDismissedAfterItWasRead(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	writeHookScript(t, hooksDir, "post-dismiss", "01-post-dismiss.sh", "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n")
+
+	s := newTestStorage(t)
+	id, err := s.AddNotification("raced", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	idInt, err := parseID(id)
+	require.NoError(t, err)
+	stale, err := s.getNotificationForHooks(idInt)
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	before, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+
+	changed, err := s.dismissSingleNotification(stale)
+	require.NoError(t, err)
+	require.False(t, changed, "a notification dismissed in between is not dismissed again")
+
+	after, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, before, after, "the guard leaves updated_at alone")
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "post-dismiss:"+id+"\n", string(content), "post-dismiss runs once")
+}
+
+func TestDismissByIDsDismissesNothingWhenAPreDismissHookAborts(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("TMUX_INTRAY_HOOKS_FAILURE_MODE", "abort")
+	writeHookScript(t, hooksDir, "pre-dismiss", "01-pre-dismiss.sh", "#!/bin/sh\n[ \"$NOTIFICATION_ID\" != 2 ]\n")
+
+	s := newTestStorage(t)
+	first, err := s.AddNotification("first", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	second, err := s.AddNotification("second", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.Equal(t, "2", second)
+
+	_, err = s.DismissByIDs([]string{first, second})
+	require.Error(t, err)
+
+	for _, id := range []string{first, second} {
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		require.Equal(t, "active", strings.Split(line, "\t")[2], "notification %s", id)
+	}
+}
+
+func TestMarkReadAndUnread(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("n", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.MarkNotificationRead(id))
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields := strings.Split(line, "\t")
+	require.Len(t, fields, 17)
+	require.NotEmpty(t, fields[9])
+	_, err = time.Parse(time.RFC3339, fields[9])
+	require.NoError(t, err)
+
+	require.NoError(t, s.MarkNotificationUnread(id))
+	line, err = s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields = strings.Split(line, "\t")
+	require.Empty(t, fields[9])
+}
+
+func TestMarkAllReadAndUnreadChangeActiveNotificationsOnly(t *testing.T) {
+	s := newTestStorage(t)
+
+	unread, err := s.AddNotification("unread", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	alreadyRead, err := s.AddNotification("already read", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.MarkNotificationRead(alreadyRead))
+	dismissed, err := s.AddNotification("dismissed", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(dismissed))
+
+	readState := func(id string) string {
+		t.Helper()
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		return strings.Split(line, "\t")[9]
+	}
+
+	changed, err := s.MarkAllRead()
+	require.NoError(t, err)
+	require.Equal(t, 1, changed, "only the unread active notification changes")
+	require.NotEmpty(t, readState(unread))
+	require.Empty(t, readState(dismissed))
+
+	changed, err = s.MarkAllRead()
+	require.NoError(t, err)
+	require.Zero(t, changed)
+
+	changed, err = s.MarkAllUnread()
+	require.NoError(t, err)
+	require.Equal(t, 2, changed)
+	require.Empty(t, readState(unread))
+	require.Empty(t, readState(alreadyRead))
+}
+
+func TestMarkReadAndUnreadByIDsChangeOnlyListedNotifications(t *testing.T) {
+	s := newTestStorage(t)
+
+	first, err := s.AddNotification("first", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	second, err := s.AddNotification("second", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	untouched, err := s.AddNotification("untouched", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	readState := func(id string) string {
+		t.Helper()
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		return strings.Split(line, "\t")[9]
+	}
+
+	changed, err := s.MarkReadByIDs([]string{first, second, "999"})
+	require.NoError(t, err)
+	require.Equal(t, 2, changed, "missing IDs are skipped")
+	require.NotEmpty(t, readState(first))
+	require.NotEmpty(t, readState(second))
+	require.Empty(t, readState(untouched))
+
+	changed, err = s.MarkUnreadByIDs([]string{second})
+	require.NoError(t, err)
+	require.Equal(t, 1, changed)
+	require.NotEmpty(t, readState(first))
+	require.Empty(t, readState(second))
+
+	_, err = s.MarkReadByIDs([]string{untouched, "abc"})
+	require.ErrorIs(t, err, ErrInvalidNotificationID)
+	require.Empty(t, readState(untouched), "an invalid ID changes nothing")
+}
+
+func TestMarkReadWithTimestamp(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("n", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.MarkNotificationReadWithTimestamp(id, "2026-01-02T03:04:05Z"))
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields := strings.Split(line, "\t")
+	require.Equal(t, "2026-01-02T03:04:05Z", fields[9])
+
+	err = s.MarkNotificationReadWithTimestamp(id, "yesterday")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidTimestamp))
+
+	unchanged, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, line, unchanged)
+}
+
+func TestCleanupOldNotifications(t *testing.T) {
+	s := newTestStorage(t)
+
+	idOld, err := s.AddNotification("old", "2000-01-01T00:00:00Z", "", "", "", "", "info")
+	require.NoError(t, err)
+	idNew, err := s.AddNotification("new", "2099-01-01T00:00:00Z", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.DismissNotification(idOld))
+	require.NoError(t, s.DismissNotification(idNew))
+
+	require.NoError(t, s.CleanupOldNotifications(1, true))
+	_, err = s.GetNotificationByID(idOld)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CleanupOldNotifications(1, false))
+	_, err = s.GetNotificationByID(idOld)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrNotificationNotFound))
+
+	_, err = s.GetNotificationByID(idNew)
+	require.NoError(t, err)
+}
+
+func requireIDAbove(t *testing.T, id, floor string) {
+	t.Helper()
+	idInt, err := parseID(id)
+	require.NoError(t, err)
+	floorInt, err := parseID(floor)
+	require.NoError(t, err)
+	require.Greater(t, idInt, floorInt, "an ID freed by cleanup must not be reused")
+}
+
+func TestMarkAllReadAndCleanupKeepEventStreamBounded(t *testing.T) {
+	s := newTestStorage(t)
+	for _, message := range []string{"a", "b", "c"} {
+		_, err := s.AddNotification(message, "", "$1", "@1", "%1", "", "info")
+		require.NoError(t, err)
+	}
+	count, err := s.MarkAllRead()
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	path := s.options.EventsPath
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	recorded, err := events.Read(f)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	require.Len(t, recorded, 6, "three adds and three reads")
+
+	require.NoError(t, s.CleanupOldNotifications(30, true))
+	require.FileExists(t, path, "a dry run leaves the stream alone")
+	require.NoError(t, os.Truncate(path, events.MaxStreamSize+1))
+	require.NoError(t, s.CleanupOldNotifications(30, false))
+	require.NoFileExists(t, path)
+	require.FileExists(t, path+".1", "cleanup rotates an oversized stream")
+}
+
+func TestCleanupNeverFreesHighestIDForReuse(t *testing.T) {
+	s := newTestStorage(t)
+
+	_, err := s.AddNotification("first", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	idMax, err := s.AddNotification("second", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(idMax))
+	require.NoError(t, s.CleanupOldNotifications(0, false))
+	_, err = s.GetNotificationByID(idMax)
+	require.True(t, errors.Is(err, ErrNotificationNotFound))
+
+	idNext, err := s.AddNotification("third", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	requireIDAbove(t, idNext, idMax)
+}
+
+func TestCleanupRecordsHighestIDForDatabasesWithoutMark(t *testing.T) {
+	s := newTestStorage(t)
+
+	idMax, err := s.AddNotification("only", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	// Databases created before the mark existed have no row for it.
+	_, err = s.db.Exec("DELETE FROM id_high_water")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(idMax))
+	require.NoError(t, s.CleanupOldNotifications(0, false))
+
+	idNext, err := s.AddNotification("next", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	requireIDAbove(t, idNext, idMax)
+}
+
+func TestCleanupDeletesWhatIsStoredBesideNotifications(t *testing.T) {
+	s := newTestStorage(t)
+
+	added, err := s.AddNotificationWithOptions("deploy blocked", "", "$1", "@1", "%1", "", "error", domain.AddOptions{Color: "#ff8800", Priority: 5, Tags: []string{"deploy"}})
+	id := added.ID
+	require.NoError(t, err)
+	require.NoError(t, s.SetImportant(id, true))
+	require.NoError(t, s.AssignNotification(id, "alice"))
+	require.NoError(t, s.SnoozeNotification(id, time.Now().Add(time.Hour)))
+	keptResult, err := s.AddNotificationWithOptions("still active", "", "$1", "@1", "%1", "", "info", domain.AddOptions{Tags: []string{"deploy"}})
+	kept := keptResult.ID
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+
+	require.NoError(t, s.CleanupOldNotifications(0, false))
+
+	for _, table := range []string{"notification_colors", "notification_priorities", "notification_tags", "important_notifications", "notification_owners", "notification_snoozes"} {
+		var count int
+		require.NoError(t, s.db.QueryRow("SELECT COUNT(1) FROM "+table+" WHERE notification_id = ?", id).Scan(&count))
+		require.Zero(t, count, "cleanup leaves no %s rows behind", table)
+	}
+	line, err := s.GetNotificationByID(kept)
+	require.NoError(t, err)
+	require.Equal(t, "deploy", strings.Split(line, "\t")[15], "other notifications keep theirs")
+}
+
+func TestAddStoresNothingWhenAnExtraFailsToSave(t *testing.T) {
+	s := newTestStorage(t)
+	_, err := s.db.Exec("DROP TABLE notification_tags")
+	require.NoError(t, err)
+
+	_, err = s.AddNotificationWithOptions("tagged", "", "", "", "", "", "info", domain.AddOptions{Color: "#ff8800", Tags: []string{"deploy"}})
+	require.Error(t, err)
+
+	var count int
+	require.NoError(t, s.db.QueryRow("SELECT COUNT(1) FROM notifications").Scan(&count))
+	require.Zero(t, count, "the notification row is rolled back with its extras")
+	require.NoError(t, s.db.QueryRow("SELECT COUNT(1) FROM notification_colors").Scan(&count))
+	require.Zero(t, count)
+}
+
+func TestCleanupDismissesStaleActiveNotifications(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_AUTO_DISMISS_STALE_DAYS", "60")
+	s := newTestStorage(t)
+
+	now := time.Now().UTC()
+	stamp := func(days int) string { return now.AddDate(0, 0, -days).Format(time.RFC3339) }
+	idAncient, err := s.AddNotification("ancient", stamp(90), "", "", "", "", "info")
+	require.NoError(t, err)
+	idStale, err := s.AddNotification("stale", stamp(70), "", "", "", "", "info")
+	require.NoError(t, err)
+	idRecent, err := s.AddNotification("recent", stamp(40), "", "", "", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.CleanupOldNotifications(80, true))
+	for _, id := range []string{idAncient, idStale, idRecent} {
+		assertNotificationState(t, s, id, "active")
+	}
+
+	require.NoError(t, s.CleanupOldNotifications(80, false))
+	_, err = s.GetNotificationByID(idAncient)
+	require.True(t, errors.Is(err, ErrNotificationNotFound), "stale and past the cleanup threshold: dismissed then removed")
+	assertNotificationState(t, s, idStale, "dismissed")
+	assertNotificationState(t, s, idRecent, "active")
+
+	require.NoError(t, s.CleanupOldNotifications(65, false))
+	_, err = s.GetNotificationByID(idStale)
+	require.True(t, errors.Is(err, ErrNotificationNotFound), "dismissed stale notifications are eligible for cleanup")
+	assertNotificationState(t, s, idRecent, "active")
+}
+
+func TestCleanupLeavesActiveNotificationsWhenStaleDismissDisabled(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_AUTO_DISMISS_STALE_DAYS", "0")
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("old", "2000-01-01T00:00:00Z", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.CleanupOldNotifications(1, false))
+	assertNotificationState(t, s, id, "active")
+}
+
+func TestExpireActiveOlderThanDismissesWithHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	s := newTestStorage(t)
+
+	now := time.Now().UTC()
+	stamp := func(age time.Duration) string { return now.Add(-age).Format(time.RFC3339) }
+	oldInfo, err := s.AddNotification("build started", stamp(3*time.Hour), "", "", "", "", "info")
+	require.NoError(t, err)
+	oldError, err := s.AddNotification("build failed", stamp(3*time.Hour), "", "", "", "", "error")
+	require.NoError(t, err)
+	freshInfo, err := s.AddNotification("tests started", stamp(10*time.Minute), "", "", "", "", "info")
+	require.NoError(t, err)
+
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-dismiss", "01-pre-dismiss.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-dismiss", "01-post-dismiss.sh", scriptBody)
+
+	expired, err := s.ExpireActiveOlderThan("info", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, expired)
+	assertNotificationState(t, s, oldInfo, "dismissed")
+	assertNotificationState(t, s, oldError, "active")
+	assertNotificationState(t, s, freshInfo, "active")
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "pre-dismiss:"+oldInfo+"\npost-dismiss:"+oldInfo+"\n", string(content))
+
+	expired, err = s.ExpireActiveOlderThan("", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, expired, "an empty level expires every level")
+	assertNotificationState(t, s, oldError, "dismissed")
+	assertNotificationState(t, s, freshInfo, "active")
+
+	_, err = s.ExpireActiveOlderThan("loud", time.Hour)
+	require.ErrorContains(t, err, "invalid level")
+	_, err = s.ExpireActiveOlderThan("", 0)
+	require.Error(t, err)
+}
+
+func TestAddDismissesLowerLevelsForSamePane(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_AUTO_DISMISS_LOWER_LEVELS", "true")
+	s := newTestStorage(t)
+
+	idWarning, err := s.AddNotification("disk filling", "", "main", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+	idInfo, err := s.AddNotification("build started", "", "main", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	idError, err := s.AddNotification("build failed", "", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	idOtherPane, err := s.AddNotification("disk filling", "", "main", "@1", "%2", "", "warning")
+	require.NoError(t, err)
+
+	idCritical, err := s.AddNotification("disk full", "", "main", "@1", "%1", "", "critical")
+	require.NoError(t, err)
+
+	assertNotificationState(t, s, idWarning, "dismissed")
+	assertNotificationState(t, s, idInfo, "dismissed")
+	assertNotificationState(t, s, idError, "dismissed")
+	assertNotificationState(t, s, idOtherPane, "active")
+	assertNotificationState(t, s, idCritical, "active")
+
+	idSecond, err := s.AddNotification("disk still full", "", "main", "@1", "%1", "", "critical")
+	require.NoError(t, err)
+	assertNotificationState(t, s, idCritical, "active")
+	assertNotificationState(t, s, idSecond, "active")
+}
+
+func TestAddKeepsLowerLevelsWhenLowerLevelDismissDisabled(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_AUTO_DISMISS_LOWER_LEVELS", "false")
+	s := newTestStorage(t)
+
+	idWarning, err := s.AddNotification("disk filling", "", "main", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+	_, err = s.AddNotification("disk full", "", "main", "@1", "%1", "", "critical")
+	require.NoError(t, err)
+
+	assertNotificationState(t, s, idWarning, "active")
+}
+
+func TestAddEvictsOldestActiveOverMaxActive(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_ACTIVE", "2")
+	s := newTestStorage(t)
+
+	idNewest, err := s.AddNotification("deploy done", "2025-01-03T10:00:00Z", "main", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	idOldest, err := s.AddNotification("build started", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	idMiddle, err := s.AddNotification("tests passed", "2025-01-02T10:00:00Z", "main", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	assertNotificationState(t, s, idOldest, "dismissed")
+	assertNotificationState(t, s, idMiddle, "active")
+	assertNotificationState(t, s, idNewest, "active")
+
+	result, err := s.AddNotificationWithResult("lint failed", "2025-01-04T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.Equal(t, []string{idMiddle}, result.Evicted)
+	assertNotificationState(t, s, result.ID, "active")
+	require.Equal(t, 2, s.GetActiveCount())
+
+	old, err := s.AddNotificationWithResult("backfilled", "2024-12-31T10:00:00Z", "main", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	require.Equal(t, []string{idNewest}, old.Evicted, "the new notification is never evicted")
+	assertNotificationState(t, s, old.ID, "active")
+}
+
+func TestAddKeepsEverythingWithoutMaxActive(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_ACTIVE", "0")
+	s := newTestStorage(t)
+
+	for _, message := range []string{"build 1", "build 2", "build 3"} {
+		result, err := s.AddNotificationWithResult(message, "", "main", "@1", "%1", "", "info")
+		require.NoError(t, err)
+		require.Empty(t, result.Evicted)
+	}
+	require.Equal(t, 3, s.GetActiveCount())
+}
+
+func TestAddCollapsesDuplicatesWithinCollapseWindow(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__COLLAPSE_WINDOW", "1m")
+	t.Setenv("TMUX_INTRAY_DEDUP__CRITERIA", "exact")
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("build failed", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	again, err := s.AddNotification("build failed", "2025-01-01T10:00:30Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.Equal(t, id, again, "a repeat inside the window reuses the entry")
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields := strings.Split(line, "\t")
+	require.Len(t, fields, 17)
+	require.Equal(t, "2025-01-01T10:00:30Z", fields[1], "the timestamp moves to the latest add")
+	require.Equal(t, "2", fields[16])
+	require.Equal(t, 1, s.GetActiveCount())
+
+	otherPane, err := s.AddNotification("build failed", "2025-01-01T10:00:40Z", "main", "@1", "%2", "", "error")
+	require.NoError(t, err)
+	require.NotEqual(t, id, otherPane, "another pane is not a duplicate")
+
+	later, err := s.AddNotification("build failed", "2025-01-01T10:02:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NotEqual(t, id, later, "a repeat after the window is a new entry")
+}
+
+func TestAddCollapsesOnlyExactDuplicatesWhateverDedupCriteria(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__COLLAPSE_WINDOW", "1m")
+	t.Setenv("TMUX_INTRAY_DEDUP__CRITERIA", "message")
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("build failed", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	otherPane, err := s.AddNotification("build failed", "2025-01-01T10:00:10Z", "main", "@2", "%4", "", "error")
+	require.NoError(t, err)
+	require.NotEqual(t, id, otherPane, "another source is not collapsed")
+
+	otherLevel, err := s.AddNotification("build failed", "2025-01-01T10:00:20Z", "main", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+	require.NotEqual(t, id, otherLevel, "another level is not collapsed")
+	require.Equal(t, 3, s.GetActiveCount())
+}
+
+func TestCollapsedRepeatSkipsAddHooksAndResurfaces(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__COLLAPSE_WINDOW", "1m")
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	for _, point := range []string{"pre-add", "post-add"} {
+		writeHookScript(t, hooksDir, point, "01-log.sh", "#!/bin/sh\necho \"$HOOK_POINT\" >> \"$HOOK_LOG\"\n")
+	}
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("build failed", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NoError(t, s.MarkNotificationRead(id))
+	require.NoError(t, s.SnoozeNotification(id, time.Now().Add(time.Hour)))
+
+	again, err := s.AddNotification("build failed", "2025-01-01T10:00:30Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.Equal(t, id, again)
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pre-add", "post-add"}, strings.Fields(string(content)), "the repeat is not delivered again")
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields := strings.Split(line, "\t")
+	require.Empty(t, fields[9], "a repeat is unread again")
+	require.Empty(t, fields[13], "a repeat wakes a snoozed notification")
+
+	previous, err := s.GetPreviousOccurrence(id)
+	require.NoError(t, err)
+	require.Equal(t, "2025-01-01T10:00:00Z", previous, "the earlier occurrence is the last seen time")
+}
+
+func TestAddKeepsDuplicatesWithoutCollapseWindow(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__COLLAPSE_WINDOW", "")
+	s := newTestStorage(t)
+
+	first, err := s.AddNotification("build failed", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	second, err := s.AddNotification("build failed", "2025-01-01T10:00:00Z", "main", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+	require.Equal(t, 2, s.GetActiveCount())
+}
+
+func assertNotificationState(t *testing.T, s *SQLiteStorage, id, want string) {
+	t.Helper()
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Contains(t, line, "\t"+want+"\t", "notification %s", id)
+}
+
+func TestValidationAndNotFoundErrors(t *testing.T) {
+	s := newTestStorage(t)
+
+	_, err := s.AddNotification("", "", "", "", "", "", "info")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "message cannot be empty")
+
+	_, err = s.ListNotifications("pending", "", "", "", "", "", "", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid state")
+
+	err = s.DismissNotification("999")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrNotificationNotFound))
+
+	err = s.MarkNotificationRead("not-a-number")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInvalidNotificationID))
+}
+
+func TestHooksParityForAddDismissAndCleanup(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("TMUX_INTRAY_HOOKS_FAILURE_MODE", "abort")
+
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID:$DELETED_COUNT\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-add", "01-pre-add.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-add", "01-post-add.sh", scriptBody)
+	writeHookScript(t, hooksDir, "pre-dismiss", "01-pre-dismiss.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-dismiss", "01-post-dismiss.sh", scriptBody)
+	writeHookScript(t, hooksDir, "pre-clear", "01-pre-clear.sh", scriptBody)
+	writeHookScript(t, hooksDir, "cleanup", "01-cleanup.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-cleanup", "01-post-cleanup.sh", scriptBody)
+	t.Setenv("HOOK_LOG", hookLog)
+
+	s := newTestStorage(t)
+
+	id, err := s.AddNotification("old", "2000-01-01T00:00:00Z", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	require.NoError(t, s.CleanupOldNotifications(1, false))
+	_, err = s.AddNotification("new", "", "", "", "", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissAll())
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	logOutput := string(content)
+	require.Contains(t, logOutput, "pre-add:1:")
+	require.Contains(t, logOutput, "post-add:1:")
+	require.Contains(t, logOutput, "pre-dismiss:1:")
+	require.Contains(t, logOutput, "post-dismiss:1:")
+	require.Contains(t, logOutput, "pre-clear::")
+	require.Equal(t, 2, strings.Count(logOutput, "pre-dismiss:"))
+	require.Equal(t, 2, strings.Count(logOutput, "post-dismiss:"))
+	require.Contains(t, logOutput, "cleanup::")
+	require.Contains(t, logOutput, "post-cleanup::1")
+}
+
+func TestSilentAddStoresUnreadWithoutPostAddHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "pre-add", "01-pre-add.sh", scriptBody)
+	writeHookScript(t, hooksDir, "post-add", "01-post-add.sh", scriptBody)
+
+	s := newTestStorage(t)
+	silentID, err := s.AddNotificationSilently("tests passed", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	loudID, err := s.AddNotification("deploy failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	unread, err := s.ListNotifications("active", "", "", "", "", "", "", "unread")
+	require.NoError(t, err)
+	require.Contains(t, unread, "tests passed")
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "pre-add:"+silentID)
+	require.NotContains(t, string(content), "post-add:"+silentID)
+	require.Contains(t, string(content), "post-add:"+loudID)
+}
+
+func TestAddPassesRoutedChannelsToHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	// One fake bridge per channel, each delivering only when routed to.
+	for _, channel := range domain.AllChannels {
+		writeHookScript(t, hooksDir, "post-add", "01-"+channel+".sh",
+			"#!/bin/sh\ncase \",$CHANNELS,\" in *,"+channel+",*) echo \"$MESSAGE:"+channel+"\" >> \"$HOOK_LOG\" ;; esac\n")
+	}
+
+	s := newTestStorage(t)
+	_, err := s.AddNotificationWithOptions("quiet", "", "$1", "@1", "%1", "", "info", domain.AddOptions{Channels: []string{}})
+	require.NoError(t, err)
+	_, err = s.AddNotificationWithOptions("loud", "", "$1", "@1", "%1", "", "error", domain.AddOptions{Channels: []string{"sound", "desktop"}})
+	require.NoError(t, err)
+	_, err = s.AddNotification("unrouted", "", "$1", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	lines := strings.Fields(string(content))
+	sort.Strings(lines)
+	require.Equal(t, []string{
+		"loud:desktop", "loud:sound",
+		"unrouted:desktop", "unrouted:sound", "unrouted:webhook",
+	}, lines)
+}
+
+func TestMutedSessionNotificationIsInsertedRead(t *testing.T) {
+	s := newTestStorage(t)
+	require.NoError(t, s.MuteSession("$1"))
+
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+
+	id, err := s.AddNotification("noisy", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.NotEmpty(t, strings.Split(line, "\t")[9])
+	mockClient.AssertNotCalled(t, "SetStatusOption", "@tmux_intray_pane_1_count", mock.Anything)
+}
+
+func TestMutedSessionNotificationsAreStoredReadWithoutHooks(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("HOOK_LOG", hookLog)
+	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n"
+	writeHookScript(t, hooksDir, "post-add", "01-post-add.sh", scriptBody)
+
+	s := newTestStorage(t)
+	require.NoError(t, s.MuteSession("$1"))
+	require.NoError(t, s.MuteSession("$1"))
+
+	sessions, err := s.ListMutedSessions()
+	require.NoError(t, err)
+	require.Equal(t, []string{"$1"}, sessions)
+
+	mutedID, err := s.AddNotification("noisy", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	otherID, err := s.AddNotification("quiet", "", "$2", "@2", "%2", "", "info")
+	require.NoError(t, err)
+
+	unread, err := s.ListNotifications("active", "", "", "", "", "", "", "unread")
+	require.NoError(t, err)
+	require.NotContains(t, unread, "noisy")
+	require.Contains(t, unread, "quiet")
+
+	all, err := s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Contains(t, all, "noisy")
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "post-add:"+mutedID)
+	require.Contains(t, string(content), "post-add:"+otherID)
+
+	require.NoError(t, s.UnmuteSession("$1"))
+	_, err = s.AddNotification("loud again", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	unread, err = s.ListNotifications("active", "", "", "", "", "", "", "unread")
+	require.NoError(t, err)
+	require.Contains(t, unread, "loud again")
+
+	err = s.UnmuteSession("$1")
+	require.True(t, errors.Is(err, ErrSessionNotMuted))
+	require.Error(t, s.MuteSession(" "))
+}
+
+func TestEscalateStaleOnlyEscalatesOldUnreadActiveCriticals(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookLog := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
+	t.Setenv("TMUX_INTRAY_ESCALATE_AFTER", "10m")
+	t.Setenv("HOOK_LOG", hookLog)
+	writeHookScript(t, hooksDir, "escalate", "01-escalate.sh", "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID\" >> \"$HOOK_LOG\"\n")
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	origNow := nowFunc
+	nowFunc = func() time.Time { return now }
+	t.Cleanup(func() { nowFunc = origNow })
+
+	s := newTestStorage(t)
+	old := "2026-01-02T11:30:00Z"
+	staleID, err := s.AddNotification("stale critical", old, "", "", "", "", "critical")
+	require.NoError(t, err)
+	_, err = s.AddNotification("fresh critical", "2026-01-02T11:55:00Z", "", "", "", "", "critical")
+	require.NoError(t, err)
+	_, err = s.AddNotification("stale error", old, "", "", "", "", "error")
+	require.NoError(t, err)
+	readID, err := s.AddNotification("read critical", old, "", "", "", "", "critical")
+	require.NoError(t, err)
+	require.NoError(t, s.MarkNotificationRead(readID))
+	dismissedID, err := s.AddNotification("dismissed critical", old, "", "", "", "", "critical")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(dismissedID))
+
+	count, err := s.EscalateStale()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.EscalateStale()
+	require.NoError(t, err)
+	require.Equal(t, 0, count, "notifications escalate only once")
+
+	content, err := os.ReadFile(hookLog)
+	require.NoError(t, err)
+	require.Equal(t, "escalate:"+staleID+"\n", string(content))
+}
+
+func TestEscalateStaleDisabledWithoutThreshold(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_ESCALATE_AFTER", "")
+
+	s := newTestStorage(t)
+	_, err := s.AddNotification("stale critical", "2000-01-01T00:00:00Z", "", "", "", "", "critical")
+	require.NoError(t, err)
+
+	count, err := s.EscalateStale()
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestAddNotificationTruncatesAtMaxMessageLength(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_MESSAGE_LENGTH", "5")
+	t.Setenv("TMUX_INTRAY_KEEP_FULL_MESSAGE", "false")
+
+	s := newTestStorage(t)
+	atLimit, err := s.AddNotification("hello", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	overLimit, err := s.AddNotification("hello!", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(atLimit)
+	require.NoError(t, err)
+	require.Equal(t, "hello", strings.Split(line, "\t")[6])
+
+	line, err = s.GetNotificationByID(overLimit)
+	require.NoError(t, err)
+	require.Equal(t, "hello…", strings.Split(line, "\t")[6])
+
+	full, err := s.GetFullMessage(overLimit)
+	require.NoError(t, err)
+	require.Equal(t, "hello…", full, "full copy is only kept when keep_full_message is on")
+}
+
+func TestStorageKeepsOptionsReadAtConstruction(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_MESSAGE_LENGTH", "5")
+	s := newTestStorage(t)
+
+	t.Setenv("TMUX_INTRAY_MAX_MESSAGE_LENGTH", "0")
+	config.Load()
+	id, err := s.AddNotification("hello!", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, "hello…", strings.Split(line, "\t")[6])
+}
+
+func TestAddNotificationKeepsFullMessageWhenTruncated(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_MAX_MESSAGE_LENGTH", "4")
+	t.Setenv("TMUX_INTRAY_KEEP_FULL_MESSAGE", "true")
+
+	s := newTestStorage(t)
+	id, err := s.AddNotification("deploy finished", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, "depl…", strings.Split(line, "\t")[6])
+
+	full, err := s.GetFullMessage(id)
+	require.NoError(t, err)
+	require.Equal(t, "deploy finished", full)
+
+	_, err = s.GetFullMessage("99")
+	require.ErrorIs(t, err, ErrNotificationNotFound)
+}
+
+func TestAddNotificationRecordsPreviousOccurrence(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__CRITERIA", "message")
+
+	s := newTestStorage(t)
+	first, err := s.AddNotification("build failed", "2026-03-10T10:00:00Z", "", "", "", "", "error")
+	require.NoError(t, err)
+	_, err = s.AddNotification("unrelated", "2026-03-10T10:01:00Z", "", "", "", "", "info")
+	require.NoError(t, err)
+	second, err := s.AddNotification("build failed", "2026-03-10T10:05:00Z", "", "", "", "", "error")
+	require.NoError(t, err)
+	third, err := s.AddNotification("build failed", "2026-03-10T10:09:00Z", "", "", "", "", "error")
+	require.NoError(t, err)
+
+	previous, err := s.GetPreviousOccurrence(first)
+	require.NoError(t, err)
+	require.Empty(t, previous, "first occurrence has no previous one")
+
+	previous, err = s.GetPreviousOccurrence(second)
+	require.NoError(t, err)
+	require.Equal(t, "2026-03-10T10:00:00Z", previous)
+
+	previous, err = s.GetPreviousOccurrence(third)
+	require.NoError(t, err)
+	require.Equal(t, "2026-03-10T10:05:00Z", previous, "each repeat links to the one just before it")
+
+	_, err = s.GetPreviousOccurrence("99")
+	require.ErrorIs(t, err, ErrNotificationNotFound)
+}
+
+func TestPreviousOccurrenceFollowsDedupCriteria(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__CRITERIA", "message_level")
+
+	s := newTestStorage(t)
+	_, err := s.AddNotification("disk full", "2026-03-10T10:00:00Z", "", "", "", "", "warning")
+	require.NoError(t, err)
+	_, err = s.AddNotification("disk full", "2026-03-10T10:02:00Z", "", "", "", "", "critical")
+	require.NoError(t, err)
+	repeat, err := s.AddNotification("disk full", "2026-03-10T10:04:00Z", "", "", "", "", "warning")
+	require.NoError(t, err)
+
+	previous, err := s.GetPreviousOccurrence(repeat)
+	require.NoError(t, err)
+	require.Equal(t, "2026-03-10T10:00:00Z", previous, "the critical add has a different level and is skipped")
+}
+
+func TestRecentlyDismissedMatchesDedupKeyWithinWindow(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_DEDUP__CRITERIA", "message_source")
+
+	now := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+	origNow := nowFunc
+	nowFunc = func() time.Time { return now }
+	t.Cleanup(func() { nowFunc = origNow })
+
+	s := newTestStorage(t)
+	id, err := s.AddNotification("backup failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+
+	now = now.Add(30 * time.Second)
+	suppressed, err := s.RecentlyDismissed("backup failed", "$1", "@1", "%1", "error", time.Minute)
+	require.NoError(t, err)
+	require.True(t, suppressed, "re-add within the window matches the dismissal")
+
+	suppressed, err = s.RecentlyDismissed("backup failed", "$2", "@2", "%2", "error", time.Minute)
+	require.NoError(t, err)
+	require.False(t, suppressed, "another source has a different dedup key")
+
+	suppressed, err = s.RecentlyDismissed("backup failed", "$1", "@1", "%1", "error", 0)
+	require.NoError(t, err)
+	require.False(t, suppressed, "a zero window disables suppression")
+
+	now = now.Add(time.Minute)
+	suppressed, err = s.RecentlyDismissed("backup failed", "$1", "@1", "%1", "error", time.Minute)
+	require.NoError(t, err)
+	require.False(t, suppressed, "re-add after the window is accepted")
+}
+
+func TestSetImportantSurvivesStateTransitions(t *testing.T) {
+	s := newTestStorage(t)
+	id, err := s.AddNotification("release tagged", "", "", "", "", "", "info")
+	require.NoError(t, err)
+
+	importantField := func() string {
+		t.Helper()
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		return strings.Split(line, "\t")[10]
+	}
+	require.Empty(t, importantField())
+
+	require.NoError(t, s.SetImportant(id, true))
+	require.Equal(t, "1", importantField())
+
+	require.NoError(t, s.MarkNotificationRead(id))
+	require.NoError(t, s.DismissNotification(id))
+	require.Equal(t, "1", importantField(), "dismiss keeps the flag")
+
+	list, err := s.ListNotifications("dismissed", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(list, "\t1\t\t\t\t\t\t"))
+
+	require.NoError(t, s.UndismissNotification(id))
+	require.Equal(t, "1", importantField())
+
+	require.NoError(t, s.SetImportant(id, false))
+	require.Empty(t, importantField())
+	require.NoError(t, s.SetImportant(id, false), "unflagging twice is a no-op")
+
+	require.ErrorIs(t, s.SetImportant("99", true), ErrNotificationNotFound)
+}
+
+func TestAssignNotificationPersistsOwner(t *testing.T) {
+	s := newTestStorage(t)
+	id, err := s.AddNotification("deploy failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	ownerField := func() string {
+		t.Helper()
+		line, err := s.GetNotificationByID(id)
+		require.NoError(t, err)
+		return strings.Split(line, "\t")[12]
+	}
+	require.Empty(t, ownerField())
+
+	require.NoError(t, s.AssignNotification(id, " alice "))
+	require.Equal(t, "alice", ownerField())
+
+	require.NoError(t, s.AssignNotification(id, "bob"))
+	require.Equal(t, "bob", ownerField(), "reassigning replaces the owner")
+
+	require.NoError(t, s.DismissNotification(id))
+	require.Equal(t, "bob", ownerField(), "dismiss keeps the owner")
+
+	list, err := s.ListNotifications("dismissed", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(list, "\tbob\t\t\t\t"))
+
+	require.NoError(t, s.AssignNotification(id, ""))
+	require.Empty(t, ownerField())
+
+	require.Error(t, s.AssignNotification(id, "ali\tce"))
+	require.ErrorIs(t, s.AssignNotification("99", "alice"), ErrNotificationNotFound)
+}
+
+func TestOversizedMessageIsCappedOnWrite(t *testing.T) {
+	s := newTestStorage(t)
+	huge := strings.Repeat("é", maxFieldLength)
+
+	id, err := s.AddNotification(huge, "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	message := strings.Split(line, "\t")[6]
+	require.LessOrEqual(t, len(message), maxFieldLength)
+	require.True(t, strings.HasSuffix(message, truncationIndicator))
+	require.True(t, strings.HasPrefix(huge, strings.TrimSuffix(message, truncationIndicator)), "the cut keeps whole runes")
+}
+
+func TestOversizedStoredFieldIsCappedOnRead(t *testing.T) {
+	s := newTestStorage(t)
+	id, err := s.AddNotification("placeholder", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	normalID, err := s.AddNotification("disk almost full", "", "$1", "@1", "%1", "", "warning")
+	require.NoError(t, err)
+
+	// Simulate a row written before the write-time cap existed.
+	_, err = s.db.Exec("UPDATE notifications SET message = ?, session = ? WHERE id = ?",
+		strings.Repeat("x", 3*1024*1024), strings.Repeat("s", maxFieldLength+1), id)
+	require.NoError(t, err)
+
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	fields := strings.Split(line, "\t")
+	require.Len(t, fields[6], maxFieldLength)
+	require.True(t, strings.HasSuffix(fields[6], truncationIndicator))
+	require.Len(t, fields[3], maxFieldLength)
+
+	list, err := s.ListNotifications("", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Less(t, len(list), 3*maxFieldLength)
+
+	normal, err := s.GetNotificationByID(normalID)
+	require.NoError(t, err)
+	require.Equal(t, "disk almost full", strings.Split(normal, "\t")[6], "normal fields are unaffected")
+	require.Equal(t, "$1", strings.Split(normal, "\t")[3])
+}
+
+func TestPaneContextRoundTrip(t *testing.T) {
+	s := newTestStorage(t)
+	id, err := s.AddNotification("tests failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	content, err := s.GetPaneContext(id)
+	require.NoError(t, err)
+	require.Empty(t, content, "nothing captured yet")
+
+	require.NoError(t, s.SetPaneContext(id, "--- FAIL: TestAdd\nFAIL"))
+	content, err = s.GetPaneContext(id)
+	require.NoError(t, err)
+	require.Equal(t, "--- FAIL: TestAdd\nFAIL", content)
+
+	require.ErrorIs(t, s.SetPaneContext("99", "x"), ErrNotificationNotFound)
+	_, err = s.GetPaneContext("99")
+	require.ErrorIs(t, err, ErrNotificationNotFound)
+}
+
+func TestPendingActionsQueueInOrder(t *testing.T) {
+	s := newTestStorage(t)
+	first, err := s.AddNotification("build failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	second, err := s.AddNotification("deploy done", "", "$1", "@2", "%2", "", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, s.QueuePendingAction("jump", first))
+	require.NoError(t, s.QueuePendingAction("jump", second))
+	require.ErrorIs(t, s.QueuePendingAction("jump", "99"), ErrNotificationNotFound)
+
+	actions, err := s.ListPendingActions()
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.Equal(t, "jump", actions[0].Action)
+	require.Equal(t, first, actions[0].NotificationID)
+	require.Equal(t, second, actions[1].NotificationID)
+
+	claimed, err := s.ClaimPendingAction(actions[0].ID)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = s.ClaimPendingAction(actions[0].ID)
+	require.NoError(t, err)
+	require.False(t, claimed, "an action is claimed once")
+	actions, err = s.ListPendingActions()
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, second, actions[0].NotificationID)
+}
+
+func TestSessionSnoozeHidesNotificationUntilWoken(t *testing.T) {
+	s := newTestStorage(t)
+	snoozed, err := s.AddNotification("review requested", "", "$2", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = s.AddNotification("build failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	require.NoError(t, s.SnoozeUntilSessionActive(snoozed, "$2"))
+	require.ErrorIs(t, s.SnoozeUntilSessionActive("99", "$2"), ErrNotificationNotFound)
+	require.Error(t, s.SnoozeUntilSessionActive(snoozed, ""))
+
+	list, err := s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.NotContains(t, list, "review requested")
+	require.Contains(t, list, "build failed")
+	require.Equal(t, 1, s.GetActiveCount())
+
+	snoozes, err := s.ListSessionSnoozes()
+	require.NoError(t, err)
+	require.Len(t, snoozes, 1)
+	require.Equal(t, snoozed, snoozes[0].NotificationID)
+	require.Equal(t, "$2", snoozes[0].Session)
+
+	require.NoError(t, s.WakeSessionSnooze(snoozed))
+	list, err = s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Contains(t, list, "review requested")
+	require.Equal(t, 2, s.GetActiveCount())
+	require.NoError(t, s.WakeSessionSnooze(snoozed), "waking twice is a no-op")
+}
+
+func TestSnoozeNotificationHidesActiveUntilTimePasses(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	origNow := nowFunc
+	nowFunc = func() time.Time { return now }
+	t.Cleanup(func() { nowFunc = origNow })
+
+	s := newTestStorage(t)
+	later, err := s.AddNotification("review after lunch", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	_, err = s.AddNotification("build failed", "", "", "", "", "", "error")
+	require.NoError(t, err)
+
+	require.NoError(t, s.SnoozeNotification(later, now.Add(time.Hour)))
+	require.ErrorIs(t, s.SnoozeNotification("99", now.Add(time.Hour)), ErrNotificationNotFound)
+	require.Error(t, s.SnoozeNotification(later, time.Time{}))
+
+	list, err := s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.NotContains(t, list, "review after lunch")
+	require.Contains(t, list, "build failed")
+	page, total, err := s.ListNotificationsPaged(ListFilter{State: "active"}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, 1, total)
+	require.Equal(t, 1, s.GetActiveCount(), "the active count leaves out snoozed notifications")
+	since, err := s.ListSinceID(0, "active")
+	require.NoError(t, err)
+	require.NotContains(t, since, "review after lunch")
+	since, err = s.ListSinceID(0, "all")
+	require.NoError(t, err)
+	require.Contains(t, since, "review after lunch")
+
+	all, err := s.ListNotifications("all", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Contains(t, all, "review after lunch\t\tinfo\t\t\t\t\t2026-01-02T13:00:00Z")
+
+	cleared, err := s.UnsnoozeExpired()
+	require.NoError(t, err)
+	require.Zero(t, cleared, "a snooze still ahead stays")
+
+	now = now.Add(time.Hour)
+	list, err = s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Contains(t, list, "review after lunch", "an expired snooze shows before the sweep")
+	require.Equal(t, 2, s.GetActiveCount())
+
+	cleared, err = s.UnsnoozeExpired()
+	require.NoError(t, err)
+	require.Equal(t, 1, cleared)
+	line, err := s.GetNotificationByID(later)
+	require.NoError(t, err)
+	require.Empty(t, strings.Split(line, "\t")[13])
+}
+
+func TestListRecreatesMissingDatabaseFile(t *testing.T) {
+	s := newTestStorage(t)
+
+	_, err := s.AddNotification("before delete", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(s.dbPath))
+
+	list, err := s.ListNotifications("", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	_, err = os.Stat(s.dbPath)
+	require.NoError(t, err)
+
+	id, err := s.AddNotification("after recreate", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.Equal(t, "1", id)
+	require.Equal(t, 1, s.GetActiveCount())
+}
+
+func TestWritesRecreateMissingDatabaseFile(t *testing.T) {
+	writes := map[string]func(s *SQLiteStorage) error{
+		"add": func(s *SQLiteStorage) error {
+			_, err := s.AddNotification("after delete", "", "", "", "", "", "info")
+			return err
+		},
+		"dismiss all": func(s *SQLiteStorage) error { return s.DismissAll() },
+		"mark all read": func(s *SQLiteStorage) error {
+			_, err := s.MarkAllRead()
+			return err
+		},
+		"mute": func(s *SQLiteStorage) error { return s.MuteSession("$1") },
+		"cleanup": func(s *SQLiteStorage) error {
+			return s.CleanupOldNotifications(0, false)
+		},
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			s := newTestStorage(t)
+			_, err := s.AddNotification("before delete", "", "", "", "", "", "info")
+			require.NoError(t, err)
+			require.NoError(t, os.Remove(s.dbPath))
+
+			require.NoError(t, write(s))
+
+			_, err = os.Stat(s.dbPath)
+			require.NoError(t, err, "the write recreates the database file")
+		})
+	}
+}
+
+func TestTmuxStatusOptionWriteRetriesTransientFailures(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_TMUX_OPTION_RETRIES", "3")
+	t.Setenv("TMUX_INTRAY_TMUX_OPTION_RETRY_INTERVAL", "10ms")
+	s := newTestStorage(t)
+
+	var waits []time.Duration
+	sleepFunc = func(d time.Duration) { waits = append(waits, d) }
+	t.Cleanup(func() { sleepFunc = time.Sleep })
+
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(errors.New("server busy")).Twice()
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(nil).Once()
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+
+	require.NoError(t, s.updateTmuxStatusOption(1))
+
+	mockClient.AssertNumberOfCalls(t, "SetStatusOption", 3)
+	require.Equal(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}, waits)
+}
+
+func TestTmuxStatusOptionWriteGivesUpWithoutError(t *testing.T) {
+	t.Setenv("TMUX_INTRAY_TMUX_OPTION_RETRIES", "2")
+	s := newTestStorage(t)
+
+	sleepFunc = func(time.Duration) {}
+	t.Cleanup(func() { sleepFunc = time.Sleep })
+
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(errors.New("server busy"))
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+
+	require.NoError(t, s.updateTmuxStatusOption(1), "a failed option write is a warning")
+	mockClient.AssertNumberOfCalls(t, "SetStatusOption", 2)
+}
+
+func TestTmuxStatusParityForActiveCountChanges(t *testing.T) {
+	s := newTestStorage(t)
+
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(nil).Once()
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "2").Return(nil).Once()
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(nil).Once()
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "0").Return(nil).Once()
+
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+
+	id1, err := s.AddNotification("n1", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	id2, err := s.AddNotification("n2", "", "", "", "", "", "warning")
+	require.NoError(t, err)
+
+	require.NoError(t, s.DismissNotification(id1))
+	require.NoError(t, s.DismissAll())
+
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "1")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "2")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "1")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+	mockClient.AssertNumberOfCalls(t, "SetStatusOption", 4)
+	mockClient.AssertNumberOfCalls(t, "HasSession", 4)
+
+	line, err := s.GetNotificationByID(id2)
+	require.NoError(t, err)
+	require.Contains(t, line, "\tdismissed\t")
+}
+
+type fakeStatusTimer struct {
+	wait    time.Duration
+	fire    func()
+	stopped bool
+}
+
+func (f *fakeStatusTimer) Stop() bool {
+	f.stopped = true
+	return true
+}
+
+// stubStatusTimers records scheduled zero writes instead of starting real timers.
+func stubStatusTimers(t *testing.T) *[]*fakeStatusTimer {
+	t.Helper()
+	var timers []*fakeStatusTimer
+	afterFunc = func(d time.Duration, f func()) statusTimer {
+		timer := &fakeStatusTimer{wait: d, fire: f}
+		timers = append(timers, timer)
+		return timer
+	}
+	t.Cleanup(func() {
+		afterFunc = func(d time.Duration, f func()) statusTimer { return time.AfterFunc(d, f) }
+		cancelZeroStatus()
+	})
+	return &timers
+}
+
+func newStatusGraceTest(t *testing.T) (*SQLiteStorage, *mockStatusPublisher, *[]*fakeStatusTimer) {
+	t.Helper()
+	t.Setenv("TMUX_INTRAY_STATUS_ZERO_GRACE", "2s")
+	s := newTestStorage(t)
+	timers := stubStatusTimers(t)
+
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
+	mockClient.On("SetStatusOptionAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+	return s, mockClient, timers
+}
+
+func TestStatusZeroWaitsForGracePeriod(t *testing.T) {
+	s, mockClient, timers := newStatusGraceTest(t)
+
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+
+	mockClient.AssertNotCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+	require.Len(t, *timers, 1)
+	require.Equal(t, 2*time.Second, (*timers)[0].wait)
+
+	(*timers)[0].fire()
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+}
+
+func TestStatusZeroIsDroppedWhenAddFollowsWithinGrace(t *testing.T) {
+	s, mockClient, timers := newStatusGraceTest(t)
 
-	id1, err := s.AddNotification("n1", "", "", "", "", "", "info")
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
 	require.NoError(t, err)
-	id2, err := s.AddNotification("n2", "", "", "", "", "", "warning")
+	require.NoError(t, s.DismissNotification(id))
+	_, err = s.AddNotification("n2", "", "", "", "", "", "info")
 	require.NoError(t, err)
 
-	require.Equal(t, 2, s.GetActiveCount())
-	require.NoError(t, s.DismissNotification(id1))
-	require.Equal(t, 1, s.GetActiveCount())
+	require.Len(t, *timers, 1)
+	require.True(t, (*timers)[0].stopped, "the add cancels the pending zero")
+	(*timers)[0].fire()
+	mockClient.AssertNotCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+	mockClient.AssertNumberOfCalls(t, "HasSession", 3)
+}
 
-	err = s.DismissNotification(id1)
-	require.Error(t, err)
-	require.True(t, errors.Is(err, ErrNotificationAlreadyDismissed))
+func TestStatusZeroKeepsFirstDeadlineAcrossDismissals(t *testing.T) {
+	s, _, timers := newStatusGraceTest(t)
 
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
 	require.NoError(t, s.DismissAll())
-	require.Equal(t, 0, s.GetActiveCount())
 
-	line, err := s.GetNotificationByID(id2)
-	require.NoError(t, err)
-	require.Contains(t, line, "\tdismissed\t")
+	require.Len(t, *timers, 1, "a second zero does not restart the grace period")
 }
 
-func TestMarkReadAndUnread(t *testing.T) {
-	s := newTestStorage(t)
+// stubStatusClock fixes nowFunc at the returned time until the test advances it.
+func stubStatusClock(t *testing.T) *time.Time {
+	t.Helper()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := nowFunc
+	nowFunc = func() time.Time { return now }
+	t.Cleanup(func() { nowFunc = origNow })
+	return &now
+}
 
-	id, err := s.AddNotification("n", "", "", "", "", "", "info")
-	require.NoError(t, err)
+func TestFlushStatusHandsPendingZeroToTmux(t *testing.T) {
+	now := stubStatusClock(t)
+	s, mockClient, timers := newStatusGraceTest(t)
 
-	require.NoError(t, s.MarkNotificationRead(id))
-	line, err := s.GetNotificationByID(id)
-	require.NoError(t, err)
-	fields := strings.Split(line, "\t")
-	require.Len(t, fields, 10)
-	require.NotEmpty(t, fields[9])
-	_, err = time.Parse(time.RFC3339, fields[9])
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
 	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	*now = now.Add(500 * time.Millisecond)
 
-	require.NoError(t, s.MarkNotificationUnread(id))
-	line, err = s.GetNotificationByID(id)
-	require.NoError(t, err)
-	fields = strings.Split(line, "\t")
-	require.Empty(t, fields[9])
+	FlushStatus()
+	require.True(t, (*timers)[0].stopped)
+	token := strconv.FormatInt(now.UnixNano(), 10)
+	mockClient.AssertCalled(t, "SetStatusOption", zeroPendingOption, token)
+	mockClient.AssertCalled(t, "SetStatusOptionAfter", 1500*time.Millisecond, "@tmux_intray_active_count", "0", zeroPendingOption, token)
+	mockClient.AssertNotCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+
+	FlushStatus()
+	mockClient.AssertNumberOfCalls(t, "SetStatusOptionAfter", 1)
 }
 
-func TestCleanupOldNotifications(t *testing.T) {
-	s := newTestStorage(t)
+func TestFlushStatusWritesZeroWhenGraceHasPassed(t *testing.T) {
+	now := stubStatusClock(t)
+	s, mockClient, _ := newStatusGraceTest(t)
 
-	idOld, err := s.AddNotification("old", "2000-01-01T00:00:00Z", "", "", "", "", "info")
-	require.NoError(t, err)
-	idNew, err := s.AddNotification("new", "2099-01-01T00:00:00Z", "", "", "", "", "info")
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
 	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
+	*now = now.Add(3 * time.Second)
 
-	require.NoError(t, s.DismissNotification(idOld))
-	require.NoError(t, s.DismissNotification(idNew))
+	FlushStatus()
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+	mockClient.AssertNotCalled(t, "SetStatusOptionAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
 
-	require.NoError(t, s.CleanupOldNotifications(1, true))
-	_, err = s.GetNotificationByID(idOld)
+func TestFlushStatusWritesZeroWhenTmuxCannotSchedule(t *testing.T) {
+	stubStatusClock(t)
+	t.Setenv("TMUX_INTRAY_STATUS_ZERO_GRACE", "2s")
+	s := newTestStorage(t)
+	stubStatusTimers(t)
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
+	mockClient.On("SetStatusOptionAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("run-shell failed"))
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() { SetTmuxClient(noopStatusPublisher{}) })
+
+	id, err := s.AddNotification("n1", "", "", "", "", "", "info")
 	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(id))
 
-	require.NoError(t, s.CleanupOldNotifications(1, false))
-	_, err = s.GetNotificationByID(idOld)
-	require.Error(t, err)
-	require.True(t, errors.Is(err, ErrNotificationNotFound))
+	FlushStatus()
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
+}
 
-	_, err = s.GetNotificationByID(idNew)
+func TestStatusCountCancelsZeroLeftForTmux(t *testing.T) {
+	s, mockClient, _ := newStatusGraceTest(t)
+
+	_, err := s.AddNotification("n1", "", "", "", "", "", "info")
 	require.NoError(t, err)
+
+	var calls []string
+	for _, call := range mockClient.Calls {
+		if call.Method == "HasSession" {
+			continue
+		}
+		calls = append(calls, call.Method+" "+call.Arguments.String(0))
+	}
+	require.GreaterOrEqual(t, len(calls), 2)
+	require.Equal(t, []string{
+		"UnsetStatusOption " + zeroPendingOption,
+		"SetStatusOption @tmux_intray_active_count",
+	}, calls[:2], "the pending zero is dropped before the count is written")
 }
 
-func TestValidationAndNotFoundErrors(t *testing.T) {
+func TestPaneBadgesTrackUnreadCountsPerPane(t *testing.T) {
 	s := newTestStorage(t)
 
-	_, err := s.AddNotification("", "", "", "", "", "", "info")
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "message cannot be empty")
+	mockClient := new(mockStatusPublisher)
+	mockClient.On("HasSession").Return(true, nil)
+	mockClient.On("SetStatusOption", "@tmux_intray_active_count", mock.Anything).Return(nil)
+	mockClient.On("SetStatusOption", mock.MatchedBy(func(name string) bool {
+		return strings.HasPrefix(name, "@tmux_intray_pane_")
+	}), mock.Anything).Return(nil)
+	mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
 
-	_, err = s.ListNotifications("pending", "", "", "", "", "", "", "")
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "invalid state")
+	SetTmuxClient(mockClient)
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
 
-	err = s.DismissNotification("999")
-	require.Error(t, err)
-	require.True(t, errors.Is(err, ErrNotificationNotFound))
+	id1, err := s.AddNotification("a", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = s.AddNotification("b", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	id3, err := s.AddNotification("c", "", "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	_, err = s.AddNotification("no pane", "", "", "", "", "", "info")
+	require.NoError(t, err)
 
-	err = s.MarkNotificationRead("not-a-number")
-	require.Error(t, err)
-	require.True(t, errors.Is(err, ErrInvalidNotificationID))
-}
+	require.NoError(t, s.MarkNotificationRead(id3))
+	require.NoError(t, s.DismissNotification(id1))
 
-func TestHooksParityForAddDismissAndCleanup(t *testing.T) {
-	hooksDir := filepath.Join(t.TempDir(), "hooks")
-	hookLog := filepath.Join(t.TempDir(), "hooks.log")
-	t.Setenv("TMUX_INTRAY_HOOKS_DIR", hooksDir)
-	t.Setenv("TMUX_INTRAY_HOOKS_FAILURE_MODE", "abort")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_pane_1_count", "1")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_pane_1_count", "2")
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_pane_2_count", "1")
+	mockClient.AssertCalled(t, "UnsetStatusOption", "@tmux_intray_pane_2_count")
+	mockClient.AssertNumberOfCalls(t, "UnsetStatusOption", 1)
 
-	scriptBody := "#!/bin/sh\necho \"$HOOK_POINT:$NOTIFICATION_ID:$DELETED_COUNT\" >> \"$HOOK_LOG\"\n"
-	writeHookScript(t, hooksDir, "pre-add", "01-pre-add.sh", scriptBody)
-	writeHookScript(t, hooksDir, "post-add", "01-post-add.sh", scriptBody)
-	writeHookScript(t, hooksDir, "pre-dismiss", "01-pre-dismiss.sh", scriptBody)
-	writeHookScript(t, hooksDir, "post-dismiss", "01-post-dismiss.sh", scriptBody)
-	writeHookScript(t, hooksDir, "pre-clear", "01-pre-clear.sh", scriptBody)
-	writeHookScript(t, hooksDir, "cleanup", "01-cleanup.sh", scriptBody)
-	writeHookScript(t, hooksDir, "post-cleanup", "01-post-cleanup.sh", scriptBody)
-	t.Setenv("HOOK_LOG", hookLog)
+	var paneSets []string
+	for _, call := range mockClient.Calls {
+		if call.Method == "SetStatusOption" && strings.HasPrefix(call.Arguments.String(0), "@tmux_intray_pane_") {
+			paneSets = append(paneSets, call.Arguments.String(0)+"="+call.Arguments.String(1))
+		}
+	}
+	require.Equal(t, []string{
+		"@tmux_intray_pane_1_count=1",
+		"@tmux_intray_pane_1_count=2",
+		"@tmux_intray_pane_2_count=1",
+		"@tmux_intray_pane_1_count=1",
+	}, paneSets, "unchanged counts are not republished")
+
+	require.NoError(t, s.MarkNotificationUnread(id3))
+	last := mockClient.Calls[len(mockClient.Calls)-1]
+	require.Equal(t, "SetStatusOption", last.Method)
+	require.Equal(t, []interface{}{"@tmux_intray_pane_2_count", "1"}, []interface{}(last.Arguments))
+
+	require.NoError(t, s.SnoozeNotification(id3, time.Now().Add(time.Hour)))
+	mockClient.AssertCalled(t, "UnsetStatusOption", "@tmux_intray_pane_2_count")
+	mockClient.AssertNumberOfCalls(t, "UnsetStatusOption", 2)
+}
 
+func TestPaneBadgesRepublishAfterTmuxServerRestart(t *testing.T) {
 	s := newTestStorage(t)
 
-	id, err := s.AddNotification("old", "2000-01-01T00:00:00Z", "", "", "", "", "info")
+	newServer := func() *mockStatusPublisher {
+		mockClient := new(mockStatusPublisher)
+		mockClient.On("HasSession").Return(true, nil)
+		mockClient.On("SetStatusOption", mock.Anything, mock.Anything).Return(nil)
+		mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
+		SetTmuxClient(mockClient)
+		return mockClient
+	}
+	t.Cleanup(func() {
+		SetTmuxClient(noopStatusPublisher{})
+	})
+
+	newServer()
+	_, err := s.AddNotification("a", "", "$1", "@1", "%1", "", "info")
 	require.NoError(t, err)
-	require.NoError(t, s.DismissNotification(id))
-	require.NoError(t, s.CleanupOldNotifications(1, false))
-	_, err = s.AddNotification("new", "", "", "", "", "", "warning")
+	id, err := s.AddNotification("b", "", "$1", "@1", "%2", "", "info")
 	require.NoError(t, err)
-	require.NoError(t, s.DismissAll())
 
-	content, err := os.ReadFile(hookLog)
-	require.NoError(t, err)
-	logOutput := string(content)
-	require.Contains(t, logOutput, "pre-add:1:")
-	require.Contains(t, logOutput, "post-add:1:")
-	require.Contains(t, logOutput, "pre-dismiss:1:")
-	require.Contains(t, logOutput, "post-dismiss:1:")
-	require.Contains(t, logOutput, "pre-clear::")
-	require.Equal(t, 2, strings.Count(logOutput, "pre-dismiss:"))
-	require.Equal(t, 2, strings.Count(logOutput, "post-dismiss:"))
-	require.Contains(t, logOutput, "cleanup::")
-	require.Contains(t, logOutput, "post-cleanup::1")
+	// A new server starts without any of the options published so far.
+	restarted := newServer()
+	require.NoError(t, s.MarkNotificationRead(id))
+
+	restarted.AssertCalled(t, "SetStatusOption", "@tmux_intray_pane_1_count", "1")
+	restarted.AssertNotCalled(t, "UnsetStatusOption", "@tmux_intray_pane_2_count")
 }
 
-func TestTmuxStatusParityForActiveCountChanges(t *testing.T) {
+func TestPaneBadgesLeaveOutSessionSnoozedNotifications(t *testing.T) {
 	s := newTestStorage(t)
 
 	mockClient := new(mockStatusPublisher)
 	mockClient.On("HasSession").Return(true, nil)
-	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(nil).Once()
-	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "2").Return(nil).Once()
-	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "1").Return(nil).Once()
-	mockClient.On("SetStatusOption", "@tmux_intray_active_count", "0").Return(nil).Once()
+	mockClient.On("SetStatusOption", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("UnsetStatusOption", mock.Anything).Return(nil)
 
 	SetTmuxClient(mockClient)
 	t.Cleanup(func() {
 		SetTmuxClient(noopStatusPublisher{})
 	})
 
-	id1, err := s.AddNotification("n1", "", "", "", "", "", "info")
-	require.NoError(t, err)
-	id2, err := s.AddNotification("n2", "", "", "", "", "", "warning")
+	id, err := s.AddNotification("review requested", "", "$2", "@1", "%3", "", "info")
 	require.NoError(t, err)
+	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_pane_3_count", "1")
 
-	require.NoError(t, s.DismissNotification(id1))
-	require.NoError(t, s.DismissAll())
-
-	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "1")
-	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "2")
-	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "1")
-	mockClient.AssertCalled(t, "SetStatusOption", "@tmux_intray_active_count", "0")
-	mockClient.AssertNumberOfCalls(t, "SetStatusOption", 4)
-	mockClient.AssertNumberOfCalls(t, "HasSession", 4)
+	require.NoError(t, s.SnoozeUntilSessionActive(id, "$2"))
+	mockClient.AssertCalled(t, "UnsetStatusOption", "@tmux_intray_pane_3_count")
 
-	line, err := s.GetNotificationByID(id2)
-	require.NoError(t, err)
-	require.Contains(t, line, "\tdismissed\t")
+	require.NoError(t, s.WakeSessionSnooze(id))
+	mockClient.AssertNumberOfCalls(t, "UnsetStatusOption", 1)
+	last := mockClient.Calls[len(mockClient.Calls)-1]
+	require.Equal(t, []interface{}{"@tmux_intray_pane_3_count", "1"}, []interface{}(last.Arguments))
 }
 
 func TestDismissByFilter(t *testing.T) {
@@ -450,3 +2108,202 @@ func TestDismissByFilterRunsHooks(t *testing.T) {
 	require.Contains(t, logOutput, "pre-dismiss:"+id2)
 	require.Contains(t, logOutput, "post-dismiss:"+id2)
 }
+
+// addWithOptions adds a notification with opts and returns its ID.
+func addWithOptions(s *SQLiteStorage, message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (string, error) {
+	result, err := s.AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level, opts)
+	return result.ID, err
+}
+
+func TestAddNotificationWithOptionsStoresColorField(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := addWithOptions(s, "deploy blocked", "", "$1", "@1", "%1", "", "warning", domain.AddOptions{Color: "#ff8800"})
+	require.NoError(t, err)
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, "#ff8800", strings.Split(line, "\t")[11])
+
+	plain, err := s.AddNotification("tests passed", "", "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	line, err = s.GetNotificationByID(plain)
+	require.NoError(t, err)
+	require.Empty(t, strings.Split(line, "\t")[11])
+
+	_, err = addWithOptions(s, "bad color", "", "$1", "@1", "%1", "", "info", domain.AddOptions{Color: "orange"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid color 'orange'")
+	list, err := s.ListNotifications("all", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.NotContains(t, list, "bad color")
+}
+
+func TestAddNotificationWithOptionsStoresPriorityField(t *testing.T) {
+	s := newTestStorage(t)
+
+	id, err := addWithOptions(s, "deploy failed", "", "$1", "@1", "%1", "", "error", domain.AddOptions{Priority: 10})
+	require.NoError(t, err)
+	line, err := s.GetNotificationByID(id)
+	require.NoError(t, err)
+	require.Equal(t, "10", strings.Split(line, "\t")[14])
+
+	plain, err := s.AddNotification("lint failed", "", "$1", "@1", "%2", "", "error")
+	require.NoError(t, err)
+	line, err = s.GetNotificationByID(plain)
+	require.NoError(t, err)
+	require.Empty(t, strings.Split(line, "\t")[14], "the default priority leaves the field empty")
+
+	list, err := s.ListNotifications("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	lines := strings.Split(list, "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "10", strings.Split(lines[0], "\t")[14])
+	require.Empty(t, strings.Split(lines[1], "\t")[14])
+}
+
+func TestAddNotificationWithOptionsFiltersByTag(t *testing.T) {
+	s := newTestStorage(t)
+
+	deploy, err := addWithOptions(s, "deploy failed", "", "$1", "@1", "%1", "", "error", domain.AddOptions{Tags: []string{"Deploy", "prod", "deploy"}})
+	require.NoError(t, err)
+	line, err := s.GetNotificationByID(deploy)
+	require.NoError(t, err)
+	require.Equal(t, "deploy,prod", strings.Split(line, "\t")[15])
+
+	ci, err := addWithOptions(s, "tests failed", "", "$1", "@1", "%2", "", "error", domain.AddOptions{Tags: []string{"ci"}})
+	require.NoError(t, err)
+	plain, err := s.AddNotification("lint failed", "", "$1", "@1", "%3", "", "error")
+	require.NoError(t, err)
+	line, err = s.GetNotificationByID(plain)
+	require.NoError(t, err)
+	require.Empty(t, strings.Split(line, "\t")[15], "untagged notifications leave the field empty")
+
+	ids := func(list string) []string {
+		t.Helper()
+		var ids []string
+		for _, line := range strings.Split(list, "\n") {
+			if line != "" {
+				ids = append(ids, strings.Split(line, "\t")[0])
+			}
+		}
+		return ids
+	}
+	list, err := s.ListWithFilter(ListFilter{State: "active", Tags: "deploy"})
+	require.NoError(t, err)
+	require.Equal(t, []string{deploy}, ids(list))
+
+	list, err = s.ListWithFilter(ListFilter{State: "active", Tags: "CI, prod"})
+	require.NoError(t, err)
+	require.Equal(t, []string{deploy, ci}, ids(list), "any listed tag matches")
+
+	list, err = s.ListWithFilter(ListFilter{State: "active"})
+	require.NoError(t, err)
+	require.Len(t, ids(list), 3)
+
+	_, err = addWithOptions(s, "bad", "", "", "", "", "", "info", domain.AddOptions{Tags: []string{"two words"}})
+	require.Error(t, err)
+	_, err = s.ListWithFilter(ListFilter{State: "active", Tags: "two words"})
+	require.Error(t, err)
+}
+
+func TestListNotificationsPagedFiltersByTag(t *testing.T) {
+	s := newTestStorage(t)
+
+	deploy, err := addWithOptions(s, "deploy failed", "", "$1", "@1", "%1", "", "info", domain.AddOptions{Tags: []string{"deploy"}})
+	require.NoError(t, err)
+	_, err = addWithOptions(s, "tests failed", "", "$1", "@1", "%2", "", "info", domain.AddOptions{Tags: []string{"ci"}})
+	require.NoError(t, err)
+	_, err = s.AddNotification("lint failed", "", "$1", "@1", "%3", "", "info")
+	require.NoError(t, err)
+
+	page, total, err := s.ListNotificationsPaged(ListFilter{State: "active", Tags: "Deploy"}, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Len(t, page, 1)
+	require.Equal(t, deploy, strings.Split(page[0], "\t")[0])
+
+	_, _, err = s.ListNotificationsPaged(ListFilter{Tags: "two words"}, 0, 0)
+	require.Error(t, err)
+}
+
+func TestRelevelByFilterFiltersByTag(t *testing.T) {
+	s := newTestStorage(t)
+
+	deploy, err := addWithOptions(s, "deploy failed", "", "$1", "@1", "%1", "", "info", domain.AddOptions{Tags: []string{"deploy"}})
+	require.NoError(t, err)
+	_, err = addWithOptions(s, "tests failed", "", "$1", "@1", "%2", "", "info", domain.AddOptions{Tags: []string{"ci"}})
+	require.NoError(t, err)
+
+	changed, err := s.RelevelByFilter(ListFilter{Tags: "deploy"}, "error")
+	require.NoError(t, err)
+	require.Equal(t, 1, changed)
+	list, err := s.ListWithFilter(ListFilter{Level: "error"})
+	require.NoError(t, err)
+	require.Equal(t, deploy, strings.Split(list, "\t")[0])
+
+	_, err = s.RelevelByFilter(ListFilter{Tags: "two words"}, "error")
+	require.Error(t, err)
+}
+
+func TestCountByLevelAndSession(t *testing.T) {
+	s := newTestStorage(t)
+
+	_, err := s.AddNotification("build failed", "", "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	_, err = s.AddNotification("lint failed", "", "$1", "@1", "%2", "", "error")
+	require.NoError(t, err)
+	_, err = s.AddNotification("deploy done", "", "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+	dismissed, err := s.AddNotification("disk full", "", "$2", "@2", "%4", "", "critical")
+	require.NoError(t, err)
+	require.NoError(t, s.DismissNotification(dismissed))
+	snoozed, err := s.AddNotification("tests flaky", "", "$3", "@3", "%5", "", "warning")
+	require.NoError(t, err)
+	require.NoError(t, s.SnoozeNotification(snoozed, time.Now().Add(time.Hour)))
+
+	byLevel, err := s.CountByLevel("active")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"error": 2, "info": 1}, byLevel, "levels without active notifications are left out")
+
+	byLevel, err = s.CountByLevel("all")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"error": 2, "info": 1, "critical": 1, "warning": 1}, byLevel)
+
+	bySession, err := s.CountBySession("active")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"$1": 2, "$2": 1}, bySession)
+
+	bySession, err = s.CountBySession("dismissed")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"$2": 1}, bySession)
+
+	_, err = s.CountByLevel("bogus")
+	require.Error(t, err)
+	_, err = s.CountBySession("bogus")
+	require.Error(t, err)
+}
+
+func TestCompactReclaimsDeletedNotificationPages(t *testing.T) {
+	s := newTestStorage(t)
+	message := strings.Repeat("build output ", 200)
+	for i := 0; i < 50; i++ {
+		_, err := s.AddNotification(message, "", "", "", "", "", "info")
+		require.NoError(t, err)
+	}
+	kept, err := s.AddNotification("deploy done", "", "", "", "", "", "info")
+	require.NoError(t, err)
+	_, err = s.db.Exec("DELETE FROM notifications WHERE id != ?", kept)
+	require.NoError(t, err)
+
+	var freePages int
+	require.NoError(t, s.db.QueryRow("PRAGMA freelist_count").Scan(&freePages))
+	require.Positive(t, freePages)
+
+	require.NoError(t, s.Compact())
+
+	require.NoError(t, s.db.QueryRow("PRAGMA freelist_count").Scan(&freePages))
+	require.Zero(t, freePages)
+	list, err := s.ListNotifications("", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Contains(t, list, "deploy done")
+}
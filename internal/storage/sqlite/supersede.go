@@ -0,0 +1,45 @@
+// File: supersede.go
+// Purpose: Dismisses lower-level notifications for a pane when a higher level
+// arrives for it, when auto_dismiss_lower_levels is enabled.
+package sqlite
+
+// levelRanks orders the notification levels from least to most severe.
+var levelRanks = map[string]int{
+	"info":     0,
+	"warning":  1,
+	"error":    2,
+	"critical": 3,
+}
+
+// dismissLowerLevels dismisses the active notifications for pane whose level
+// is strictly lower than level, running the usual dismiss hooks for each. It
+// returns how many were dismissed. It does nothing unless
+// auto_dismiss_lower_levels is enabled, or when pane is empty.
+func (s *SQLiteStorage) dismissLowerLevels(pane, level string) (int, error) {
+	if pane == "" {
+		return 0, nil
+	}
+	if !s.options.AutoDismissLowerLevels {
+		return 0, nil
+	}
+	rank := levelRanks[level]
+
+	active, err := s.listActiveNotificationsByFilter("", "", pane)
+	if err != nil {
+		return 0, err
+	}
+	dismissed := 0
+	for _, notification := range active {
+		if levelRanks[notification.level] >= rank {
+			continue
+		}
+		changed, err := s.dismissSingleNotification(notification)
+		if err != nil {
+			return dismissed, err
+		}
+		if changed {
+			dismissed++
+		}
+	}
+	return dismissed, nil
+}
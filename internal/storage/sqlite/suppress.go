@@ -0,0 +1,52 @@
+// File: suppress.go
+// Purpose: Finds recent dismissals of a notification so a source that re-adds
+// it right away can be ignored.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/dedup"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// RecentlyDismissed reports whether a notification sharing the dedup key of
+// the given fields, as configured by dedup.criteria, was dismissed within the
+// last within. A non-positive within never matches.
+func (s *SQLiteStorage) RecentlyDismissed(message, session, window, pane, level string, within time.Duration) (bool, error) {
+	if within <= 0 {
+		return false, nil
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return false, err
+	}
+
+	since := nowFunc().Add(-within).UTC().Format("2006-01-02T15:04:05Z")
+	candidates, err := s.queries.ListDismissedSince(context.Background(), sqlcgen.ListDismissedSinceParams{
+		Message:   message,
+		UpdatedAt: since,
+	})
+	if err != nil {
+		return false, fmt.Errorf("sqlite storage: find recent dismissals: %w", err)
+	}
+
+	criteria := s.options.Dedup
+	current := dedup.Record{Message: message, Level: level, Session: session, Window: window, Pane: pane, State: "dismissed"}
+	for _, candidate := range candidates {
+		dismissed := dedup.Record{
+			Message: message,
+			Level:   candidate.Level,
+			Session: candidate.Session,
+			Window:  candidate.Window,
+			Pane:    candidate.Pane,
+			State:   "dismissed",
+		}
+		keys := dedup.BuildKeys([]dedup.Record{current, dismissed}, criteria)
+		if keys[0] == keys[1] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,39 @@
+// File: tags.go
+// Purpose: Stores free-form per-notification tags and normalizes tag filters
+// for listing.
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// recordTags stores the tags for a notification. No tags stores nothing.
+func recordTags(ctx context.Context, q *sqlcgen.Queries, id int64, tags []string) error {
+	for _, tag := range tags {
+		if err := q.InsertNotificationTag(ctx, sqlcgen.InsertNotificationTagParams{
+			NotificationID: id,
+			Tag:            tag,
+		}); err != nil {
+			return fmt.Errorf("sqlite storage: record tag: %w", err)
+		}
+	}
+	return nil
+}
+
+// normalizeTagFilter normalizes a comma-separated tag filter the way tags are
+// stored, so "Deploy, CI" matches notifications tagged deploy or ci.
+func normalizeTagFilter(tagFilter string) (string, error) {
+	if tagFilter == "" {
+		return "", nil
+	}
+	tags, err := domain.NormalizeTags(strings.Split(tagFilter, ","))
+	if err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
+	}
+	return strings.Join(tags, ","), nil
+}
@@ -5,6 +5,7 @@ package sqlite
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/ports"
@@ -20,6 +21,18 @@ func (noopStatusPublisher) SetStatusOption(name, value string) error {
 	return nil
 }
 
+func (noopStatusPublisher) UnsetStatusOption(name string) error {
+	return nil
+}
+
+func (noopStatusPublisher) SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error {
+	return nil
+}
+
+func (noopStatusPublisher) ListStatusOptions(prefix string) (map[string]string, error) {
+	return nil, nil
+}
+
 var tmuxClient ports.StatusPublisher = noopStatusPublisher{}
 
 // SetTmuxClient sets the tmux client used for status updates.
@@ -33,6 +46,10 @@ func SetTmuxClient(client ports.StatusPublisher) {
 func (s *SQLiteStorage) syncTmuxStatusOption() {
 	if err := s.updateTmuxStatusOption(s.GetActiveCount()); err != nil {
 		colors.Error(fmt.Sprintf("failed to update tmux status: %v", err))
+		return
+	}
+	if err := s.updatePaneBadges(); err != nil {
+		colors.Error(fmt.Sprintf("failed to update tmux pane badges: %v", err))
 	}
 }
 
@@ -44,8 +61,49 @@ func (s *SQLiteStorage) updateTmuxStatusOption(count int) error {
 	if !running {
 		return fmt.Errorf("updateTmuxStatusOption: tmux not running")
 	}
-	if err := tmuxClient.SetStatusOption("@tmux_intray_active_count", fmt.Sprintf("%d", count)); err != nil {
-		return fmt.Errorf("updateTmuxStatusOption: failed to set @tmux_intray_active_count to %d: %w", count, err)
+	if count == 0 {
+		if grace := s.options.StatusZeroGrace; grace > 0 {
+			s.deferZeroStatus(grace)
+			return nil
+		}
+	}
+	cancelZeroStatus()
+	if count > 0 && s.options.StatusZeroGrace > 0 {
+		// Drops a zero an exited process left for tmux to write, before the
+		// new count goes in, so that zero cannot land after it.
+		if err := tmuxClient.UnsetStatusOption(zeroPendingOption); err != nil {
+			colors.Warning(fmt.Sprintf("failed to unset %s: %v", zeroPendingOption, err))
+		}
+	}
+	if err := s.setStatusOptionWithRetry("@tmux_intray_active_count", fmt.Sprintf("%d", count)); err != nil {
+		// The next write syncs the count again, so a busy tmux server is not fatal.
+		colors.Warning(fmt.Sprintf("failed to set @tmux_intray_active_count to %d: %v", count, err))
 	}
 	return nil
 }
+
+// sleepFunc waits between tmux option write attempts; tests replace it.
+var sleepFunc = time.Sleep
+
+// setStatusOptionWithRetry writes a tmux option, retrying transient failures.
+// TmuxOptionRetries sets the number of attempts, and the wait between them
+// starts at TmuxOptionRetryInterval and doubles after each failure.
+func (s *SQLiteStorage) setStatusOptionWithRetry(name, value string) error {
+	attempts := s.options.TmuxOptionRetries
+	if attempts < 1 {
+		attempts = 1
+	}
+	wait := s.options.TmuxOptionRetryInterval
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = tmuxClient.SetStatusOption(name, value); err == nil {
+			return nil
+		}
+		if attempt < attempts {
+			sleepFunc(wait)
+			wait *= 2
+		}
+	}
+	return fmt.Errorf("%d attempts: %w", attempts, err)
+}
@@ -0,0 +1,71 @@
+// File: truncate.go
+// Purpose: Caps stored message length and keeps optional full copies of
+// truncated messages.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// truncationIndicator is appended to messages cut at max_message_length.
+const truncationIndicator = "…"
+
+// truncateMessage shortens message to maxLength runes followed by the truncation
+// indicator. Messages within the limit, or any message when maxLength <= 0, are
+// returned unchanged.
+func truncateMessage(message string, maxLength int) (string, bool) {
+	if maxLength <= 0 {
+		return message, false
+	}
+	runes := []rune(message)
+	if len(runes) <= maxLength {
+		return message, false
+	}
+	return string(runes[:maxLength]) + truncationIndicator, true
+}
+
+// recordFullMessage keeps the untruncated message for a notification.
+func recordFullMessage(ctx context.Context, q *sqlcgen.Queries, id int64, message string) error {
+	if err := q.InsertMessageOriginal(ctx, sqlcgen.InsertMessageOriginalParams{
+		NotificationID: id,
+		Message:        message,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: record full message: %w", err)
+	}
+	return nil
+}
+
+// GetFullMessage returns the complete message for a notification. When the stored
+// message was truncated and keep_full_message was enabled at add time, the
+// preserved original is returned; otherwise the stored message is returned.
+func (s *SQLiteStorage) GetFullMessage(id string) (string, error) {
+	idInt, err := parseID(id)
+	if err != nil {
+		return "", err
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return "", err
+	}
+
+	original, err := s.queries.GetMessageOriginal(context.Background(), idInt)
+	if err == nil {
+		return original, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("sqlite storage: get full message: %w", err)
+	}
+
+	row, err := s.queries.GetNotificationLineByID(context.Background(), idInt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("sqlite storage: get full message: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return "", fmt.Errorf("sqlite storage: get full message: %w", err)
+	}
+	return row.Message, nil
+}
@@ -0,0 +1,83 @@
+// File: update.go
+// Purpose: Replaces the message of an existing notification so a long-running
+// job can keep one tray entry current instead of adding new ones.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cristianoliveira/tmux-intray/internal/hooks"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite/sqlcgen"
+)
+
+// UpdateNotificationMessage replaces the message of a notification, keeping
+// its timestamp, state and every other field. The new message is capped at
+// max_message_length like an added one. The pre-update and post-update hooks
+// run around the change and also receive PREVIOUS_MESSAGE.
+func (s *SQLiteStorage) UpdateNotificationMessage(id, message string) error {
+	idInt, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(message) == "" {
+		return fmt.Errorf("validation error: message cannot be empty")
+	}
+	if err := s.ensureDatabaseFile(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	row, err := s.queries.GetNotificationForHooksByID(ctx, idInt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("sqlite storage: update notification message: %w: id %s", ErrNotificationNotFound, id)
+		}
+		return fmt.Errorf("sqlite storage: update notification message: %w", err)
+	}
+
+	message = capMessageForWrite(message)
+	maxLength, keepFull := s.options.MaxMessageLength, s.options.KeepFullMessage
+	fullMessage := message
+	message, truncated := truncateMessage(message, maxLength)
+
+	envVars := buildNotificationHookEnv(row.ID, row.Level, message, escapeMessage(message), row.Timestamp, row.Session, row.Window, row.Pane, row.PaneCreated)
+	envVars = append(envVars, fmt.Sprintf("PREVIOUS_MESSAGE=%s", row.Message))
+	if err := hooks.Run("pre-update", envVars...); err != nil {
+		return fmt.Errorf("pre-update hook aborted: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqlite storage: begin update notification message: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := s.queries.WithTx(tx)
+	if err := q.UpdateNotificationMessage(ctx, sqlcgen.UpdateNotificationMessageParams{
+		Message:   message,
+		UpdatedAt: utcNow(),
+		ID:        idInt,
+	}); err != nil {
+		return fmt.Errorf("sqlite storage: update notification message: %w", err)
+	}
+	// A full copy kept for the old message would otherwise outlive it.
+	if truncated && keepFull {
+		err = q.InsertMessageOriginal(ctx, sqlcgen.InsertMessageOriginalParams{NotificationID: idInt, Message: fullMessage})
+	} else {
+		err = q.DeleteMessageOriginal(ctx, idInt)
+	}
+	if err != nil {
+		return fmt.Errorf("sqlite storage: update notification message: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite storage: commit update notification message: %w", err)
+	}
+
+	if err := hooks.Run("post-update", envVars...); err != nil {
+		return fmt.Errorf("post-update hook failed: %w", err)
+	}
+	return nil
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/cristianoliveira/tmux-intray/internal/config"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/notification"
+	"github.com/cristianoliveira/tmux-intray/internal/storage/sqlite"
 )
 
 var (
@@ -18,7 +21,6 @@ var (
 // getDefaultStorage returns the default storage instance, initializing it if necessary.
 func getDefaultStorage() (Storage, error) {
 	defaultOnce.Do(func() {
-		config.Load()
 		defaultStorage, defaultErr = NewFromConfig()
 	})
 	return defaultStorage, defaultErr
@@ -33,6 +35,48 @@ func AddNotification(message, timestamp, session, window, pane, paneCreated, lev
 	return store.AddNotification(message, timestamp, session, window, pane, paneCreated, level)
 }
 
+// optionsAddStore is implemented by storage backends that can store a color,
+// priority and tags with a notification.
+type optionsAddStore interface {
+	AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (domain.AddNotificationResult, error)
+}
+
+// AddNotificationWithOptions adds a notification with the color, priority and
+// tags in opts using the default storage backend, and reports the
+// notifications dismissed to stay within max_active.
+func AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level string, opts domain.AddOptions) (domain.AddNotificationResult, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("failed to get storage: %w", err)
+	}
+	optionsStore, ok := store.(optionsAddStore)
+	if !ok {
+		return domain.AddNotificationResult{}, fmt.Errorf("add notification: storage does not support add options")
+	}
+	return optionsStore.AddNotificationWithOptions(message, timestamp, session, window, pane, paneCreated, level, opts)
+}
+
+// resultAddStore is implemented by storage backends that report the
+// notifications evicted by the max_active cap.
+type resultAddStore interface {
+	AddNotificationWithResult(message, timestamp, session, window, pane, paneCreated, level string) (domain.AddNotificationResult, error)
+}
+
+// AddNotificationWithResult adds a notification using the default storage
+// backend and reports the IDs of the oldest active notifications dismissed to
+// stay within max_active.
+func AddNotificationWithResult(message, timestamp, session, window, pane, paneCreated, level string) (domain.AddNotificationResult, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return domain.AddNotificationResult{}, fmt.Errorf("failed to get storage: %w", err)
+	}
+	resultStore, ok := store.(resultAddStore)
+	if !ok {
+		return domain.AddNotificationResult{}, fmt.Errorf("add notification: storage does not support add results")
+	}
+	return resultStore.AddNotificationWithResult(message, timestamp, session, window, pane, paneCreated, level)
+}
+
 // ListNotifications returns TSV lines for notifications using the default storage backend.
 func ListNotifications(stateFilter, levelFilter, sessionFilter, windowFilter, paneFilter, olderThanCutoff, newerThanCutoff, readFilter string) (string, error) {
 	store, err := getDefaultStorage()
@@ -42,6 +86,16 @@ func ListNotifications(stateFilter, levelFilter, sessionFilter, windowFilter, pa
 	return store.ListNotifications(stateFilter, levelFilter, sessionFilter, windowFilter, paneFilter, olderThanCutoff, newerThanCutoff, readFilter)
 }
 
+// ListNotificationsParsed returns the notifications ListNotifications lists
+// as parsed values. Malformed lines are skipped and logged at debug level.
+func ListNotificationsParsed(stateFilter, levelFilter, sessionFilter, windowFilter, paneFilter, olderThanCutoff, newerThanCutoff, readFilter string) ([]notification.Notification, error) {
+	lines, err := ListNotifications(stateFilter, levelFilter, sessionFilter, windowFilter, paneFilter, olderThanCutoff, newerThanCutoff, readFilter)
+	if err != nil {
+		return nil, err
+	}
+	return notification.ParseNotifications(lines), nil
+}
+
 // GetNotificationByID retrieves a notification by ID using the default storage backend.
 func GetNotificationByID(id string) (string, error) {
 	store, err := getDefaultStorage()
@@ -79,6 +133,197 @@ func DismissByFilter(session, window, pane string) error {
 	return store.DismissByFilter(session, window, pane)
 }
 
+// bulkDismissStore is implemented by storage backends that can dismiss several
+// notifications in one transaction.
+type bulkDismissStore interface {
+	DismissByIDs(ids []string) (int, error)
+}
+
+// DismissByIDs dismisses the given active notifications in one transaction
+// using the default storage backend and returns how many changed. Missing and
+// already dismissed IDs are skipped.
+func DismissByIDs(ids []string) (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	dismisser, ok := store.(bulkDismissStore)
+	if !ok {
+		return 0, fmt.Errorf("dismiss: storage does not support bulk dismissal")
+	}
+	return dismisser.DismissByIDs(ids)
+}
+
+// UndismissNotification restores a dismissed notification to the active state using the default storage backend.
+func UndismissNotification(id string) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.UndismissNotification(id)
+}
+
+// snoozeStore is implemented by storage backends that can hide notifications
+// until a time.
+type snoozeStore interface {
+	SnoozeNotification(id string, until time.Time) error
+	UnsnoozeExpired() (int, error)
+}
+
+// SnoozeNotification hides a notification from the active listing until until
+// using the default storage backend. The time is kept in the snoozed_until field.
+func SnoozeNotification(id string, until time.Time) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	snoozer, ok := store.(snoozeStore)
+	if !ok {
+		return fmt.Errorf("snooze notification: storage does not support snoozing")
+	}
+	return snoozer.SnoozeNotification(id, until)
+}
+
+// UnsnoozeExpired clears snoozes whose time has passed using the default
+// storage backend and returns how many were cleared.
+func UnsnoozeExpired() (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	snoozer, ok := store.(snoozeStore)
+	if !ok {
+		return 0, fmt.Errorf("unsnooze expired: storage does not support snoozing")
+	}
+	return snoozer.UnsnoozeExpired()
+}
+
+// markAllStore is implemented by storage backends that can change the read
+// state of every active notification at once.
+type markAllStore interface {
+	MarkAllRead() (int, error)
+	MarkAllUnread() (int, error)
+}
+
+// MarkAllRead marks every unread active notification read using the default
+// storage backend and returns how many changed.
+func MarkAllRead() (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	marker, ok := store.(markAllStore)
+	if !ok {
+		return 0, fmt.Errorf("mark all read: storage does not support bulk read updates")
+	}
+	return marker.MarkAllRead()
+}
+
+// MarkAllUnread marks every read active notification unread using the default
+// storage backend and returns how many changed.
+func MarkAllUnread() (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	marker, ok := store.(markAllStore)
+	if !ok {
+		return 0, fmt.Errorf("mark all unread: storage does not support bulk read updates")
+	}
+	return marker.MarkAllUnread()
+}
+
+// bulkReadStore is implemented by storage backends that can change the read
+// state of several notifications in one transaction.
+type bulkReadStore interface {
+	MarkReadByIDs(ids []string) (int, error)
+	MarkUnreadByIDs(ids []string) (int, error)
+}
+
+// MarkReadByIDs marks the given notifications read in one transaction using
+// the default storage backend and returns how many changed.
+func MarkReadByIDs(ids []string) (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	marker, ok := store.(bulkReadStore)
+	if !ok {
+		return 0, fmt.Errorf("mark read: storage does not support bulk read updates")
+	}
+	return marker.MarkReadByIDs(ids)
+}
+
+// MarkUnreadByIDs marks the given notifications unread in one transaction
+// using the default storage backend and returns how many changed.
+func MarkUnreadByIDs(ids []string) (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	marker, ok := store.(bulkReadStore)
+	if !ok {
+		return 0, fmt.Errorf("mark unread: storage does not support bulk read updates")
+	}
+	return marker.MarkUnreadByIDs(ids)
+}
+
+// compactStore is implemented by storage backends that can reclaim the space
+// left by deleted notifications.
+type compactStore interface {
+	Compact() error
+}
+
+// Compact shrinks the storage file of the default storage backend by
+// reclaiming the space left by deleted notifications.
+func Compact() error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	compacter, ok := store.(compactStore)
+	if !ok {
+		return fmt.Errorf("compact: storage does not support compaction")
+	}
+	return compacter.Compact()
+}
+
+// MuteSession mutes a session using the default storage backend.
+func MuteSession(session string) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.MuteSession(session)
+}
+
+// UnmuteSession unmutes a session using the default storage backend.
+func UnmuteSession(session string) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.UnmuteSession(session)
+}
+
+// ListMutedSessions returns muted sessions using the default storage backend.
+func ListMutedSessions() ([]string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.ListMutedSessions()
+}
+
+// EscalateStale runs the escalation sweep using the default storage backend.
+func EscalateStale() (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.EscalateStale()
+}
+
 // MarkNotificationRead marks a notification as read using the default storage backend.
 func MarkNotificationRead(id string) error {
 	store, err := getDefaultStorage()
@@ -97,6 +342,118 @@ func MarkNotificationUnread(id string) error {
 	return store.MarkNotificationUnread(id)
 }
 
+// SetImportant flags or unflags a notification as important using the default storage backend.
+func SetImportant(id string, important bool) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.SetImportant(id, important)
+}
+
+// AssignNotification sets or clears the owner of a notification using the default storage backend.
+func AssignNotification(id, owner string) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	return store.AssignNotification(id, owner)
+}
+
+var (
+	// ErrNotificationNotFound is wrapped by the errors returned for IDs that
+	// do not exist.
+	ErrNotificationNotFound = sqlite.ErrNotificationNotFound
+	// ErrNotificationAlreadyDismissed is wrapped by the error returned when
+	// dismissing a notification that is already dismissed.
+	ErrNotificationAlreadyDismissed = sqlite.ErrNotificationAlreadyDismissed
+)
+
+// occurrenceStore is implemented by storage backends that link repeated
+// messages to their previous occurrence.
+type occurrenceStore interface {
+	GetPreviousOccurrence(id string) (string, error)
+}
+
+// GetPreviousOccurrence returns when the message of a notification was last
+// seen before it, using the default storage backend. It returns an empty
+// string for the first occurrence.
+func GetPreviousOccurrence(id string) (string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return "", fmt.Errorf("failed to get storage: %w", err)
+	}
+	occurrences, ok := store.(occurrenceStore)
+	if !ok {
+		return "", fmt.Errorf("previous occurrence: storage does not support occurrence tracking")
+	}
+	return occurrences.GetPreviousOccurrence(id)
+}
+
+// fullMessageStore is implemented by storage backends that keep the full text
+// of messages truncated at max_message_length.
+type fullMessageStore interface {
+	GetFullMessage(id string) (string, error)
+}
+
+// GetFullMessage returns the untruncated message of a notification using the
+// default storage backend. It is the stored message unless keep_full_message
+// preserved a longer original.
+func GetFullMessage(id string) (string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return "", fmt.Errorf("failed to get storage: %w", err)
+	}
+	messages, ok := store.(fullMessageStore)
+	if !ok {
+		return "", fmt.Errorf("full message: storage does not support full messages")
+	}
+	return messages.GetFullMessage(id)
+}
+
+// paneContextStore is implemented by storage backends that keep the pane
+// output captured with a notification.
+type paneContextStore interface {
+	GetPaneContext(id string) (string, error)
+}
+
+// GetPaneContext returns the pane output captured when a notification was
+// added, using the default storage backend. It is empty when nothing was
+// captured.
+func GetPaneContext(id string) (string, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return "", fmt.Errorf("failed to get storage: %w", err)
+	}
+	contexts, ok := store.(paneContextStore)
+	if !ok {
+		return "", fmt.Errorf("pane context: storage does not support pane context")
+	}
+	return contexts.GetPaneContext(id)
+}
+
+// messageUpdateStore is implemented by storage backends that can replace the
+// message of an existing notification.
+type messageUpdateStore interface {
+	UpdateNotificationMessage(id, message string) error
+}
+
+// UpdateNotificationMessage replaces the message of a notification using the
+// default storage backend, keeping its timestamp and every other field. It
+// returns ErrNotificationNotFound for unknown IDs and runs the pre-update and
+// post-update hooks around the change.
+func UpdateNotificationMessage(id, newMessage string) error {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	updater, ok := store.(messageUpdateStore)
+	if !ok {
+		return fmt.Errorf("update notification: storage does not support message updates")
+	}
+	return updater.UpdateNotificationMessage(id, newMessage)
+}
+
 // CleanupOldNotifications cleans up old notifications using the default storage backend.
 func CleanupOldNotifications(daysThreshold int, dryRun bool) error {
 	store, err := getDefaultStorage()
@@ -106,6 +463,28 @@ func CleanupOldNotifications(daysThreshold int, dryRun bool) error {
 	return store.CleanupOldNotifications(daysThreshold, dryRun)
 }
 
+// expireStore is implemented by storage backends that can dismiss active
+// notifications past an age.
+type expireStore interface {
+	ExpireActiveOlderThan(level string, age time.Duration) (int, error)
+}
+
+// ExpireActiveOlderThan dismisses active notifications of level older than age
+// using the default storage backend, and returns how many expired. An empty
+// level expires every level. The pre-dismiss and post-dismiss hooks run for
+// each one. Dismissed notifications are left for CleanupOldNotifications.
+func ExpireActiveOlderThan(level string, age time.Duration) (int, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage: %w", err)
+	}
+	expirer, ok := store.(expireStore)
+	if !ok {
+		return 0, fmt.Errorf("expire notifications: storage does not support expiry")
+	}
+	return expirer.ExpireActiveOlderThan(level, age)
+}
+
 // GetActiveCount returns the count of active notifications using the default storage backend.
 func GetActiveCount() int {
 	store, err := getDefaultStorage()
@@ -115,6 +494,45 @@ func GetActiveCount() int {
 	return store.GetActiveCount()
 }
 
+// countStore is implemented by storage backends that can count notifications
+// per level and per session without listing them.
+type countStore interface {
+	CountByLevel(stateFilter string) (map[string]int, error)
+	CountBySession(stateFilter string) (map[string]int, error)
+}
+
+// CountByLevel returns how many notifications in stateFilter have each level
+// using the default storage backend. Only levels that occur are present.
+func CountByLevel(stateFilter string) (map[string]int, error) {
+	counter, err := defaultCountStore()
+	if err != nil {
+		return nil, err
+	}
+	return counter.CountByLevel(stateFilter)
+}
+
+// CountBySession returns how many notifications in stateFilter each session
+// has using the default storage backend. Only sessions that occur are present.
+func CountBySession(stateFilter string) (map[string]int, error) {
+	counter, err := defaultCountStore()
+	if err != nil {
+		return nil, err
+	}
+	return counter.CountBySession(stateFilter)
+}
+
+func defaultCountStore() (countStore, error) {
+	store, err := getDefaultStorage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage: %w", err)
+	}
+	counter, ok := store.(countStore)
+	if !ok {
+		return nil, fmt.Errorf("count: storage does not support counting")
+	}
+	return counter, nil
+}
+
 // NormalizeFields ensures a TSV line has the correct number of fields.
 // Pads with empty strings if fewer than expected, returns error if below minimum.
 func NormalizeFields(fields []string) ([]string, error) {
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +20,11 @@ func setupStorageTest(t *testing.T) {
 	t.Setenv("TMUX_INTRAY_CONFIG_PATH", filepath.Join(t.TempDir(), "config.toml"))
 }
 
+func TestFieldCountsMatchDomainParser(t *testing.T) {
+	assert.Equal(t, domain.NotificationFields, NumFields)
+	assert.Equal(t, domain.MinNotificationFields, MinFields)
+}
+
 func TestNormalizeFields(t *testing.T) {
 	t.Run("returns error when too few fields", func(t *testing.T) {
 		fields := []string{"one", "two", "three"}
@@ -29,7 +35,6 @@ func TestNormalizeFields(t *testing.T) {
 	})
 
 	t.Run("pads with empty strings when between MinFields and NumFields", func(t *testing.T) {
-		// MinFields is 9, NumFields is 10
 		fields := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}
 		result, err := NormalizeFields(fields)
 		require.NoError(t, err)
@@ -37,12 +42,19 @@ func TestNormalizeFields(t *testing.T) {
 		// Original fields preserved
 		assert.Equal(t, "1", result[0])
 		assert.Equal(t, "9", result[8])
-		// Padded field is empty
-		assert.Empty(t, result[9])
+		// Padded fields are empty
+		assert.Empty(t, result[FieldReadTimestamp])
+		assert.Empty(t, result[FieldImportant])
+		assert.Empty(t, result[FieldColor])
+		assert.Empty(t, result[FieldOwner])
+		assert.Empty(t, result[FieldSnoozedUntil])
+		assert.Empty(t, result[FieldPriority])
+		assert.Empty(t, result[FieldTags])
+		assert.Empty(t, result[FieldOccurrences])
 	})
 
 	t.Run("returns same slice when already at NumFields", func(t *testing.T) {
-		fields := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}
+		fields := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "1", "208", "alice", "2024-01-01T18:00:00Z", "5", "ci,deploy", "3"}
 		result, err := NormalizeFields(fields)
 		require.NoError(t, err)
 		assert.Equal(t, fields, result)
@@ -189,6 +201,29 @@ func TestListNotifications_WithStorage(t *testing.T) {
 	assert.Contains(t, result, "test message")
 }
 
+func TestListNotificationsParsed_WithStorage(t *testing.T) {
+	setupStorageTest(t)
+
+	require.NoError(t, Init())
+
+	_, err := AddNotification("first\tmessage", "2025-01-01T12:00:00Z", "session1", "window0", "pane0", "123456", "info")
+	require.NoError(t, err)
+	_, err = AddNotification("second message", "2025-01-01T12:01:00Z", "session2", "window1", "pane1", "123457", "error")
+	require.NoError(t, err)
+
+	notifications, err := ListNotificationsParsed("active", "error", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "second message", notifications[0].Message)
+	assert.Equal(t, "session2", notifications[0].Session)
+	assert.Equal(t, "error", notifications[0].Level)
+
+	notifications, err = ListNotificationsParsed("", "", "session1", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "first\tmessage", notifications[0].Message, "messages are unescaped")
+}
+
 func TestGetNotificationByID_WithStorage(t *testing.T) {
 	setupStorageTest(t)
 
@@ -285,6 +320,47 @@ func TestMarkNotificationUnread_WithStorage(t *testing.T) {
 	assert.Contains(t, unreadResult, id)
 }
 
+func TestUpdateNotificationMessage_WithStorage(t *testing.T) {
+	setupStorageTest(t)
+
+	require.NoError(t, Init())
+
+	id, err := AddNotification("deploy 10%", "2025-01-01T12:00:00Z", "session1", "window0", "pane0", "123456", "info")
+	require.NoError(t, err)
+
+	require.NoError(t, UpdateNotificationMessage(id, "deploy 100%"))
+
+	result, err := GetNotificationByID(id)
+	require.NoError(t, err)
+	assert.Contains(t, result, "\tdeploy 100%\t")
+	assert.Contains(t, result, "2025-01-01T12:00:00Z", "the original timestamp is kept")
+
+	err = UpdateNotificationMessage("999", "missing")
+	assert.ErrorIs(t, err, ErrNotificationNotFound)
+}
+
+func TestGetPreviousOccurrence_WithStorage(t *testing.T) {
+	setupStorageTest(t)
+
+	require.NoError(t, Init())
+
+	first, err := AddNotification("build failed", "2025-01-01T12:00:00Z", "session1", "window0", "pane0", "123456", "error")
+	require.NoError(t, err)
+	second, err := AddNotification("build failed", "2025-01-01T13:00:00Z", "session1", "window0", "pane0", "123456", "error")
+	require.NoError(t, err)
+
+	previous, err := GetPreviousOccurrence(first)
+	require.NoError(t, err)
+	assert.Empty(t, previous)
+
+	previous, err = GetPreviousOccurrence(second)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-01T12:00:00Z", previous)
+
+	_, err = GetPreviousOccurrence("999")
+	assert.ErrorIs(t, err, ErrNotificationNotFound)
+}
+
 func TestCleanupOldNotifications_WithStorage(t *testing.T) {
 	setupStorageTest(t)
 
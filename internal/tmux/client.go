@@ -42,9 +42,23 @@ type TmuxClient interface {
 	// SetStatusOption sets a tmux status option.
 	SetStatusOption(name, value string) error
 
+	// UnsetStatusOption removes a global tmux option.
+	UnsetStatusOption(name string) error
+
+	// SetStatusOptionAfter has the tmux server set a global option after delay,
+	// while guard still holds guardValue.
+	SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error
+
+	// SetBuffer copies text into a tmux paste buffer and the system clipboard when supported.
+	SetBuffer(text string) error
+
 	// ListSessions returns all tmux sessions as a map of session ID to name.
 	ListSessions() (map[string]string, error)
 
+	// ListAttachedSessions returns the sessions with at least one attached client
+	// as a map of session ID to name.
+	ListAttachedSessions() (map[string]string, error)
+
 	// GetSessionName returns the name of a session by its ID.
 	GetSessionName(sessionID string) (string, error)
 
@@ -54,6 +68,9 @@ type TmuxClient interface {
 	// ListPanes returns all tmux panes as a map of pane ID to name.
 	ListPanes() (map[string]string, error)
 
+	// CapturePane returns the last lines of visible output in a pane.
+	CapturePane(paneID string, lines int) (string, error)
+
 	// GetTmuxVisibility gets the tmux visibility state from environment variable.
 	GetTmuxVisibility() (bool, error)
 
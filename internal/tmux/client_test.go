@@ -847,6 +847,69 @@ func TestDefaultClientGetEnvironmentNotFound(t *testing.T) {
 	t.Logf("Error: %v", err)
 }
 
+// TestDefaultClientSetStatusOptionAfter tests that the tmux server writes a
+// scheduled option only while its guard is unchanged.
+func TestDefaultClientSetStatusOptionAfter(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed, skipping integration test")
+	}
+
+	socket := fmt.Sprintf("tmux-intray-test-%d", time.Now().UnixNano())
+	if out, err := exec.Command("tmux", "-L", socket, "new-session", "-d").CombinedOutput(); err != nil {
+		t.Skipf("cannot start tmux server: %v: %s", err, out)
+	}
+	t.Cleanup(func() { _ = exec.Command("tmux", "-L", socket, "kill-server").Run() })
+	client := NewDefaultClient(WithSocketPath(socket))
+
+	require.NoError(t, client.SetStatusOption("@intray_guard", "it's"))
+	require.NoError(t, client.SetStatusOptionAfter(100*time.Millisecond, "@intray_count", "0", "@intray_guard", "it's"))
+	require.NoError(t, client.SetStatusOption("@intray_other_guard", "old"))
+	require.NoError(t, client.SetStatusOptionAfter(100*time.Millisecond, "@intray_other_count", "0", "@intray_other_guard", "old"))
+	require.NoError(t, client.UnsetStatusOption("@intray_other_guard"))
+
+	show := func(name string) string {
+		stdout, _, err := client.Run("show-options", "-gqv", name)
+		require.NoError(t, err)
+		return strings.TrimSpace(stdout)
+	}
+	assert.Eventually(t, func() bool { return show("@intray_count") == "0" }, 2*time.Second, 20*time.Millisecond)
+	assert.Empty(t, show("@intray_guard"), "the write removes its guard")
+	time.Sleep(200 * time.Millisecond)
+	assert.Empty(t, show("@intray_other_count"), "a removed guard cancels the write")
+}
+
+// TestDefaultClientListStatusOptions tests that only options with the prefix
+// are listed, with their values unquoted.
+func TestDefaultClientListStatusOptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed, skipping integration test")
+	}
+
+	socket := fmt.Sprintf("tmux-intray-test-%d", time.Now().UnixNano())
+	if out, err := exec.Command("tmux", "-L", socket, "new-session", "-d").CombinedOutput(); err != nil {
+		t.Skipf("cannot start tmux server: %v: %s", err, out)
+	}
+	t.Cleanup(func() { _ = exec.Command("tmux", "-L", socket, "kill-server").Run() })
+	client := NewDefaultClient(WithSocketPath(socket))
+
+	require.NoError(t, client.SetStatusOption("@intray_pane_1_count", "3"))
+	require.NoError(t, client.SetStatusOption("@intray_pane_2_count", "two words"))
+	require.NoError(t, client.SetStatusOption("@other", "1"))
+
+	options, err := client.ListStatusOptions("@intray_pane_")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"@intray_pane_1_count": "3",
+		"@intray_pane_2_count": "two words",
+	}, options)
+}
+
 // TestDefaultClientSetStatusOptionTmuxNotRunning tests SetStatusOption when tmux is not running.
 func TestDefaultClientSetStatusOptionTmuxNotRunning(t *testing.T) {
 	if testing.Short() {
@@ -3,7 +3,9 @@ package tmux
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 )
@@ -77,6 +79,27 @@ func (c *DefaultClient) ValidatePaneExists(sessionID, windowID, paneID string) (
 	return false, nil
 }
 
+// CapturePane returns the last lines of output in a pane, with wrapped lines
+// joined and trailing blank lines removed.
+func (c *DefaultClient) CapturePane(paneID string, lines int) (string, error) {
+	if lines <= 0 {
+		return "", nil
+	}
+	stdout, stderr, err := c.Run("capture-pane", "-p", "-J", "-t", paneID)
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return "", fmt.Errorf("failed to capture pane: %w", err)
+	}
+
+	output := strings.Split(strings.TrimRight(stdout, " \t\r\n"), "\n")
+	if len(output) > lines {
+		output = output[len(output)-lines:]
+	}
+	return strings.Join(output, "\n"), nil
+}
+
 // HasSession checks if tmux server is running.
 func (c *DefaultClient) HasSession() (bool, error) {
 	_, stderr, err := c.Run("has-session")
@@ -109,3 +132,85 @@ func (c *DefaultClient) SetStatusOption(name, value string) error {
 	}
 	return nil
 }
+
+// UnsetStatusOption removes a global tmux option.
+func (c *DefaultClient) UnsetStatusOption(name string) error {
+	_, stderr, err := c.Run("set", "-gu", name)
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return fmt.Errorf("failed to unset status option %s: %w", name, err)
+	}
+	return nil
+}
+
+// ListStatusOptions returns the global options whose names start with prefix,
+// keyed by name, as the tmux server currently holds them.
+func (c *DefaultClient) ListStatusOptions(prefix string) (map[string]string, error) {
+	stdout, stderr, err := c.Run("show-options", "-g")
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return nil, fmt.Errorf("failed to list status options: %w", err)
+	}
+	options := map[string]string{}
+	for _, line := range strings.Split(stdout, "\n") {
+		name, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+		if name == "" || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		options[name] = value
+	}
+	return options, nil
+}
+
+// SetStatusOptionAfter has the tmux server set a global option after delay, so
+// the write happens even when the calling process has exited. The write only
+// happens while guard still holds guardValue, and it removes guard.
+func (c *DefaultClient) SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error {
+	script := delayedOptionScript(delay, name, value, guard, guardValue)
+	_, stderr, err := c.Run("run-shell", "-b", script)
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return fmt.Errorf("failed to schedule status option %s: %w", name, err)
+	}
+	return nil
+}
+
+// delayedOptionScript returns the shell run by SetStatusOptionAfter.
+func delayedOptionScript(delay time.Duration, name, value, guard, guardValue string) string {
+	return fmt.Sprintf(
+		`sleep %s; [ "$(tmux show-options -gqv %s)" = %s ] && tmux set-option -g %s %s \; set-option -gu %s`,
+		strconv.FormatFloat(delay.Seconds(), 'f', 3, 64),
+		shellQuote(guard), shellQuote(guardValue), shellQuote(name), shellQuote(value), shellQuote(guard),
+	)
+}
+
+// shellQuote wraps value in single quotes for the shell run-shell starts.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// SetBuffer copies text into a tmux paste buffer. The -w flag (tmux 3.2+) also
+// forwards it to the system clipboard; older versions fall back to a plain buffer.
+func (c *DefaultClient) SetBuffer(text string) error {
+	if _, _, err := c.Run("set-buffer", "-w", "--", text); err == nil {
+		return nil
+	}
+
+	_, stderr, err := c.Run("set-buffer", "--", text)
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return fmt.Errorf("failed to set tmux buffer: %w", err)
+	}
+	return nil
+}
@@ -32,6 +32,31 @@ func (c *DefaultClient) ListSessions() (map[string]string, error) {
 	return sessions, nil
 }
 
+// ListAttachedSessions returns the sessions with at least one attached client
+// as a map of session ID to name.
+func (c *DefaultClient) ListAttachedSessions() (map[string]string, error) {
+	stdout, stderr, err := c.Run("list-sessions", "-F", "#{session_id}\t#{session_attached}\t#{session_name}")
+	if err != nil {
+		if stderr != "" {
+			colors.Debug("stderr: " + stderr)
+		}
+		return nil, fmt.Errorf("failed to list attached sessions: %w", err)
+	}
+
+	sessions := make(map[string]string)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) == 3 && parts[1] != "" && parts[1] != "0" {
+			sessions[parts[0]] = parts[2]
+		}
+	}
+	return sessions, nil
+}
+
 // GetSessionName returns the name of a session by its ID.
 func (c *DefaultClient) GetSessionName(sessionID string) (string, error) {
 	stdout, stderr, err := c.Run("display-message", "-t", sessionID, "-p", "#S")
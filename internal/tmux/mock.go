@@ -2,6 +2,8 @@
 package tmux
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -92,6 +94,45 @@ func (m *MockClient) SetStatusOption(name, value string) error {
 	return args.Error(0)
 }
 
+// UnsetStatusOption returns a mocked error when removing a status option.
+// Configure the return value using:
+//
+//	mock.On("UnsetStatusOption", "@option").Return(nil)
+func (m *MockClient) UnsetStatusOption(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// SetStatusOptionAfter returns a mocked error when scheduling a status option.
+// Configure the return value using:
+//
+//	mock.On("SetStatusOptionAfter", delay, "@option", "value", "@guard", "token").Return(nil)
+func (m *MockClient) SetStatusOptionAfter(delay time.Duration, name, value, guard, guardValue string) error {
+	args := m.Called(delay, name, value, guard, guardValue)
+	return args.Error(0)
+}
+
+// ListStatusOptions returns a mocked map of global options by name.
+// Configure the return value using:
+//
+//	mock.On("ListStatusOptions", "@prefix").Return(map[string]string{}, nil)
+func (m *MockClient) ListStatusOptions(prefix string) (map[string]string, error) {
+	args := m.Called(prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+// SetBuffer returns a mocked error when copying text into a tmux buffer.
+// Configure the return value using:
+//
+//	mock.On("SetBuffer", "text").Return(nil)
+func (m *MockClient) SetBuffer(text string) error {
+	args := m.Called(text)
+	return args.Error(0)
+}
+
 // ListSessions returns a mocked map of session IDs to names.
 // Configure the return value using:
 //
@@ -102,6 +143,17 @@ func (m *MockClient) ListSessions() (map[string]string, error) {
 	return args.Get(0).(map[string]string), args.Error(1)
 }
 
+// ListAttachedSessions returns a mocked map of attached session IDs to names.
+// Configure the return value using:
+//
+//	sessions := map[string]string{"$1": "my-session"}
+//	mock.On("ListAttachedSessions").Return(sessions, nil)
+func (m *MockClient) ListAttachedSessions() (map[string]string, error) {
+	args := m.Called()
+	sessions, _ := args.Get(0).(map[string]string)
+	return sessions, args.Error(1)
+}
+
 // GetSessionName returns a mocked session name for a given session ID.
 // Configure the return value using:
 //
@@ -131,6 +183,15 @@ func (m *MockClient) ListPanes() (map[string]string, error) {
 	return args.Get(0).(map[string]string), args.Error(1)
 }
 
+// CapturePane returns mocked pane output.
+// Configure the return value using:
+//
+//	mock.On("CapturePane", "%1", 10).Return("make: *** [all] Error 1", nil)
+func (m *MockClient) CapturePane(paneID string, lines int) (string, error) {
+	args := m.Called(paneID, lines)
+	return args.String(0), args.Error(1)
+}
+
 // GetTmuxVisibility returns a mocked visibility state.
 // Configure the return value using:
 //
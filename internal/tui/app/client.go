@@ -16,6 +16,7 @@ type Model interface {
 	tea.Model
 	SetLoadedSettings(loadedSettings *settings.Settings)
 	SetShowStale(show bool)
+	SetPeek(peek bool)
 	FromState(settingsState settings.TUIState) error
 }
 
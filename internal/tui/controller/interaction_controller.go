@@ -19,8 +19,14 @@ type notificationStore interface {
 	ListAllNotifications() (string, error)
 	DismissNotification(id string) error
 	DismissByFilter(session, window, pane string) error
+	DismissByIDs(ids []string) (int, error)
+	UndismissNotification(id string) error
 	MarkNotificationRead(id string) error
 	MarkNotificationUnread(id string) error
+	MarkReadByIDs(ids []string) (int, error)
+	MarkUnreadByIDs(ids []string) (int, error)
+	SetImportant(id string, important bool) error
+	AssignNotification(id, owner string) error
 }
 
 type typedNotificationStore interface {
@@ -28,6 +34,14 @@ type typedNotificationStore interface {
 	ListAllNotificationValues() ([]notification.Notification, error)
 }
 
+// notificationDetailStore is implemented by stores that keep data beside a
+// notification that its list row does not carry.
+type notificationDetailStore interface {
+	GetPreviousOccurrence(id string) (string, error)
+	GetFullMessage(id string) (string, error)
+	GetPaneContext(id string) (string, error)
+}
+
 type notificationParser interface {
 	Parse(line string) (domain.Notification, error)
 }
@@ -64,6 +78,18 @@ func (s storageNotificationStore) listNotificationValues(state string) ([]notifi
 	return values, nil
 }
 
+func (s storageNotificationStore) GetPreviousOccurrence(id string) (string, error) {
+	return storage.GetPreviousOccurrence(id)
+}
+
+func (s storageNotificationStore) GetFullMessage(id string) (string, error) {
+	return storage.GetFullMessage(id)
+}
+
+func (s storageNotificationStore) GetPaneContext(id string) (string, error) {
+	return storage.GetPaneContext(id)
+}
+
 func (s storageNotificationStore) DismissNotification(id string) error {
 	return storage.DismissNotification(id)
 }
@@ -72,6 +98,22 @@ func (s storageNotificationStore) DismissByFilter(session, window, pane string)
 	return storage.DismissByFilter(session, window, pane)
 }
 
+func (s storageNotificationStore) DismissByIDs(ids []string) (int, error) {
+	return storage.DismissByIDs(ids)
+}
+
+func (s storageNotificationStore) UndismissNotification(id string) error {
+	return storage.UndismissNotification(id)
+}
+
+func (s storageNotificationStore) SetImportant(id string, important bool) error {
+	return storage.SetImportant(id, important)
+}
+
+func (s storageNotificationStore) AssignNotification(id, owner string) error {
+	return storage.AssignNotification(id, owner)
+}
+
 func (s storageNotificationStore) MarkNotificationRead(id string) error {
 	return storage.MarkNotificationRead(id)
 }
@@ -80,6 +122,14 @@ func (s storageNotificationStore) MarkNotificationUnread(id string) error {
 	return storage.MarkNotificationUnread(id)
 }
 
+func (s storageNotificationStore) MarkReadByIDs(ids []string) (int, error) {
+	return storage.MarkReadByIDs(ids)
+}
+
+func (s storageNotificationStore) MarkUnreadByIDs(ids []string) (int, error) {
+	return storage.MarkUnreadByIDs(ids)
+}
+
 type defaultNotificationParser struct{}
 
 func (p defaultNotificationParser) Parse(line string) (domain.Notification, error) {
@@ -195,6 +245,28 @@ func notificationPointersToValues(notifs []*domain.Notification) []domain.Notifi
 	return items
 }
 
+// LoadNotificationDetails loads what the detail view shows about a
+// notification. Stores that keep no extra data return empty details.
+func (c *DefaultInteractionController) LoadNotificationDetails(id string) (model.NotificationDetails, error) {
+	store, ok := c.store.(notificationDetailStore)
+	if !ok {
+		return model.NotificationDetails{}, nil
+	}
+	previous, err := store.GetPreviousOccurrence(id)
+	if err != nil {
+		return model.NotificationDetails{}, fmt.Errorf("failed to load notification details: %w", err)
+	}
+	fullMessage, err := store.GetFullMessage(id)
+	if err != nil {
+		return model.NotificationDetails{}, fmt.Errorf("failed to load notification details: %w", err)
+	}
+	paneContext, err := store.GetPaneContext(id)
+	if err != nil {
+		return model.NotificationDetails{}, fmt.Errorf("failed to load notification details: %w", err)
+	}
+	return model.NotificationDetails{PreviousOccurrence: previous, FullMessage: fullMessage, PaneContext: paneContext}, nil
+}
+
 // DismissNotification marks a notification as dismissed.
 func (c *DefaultInteractionController) DismissNotification(id string) error {
 	return c.store.DismissNotification(id)
@@ -205,6 +277,17 @@ func (c *DefaultInteractionController) DismissByFilter(session, window, pane str
 	return c.store.DismissByFilter(session, window, pane)
 }
 
+// DismissNotifications dismisses several notifications in one transaction.
+func (c *DefaultInteractionController) DismissNotifications(ids []string) error {
+	_, err := c.store.DismissByIDs(ids)
+	return err
+}
+
+// UndismissNotification restores a dismissed notification to the active state.
+func (c *DefaultInteractionController) UndismissNotification(id string) error {
+	return c.store.UndismissNotification(id)
+}
+
 // MarkNotificationRead marks a notification as read.
 func (c *DefaultInteractionController) MarkNotificationRead(id string) error {
 	return c.store.MarkNotificationRead(id)
@@ -215,6 +298,28 @@ func (c *DefaultInteractionController) MarkNotificationUnread(id string) error {
 	return c.store.MarkNotificationUnread(id)
 }
 
+// MarkNotificationsRead marks several notifications read in one transaction.
+func (c *DefaultInteractionController) MarkNotificationsRead(ids []string) error {
+	_, err := c.store.MarkReadByIDs(ids)
+	return err
+}
+
+// MarkNotificationsUnread marks several notifications unread in one transaction.
+func (c *DefaultInteractionController) MarkNotificationsUnread(ids []string) error {
+	_, err := c.store.MarkUnreadByIDs(ids)
+	return err
+}
+
+// SetImportant flags or unflags a notification as important.
+func (c *DefaultInteractionController) SetImportant(id string, important bool) error {
+	return c.store.SetImportant(id, important)
+}
+
+// AssignNotification sets the owner of a notification; an empty owner unassigns it.
+func (c *DefaultInteractionController) AssignNotification(id, owner string) error {
+	return c.store.AssignNotification(id, owner)
+}
+
 // EnsureTmuxRunning verifies tmux is available.
 func (c *DefaultInteractionController) EnsureTmuxRunning() bool {
 	if c.runtimeCoordinator == nil {
@@ -231,6 +336,14 @@ func (c *DefaultInteractionController) JumpToPane(sessionID, windowID, paneID st
 	return c.runtimeCoordinator.JumpToPane(sessionID, windowID, paneID)
 }
 
+// CopyToClipboard copies text to the clipboard through tmux.
+func (c *DefaultInteractionController) CopyToClipboard(text string) error {
+	if c.runtimeCoordinator == nil {
+		return errors.New("copy: tmux runtime unavailable")
+	}
+	return c.runtimeCoordinator.CopyToClipboard(text)
+}
+
 // JumpToWindow performs a tmux window jump operation.
 func (c *DefaultInteractionController) JumpToWindow(sessionID, windowID string) bool {
 	if c.runtimeCoordinator == nil {
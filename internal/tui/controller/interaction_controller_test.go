@@ -2,6 +2,7 @@ package controller
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -20,10 +21,19 @@ type fakeNotificationStore struct {
 	typedAllCalls      int
 	dismissID          string
 	dismissFilter      [3]string
+	dismissIDs         []string
+	undismissID        string
 	markReadID         string
 	markUnreadID       string
+	markReadIDs        []string
+	markUnreadIDs      []string
+	importantID        string
+	important          bool
+	assignID           string
+	owner              string
 	dismissErr         error
 	dismissByFilterErr error
+	undismissErr       error
 	markReadErr        error
 	markUnreadErr      error
 }
@@ -62,6 +72,16 @@ func (f *fakeNotificationStore) DismissByFilter(session, window, pane string) er
 	return f.dismissByFilterErr
 }
 
+func (f *fakeNotificationStore) DismissByIDs(ids []string) (int, error) {
+	f.dismissIDs = ids
+	return len(ids), f.dismissErr
+}
+
+func (f *fakeNotificationStore) UndismissNotification(id string) error {
+	f.undismissID = id
+	return f.undismissErr
+}
+
 func (f *fakeNotificationStore) MarkNotificationRead(id string) error {
 	f.markReadID = id
 	return f.markReadErr
@@ -72,6 +92,49 @@ func (f *fakeNotificationStore) MarkNotificationUnread(id string) error {
 	return f.markUnreadErr
 }
 
+func (f *fakeNotificationStore) MarkReadByIDs(ids []string) (int, error) {
+	f.markReadIDs = ids
+	return len(ids), f.markReadErr
+}
+
+func (f *fakeNotificationStore) MarkUnreadByIDs(ids []string) (int, error) {
+	f.markUnreadIDs = ids
+	return len(ids), f.markUnreadErr
+}
+
+func (f *fakeNotificationStore) SetImportant(id string, important bool) error {
+	f.importantID = id
+	f.important = important
+	return nil
+}
+
+func (f *fakeNotificationStore) AssignNotification(id, owner string) error {
+	f.assignID = id
+	f.owner = owner
+	return nil
+}
+
+// fakeDetailStore adds the detail lookups to fakeNotificationStore.
+type fakeDetailStore struct {
+	fakeNotificationStore
+	previous    map[string]string
+	previousErr error
+	full        map[string]string
+	paneContext map[string]string
+}
+
+func (f *fakeDetailStore) GetPreviousOccurrence(id string) (string, error) {
+	return f.previous[id], f.previousErr
+}
+
+func (f *fakeDetailStore) GetFullMessage(id string) (string, error) {
+	return f.full[id], nil
+}
+
+func (f *fakeDetailStore) GetPaneContext(id string) (string, error) {
+	return f.paneContext[id], nil
+}
+
 type fakeNotificationParser struct {
 	parsed map[string]domain.Notification
 	errFor map[string]error
@@ -93,6 +156,7 @@ type fakeRuntimeCoordinator struct{}
 func (f fakeRuntimeCoordinator) EnsureTmuxRunning() bool                            { return true }
 func (f fakeRuntimeCoordinator) JumpToPane(sessionID, windowID, paneID string) bool { return true }
 func (f fakeRuntimeCoordinator) JumpToWindow(sessionID, windowID string) bool       { return true }
+func (f fakeRuntimeCoordinator) CopyToClipboard(text string) error                  { return nil }
 func (f fakeRuntimeCoordinator) ValidatePaneExists(sessionID, windowID, paneID string) (bool, error) {
 	return true, nil
 }
@@ -237,12 +301,30 @@ func TestMutationMethods_DelegateToStore(t *testing.T) {
 	if err := controller.DismissByFilter("$1", "@2", "%3"); err != nil {
 		t.Fatalf("dismiss by filter failed: %v", err)
 	}
+	if err := controller.DismissNotifications([]string{"4", "5"}); err != nil {
+		t.Fatalf("dismiss notifications failed: %v", err)
+	}
+	if err := controller.UndismissNotification("6"); err != nil {
+		t.Fatalf("undismiss failed: %v", err)
+	}
 	if err := controller.MarkNotificationRead("8"); err != nil {
 		t.Fatalf("mark read failed: %v", err)
 	}
 	if err := controller.MarkNotificationUnread("9"); err != nil {
 		t.Fatalf("mark unread failed: %v", err)
 	}
+	if err := controller.MarkNotificationsRead([]string{"1", "2"}); err != nil {
+		t.Fatalf("mark notifications read failed: %v", err)
+	}
+	if err := controller.MarkNotificationsUnread([]string{"3"}); err != nil {
+		t.Fatalf("mark notifications unread failed: %v", err)
+	}
+	if err := controller.SetImportant("10", true); err != nil {
+		t.Fatalf("set important failed: %v", err)
+	}
+	if err := controller.AssignNotification("11", "alice"); err != nil {
+		t.Fatalf("assign notification failed: %v", err)
+	}
 
 	if store.dismissID != "7" {
 		t.Fatalf("expected dismiss id 7, got %s", store.dismissID)
@@ -250,12 +332,70 @@ func TestMutationMethods_DelegateToStore(t *testing.T) {
 	if store.dismissFilter != [3]string{"$1", "@2", "%3"} {
 		t.Fatalf("unexpected dismiss filter values: %#v", store.dismissFilter)
 	}
+	if !reflect.DeepEqual(store.dismissIDs, []string{"4", "5"}) {
+		t.Fatalf("unexpected dismiss ids: %#v", store.dismissIDs)
+	}
+	if store.undismissID != "6" {
+		t.Fatalf("expected undismiss id 6, got %s", store.undismissID)
+	}
 	if store.markReadID != "8" {
 		t.Fatalf("expected mark read id 8, got %s", store.markReadID)
 	}
 	if store.markUnreadID != "9" {
 		t.Fatalf("expected mark unread id 9, got %s", store.markUnreadID)
 	}
+	if len(store.markReadIDs) != 2 || store.markReadIDs[0] != "1" || store.markReadIDs[1] != "2" {
+		t.Fatalf("expected bulk mark read ids [1 2], got %v", store.markReadIDs)
+	}
+	if len(store.markUnreadIDs) != 1 || store.markUnreadIDs[0] != "3" {
+		t.Fatalf("expected bulk mark unread ids [3], got %v", store.markUnreadIDs)
+	}
+	if store.importantID != "10" || !store.important {
+		t.Fatalf("expected important flag on id 10, got %s=%v", store.importantID, store.important)
+	}
+	if store.assignID != "11" || store.owner != "alice" {
+		t.Fatalf("expected owner alice on id 11, got %s=%s", store.assignID, store.owner)
+	}
+}
+
+func TestLoadNotificationDetails(t *testing.T) {
+	store := &fakeDetailStore{
+		previous:    map[string]string{"4": "2025-01-01T12:00:00Z"},
+		full:        map[string]string{"4": "build failed: exit status 2"},
+		paneContext: map[string]string{"4": "$ make test"},
+	}
+	controller := NewInteractionControllerWithAdapters(fakeRuntimeCoordinator{}, store, nil)
+
+	details, err := controller.LoadNotificationDetails("4")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if details.PreviousOccurrence != "2025-01-01T12:00:00Z" {
+		t.Fatalf("unexpected previous occurrence: %q", details.PreviousOccurrence)
+	}
+	if details.FullMessage != "build failed: exit status 2" {
+		t.Fatalf("unexpected full message: %q", details.FullMessage)
+	}
+	if details.PaneContext != "$ make test" {
+		t.Fatalf("unexpected pane context: %q", details.PaneContext)
+	}
+
+	store.previousErr = errors.New("storage down")
+	if _, err := controller.LoadNotificationDetails("4"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestLoadNotificationDetails_EmptyForStoresWithoutDetails(t *testing.T) {
+	controller := NewInteractionControllerWithAdapters(fakeRuntimeCoordinator{}, &fakeNotificationStore{}, nil)
+
+	details, err := controller.LoadNotificationDetails("4")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if details != (model.NotificationDetails{}) {
+		t.Fatalf("expected empty details, got %#v", details)
+	}
 }
 
 func TestLoadActiveNotifications_ReturnsEmptySliceForNoRows(t *testing.T) {
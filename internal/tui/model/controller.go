@@ -3,16 +3,38 @@ package model
 
 import "github.com/cristianoliveira/tmux-intray/internal/domain"
 
+// NotificationDetails holds what the TUI detail view shows about a
+// notification beyond its list row.
+type NotificationDetails struct {
+	// PreviousOccurrence is the RFC3339 time the same message was last seen
+	// before the notification. Empty means it was the first occurrence.
+	PreviousOccurrence string
+	// FullMessage is the untruncated message when keep_full_message kept a
+	// longer original; otherwise it equals the stored message.
+	FullMessage string
+	// PaneContext is the pane output captured when the notification was
+	// added. Empty means nothing was captured.
+	PaneContext string
+}
+
 // InteractionController coordinates side-effectful TUI interactions.
 // It encapsulates tmux/core interactions and notification persistence operations.
 type InteractionController interface {
 	LoadActiveNotifications() ([]domain.Notification, error)
 	LoadAllNotifications() ([]domain.Notification, error)
+	LoadNotificationDetails(id string) (NotificationDetails, error)
 	DismissNotification(id string) error
 	DismissByFilter(session, window, pane string) error
+	DismissNotifications(ids []string) error
+	UndismissNotification(id string) error
 	MarkNotificationRead(id string) error
 	MarkNotificationUnread(id string) error
+	MarkNotificationsRead(ids []string) error
+	MarkNotificationsUnread(ids []string) error
+	SetImportant(id string, important bool) error
+	AssignNotification(id, owner string) error
 	EnsureTmuxRunning() bool
 	JumpToPane(sessionID, windowID, paneID string) bool
 	JumpToWindow(sessionID, windowID string) bool
+	CopyToClipboard(text string) error
 }
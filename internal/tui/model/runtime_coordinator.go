@@ -19,6 +19,9 @@ type RuntimeCoordinator interface {
 	// Returns true on success, false on failure.
 	JumpToWindow(sessionID, windowID string) bool
 
+	// CopyToClipboard copies text into a tmux paste buffer and the system clipboard.
+	CopyToClipboard(text string) error
+
 	// ValidatePaneExists checks if a pane exists in the specified session and window.
 	// Returns true if the pane exists, false otherwise.
 	ValidatePaneExists(sessionID, windowID, paneID string) (bool, error)
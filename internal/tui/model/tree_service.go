@@ -4,6 +4,7 @@ package model
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 )
@@ -12,7 +13,8 @@ import (
 // It handles the hierarchical organization of notifications by session/window/pane/message.
 type TreeService interface {
 	// BuildTree creates a tree structure from a list of notifications.
-	// The groupBy parameter determines the grouping depth (session, window, pane, message, or pane_message).
+	// The groupBy parameter determines the grouping depth (session, window, pane, message, or pane_message)
+	// or a time bucket (day or week).
 	// The resulting tree is stored internally by the service.
 	BuildTree(notifications []domain.Notification, groupBy string) error
 
@@ -64,9 +66,35 @@ type TreeService interface {
 	// ToggleNodeExpansion toggles the expansion state of a group node.
 	ToggleNodeExpansion(node *TreeNode)
 
+	// SetWeekStart sets the weekday that opens each bucket when grouping by week.
+	SetWeekStart(weekStart time.Weekday)
+
+	// SetGroupUnassigned sets whether notifications with no session, window or
+	// pane are collected under a single unassigned group.
+	SetGroupUnassigned(enabled bool)
+
+	// SetMaxDepth caps how many levels are visible. Deeper levels collapse
+	// into a "+N more" node that reveals them when expanded. Zero shows all.
+	SetMaxDepth(depth int)
+
+	// SetPaneValidator sets how tree builds check that a notification's pane
+	// still exists. Groups holding a dead pane are marked stale. Nil skips
+	// the check.
+	SetPaneValidator(paneExists func(sessionID, windowID, paneID string) bool)
+
 	// GetTreeLevel returns the depth level of a node in the tree.
 	// Root is level 0, session nodes are level 0 in their context, etc.
 	GetTreeLevel(node *TreeNode) int
+
+	// GroupCounts returns the number of group nodes of each kind in the current
+	// tree, outermost first, followed by the number of notifications.
+	GroupCounts() []GroupCount
+}
+
+// GroupCount is the number of tree nodes of one kind.
+type GroupCount struct {
+	Kind  NodeKind
+	Count int
 }
 
 // TreeNode represents a node in the notification tree hierarchy.
@@ -106,6 +134,10 @@ type TreeNode struct {
 
 	// Sources contains unique source references contributing to this node.
 	Sources map[string]NotificationSource
+
+	// Stale reports whether a notification under this group node points at a
+	// pane that no longer exists.
+	Stale bool
 }
 
 // NotificationSource represents a unique tmux context for notifications.
@@ -136,9 +168,23 @@ const (
 	// NodeKindPane represents a pane group node.
 	NodeKindPane NodeKind = "pane"
 
+	// NodeKindUnassigned represents the group of notifications that have no
+	// session, window or pane.
+	NodeKindUnassigned NodeKind = "unassigned"
+
 	// NodeKindMessage represents a message group node.
 	NodeKindMessage NodeKind = "message"
 
+	// NodeKindDay represents a calendar day group node.
+	NodeKindDay NodeKind = "day"
+
+	// NodeKindWeek represents a week group node.
+	NodeKindWeek NodeKind = "week"
+
+	// NodeKindMore represents the "+N more" node that stands in for levels
+	// hidden by the maximum tree depth.
+	NodeKindMore NodeKind = "more"
+
 	// NodeKindNotification represents a leaf node containing a notification.
 	NodeKindNotification NodeKind = "notification"
 )
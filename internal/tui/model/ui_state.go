@@ -123,6 +123,9 @@ const (
 
 	// ViewModeSearch starts focused in the search input.
 	ViewModeSearch ViewMode = "search"
+
+	// ViewModeSummary shows one line per session with counts by level.
+	ViewModeSummary ViewMode = "summary"
 )
 
 // GroupBy represents the grouping mode for notifications.
@@ -146,6 +149,12 @@ const (
 
 	// GroupByPaneMessage groups notifications by pane, then message.
 	GroupByPaneMessage GroupBy = "pane_message"
+
+	// GroupByDay groups notifications by calendar day.
+	GroupByDay GroupBy = "day"
+
+	// GroupByWeek groups notifications by week, starting on the configured week start.
+	GroupByWeek GroupBy = "week"
 )
 
 // UIDTO is a data transfer object for UI state persistence.
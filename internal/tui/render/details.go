@@ -0,0 +1,130 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+)
+
+// detailsMargin keeps the detail box off the terminal edges.
+const detailsMargin = 4
+
+// Details defines the inputs needed to render the notification detail view.
+type Details struct {
+	Notification domain.Notification
+	// Location is the resolved "session:window.pane"; empty when unknown.
+	Location string
+	// PreviousOccurrence is the RFC3339 time the same message was last seen
+	// before the notification. Empty means it was the first occurrence.
+	PreviousOccurrence string
+	// FullMessage replaces the stored message when set, so a message cut at
+	// max_message_length shows in full.
+	FullMessage string
+	// PaneContext is the pane output captured at add time, shown below the
+	// message. Empty leaves the section out.
+	PaneContext string
+	Width       int
+	Now         time.Time
+}
+
+// NotificationDetails renders one notification with the fields its list row
+// leaves out, in a bordered box sized to the terminal width.
+func NotificationDetails(d Details) string {
+	labelStyle := lipgloss.NewStyle().Bold(true)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("226"))
+	hintStyle := lipgloss.NewStyle().Faint(true).Foreground(lipgloss.Color("245"))
+
+	n := d.Notification
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(fmt.Sprintf("Notification %d", n.ID)))
+	content.WriteString("\n\n")
+	for _, field := range detailFields(d) {
+		content.WriteString(labelStyle.Render(field[0] + ":"))
+		content.WriteString(" ")
+		content.WriteString(field[1])
+		content.WriteString("\n")
+	}
+	message := n.Message
+	if d.FullMessage != "" {
+		message = d.FullMessage
+	}
+	content.WriteString("\n")
+	content.WriteString(message)
+	content.WriteString("\n\n")
+	if d.PaneContext != "" {
+		content.WriteString(labelStyle.Render("Pane context:"))
+		content.WriteString("\n")
+		content.WriteString(strings.TrimRight(d.PaneContext, "\n"))
+		content.WriteString("\n\n")
+	}
+	content.WriteString(hintStyle.Render("Esc/i/q to close"))
+
+	width := d.Width - detailsMargin
+	if width < 0 {
+		width = 0
+	}
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("62")).
+		Padding(0, 1).
+		Width(width)
+	return box.Render(content.String())
+}
+
+// detailFields returns the label/value pairs shown above the message. Fields
+// that are unset are left out.
+func detailFields(d Details) [][2]string {
+	n := d.Notification
+	level := n.Level.String()
+	if level == "" {
+		level = domain.LevelInfo.String()
+	}
+	state := string(n.State)
+	if state == "" {
+		state = string(domain.StateActive)
+	}
+
+	fields := [][2]string{
+		{"Level", level},
+		{"State", state},
+		{"Time", timeWithAge(n.Timestamp, d.Now)},
+	}
+	if d.Location != "" {
+		fields = append(fields, [2]string{"Location", d.Location})
+	}
+	if n.ReadTimestamp != "" {
+		fields = append(fields, [2]string{"Read", timeWithAge(n.ReadTimestamp, d.Now)})
+	}
+	if n.Owner != "" {
+		fields = append(fields, [2]string{"Owner", n.Owner})
+	}
+	if n.Priority != 0 {
+		fields = append(fields, [2]string{"Priority", strconv.Itoa(n.Priority)})
+	}
+	if len(n.Tags) > 0 {
+		fields = append(fields, [2]string{"Tags", strings.Join(n.Tags, ", ")})
+	}
+	if n.Occurrences > 1 {
+		fields = append(fields, [2]string{"Occurrences", strconv.Itoa(n.Occurrences)})
+	}
+	lastSeen := "first occurrence"
+	if d.PreviousOccurrence != "" {
+		lastSeen = timeWithAge(d.PreviousOccurrence, d.Now)
+	}
+	fields = append(fields, [2]string{"Last seen", lastSeen})
+	return fields
+}
+
+// timeWithAge renders an RFC3339 timestamp followed by its age, such as
+// "2025-01-01T12:00:00Z (3h ago)". Unparseable timestamps are shown as is.
+func timeWithAge(timestamp string, now time.Time) string {
+	age := calculateAge(timestamp, now)
+	if age == "" {
+		return timestamp
+	}
+	return fmt.Sprintf("%s (%s ago)", timestamp, age)
+}
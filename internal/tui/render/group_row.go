@@ -18,6 +18,8 @@ type GroupNode struct {
 	Expanded    bool
 	Count       int
 	UnreadCount int
+	// Stale marks a group holding a notification whose pane no longer exists.
+	Stale bool
 }
 
 // GroupRow defines the inputs needed to render a group row.
@@ -34,6 +36,9 @@ type GroupRow struct {
 	LevelCounts       map[string]int
 	Sources           []string
 	Options           settings.GroupHeaderOptions
+	Selection         settings.SelectionOptions
+	// SourceGutter keeps group rows aligned with rows that draw a source gutter.
+	SourceGutter bool
 }
 
 // GroupRowStyles defines styles for group rows.
@@ -60,13 +65,23 @@ func RenderGroupRow(row GroupRow) string {
 	styles := ensureGroupRowStyles(row.Styles)
 	options := resolveGroupRowOptions(row.Options)
 
+	gutter := SourceGutter(row.SourceGutter, "") + Gutter(row.Selected, row.Selection)
+	width := row.Width
+	if width > 0 {
+		width = max(width-utf8.RuneCountInString(gutter), 0)
+	}
+
 	segments := buildGroupRowSegments(row, options)
-	segments = clampGroupRowSegments(segments, row.Width)
+	segments = clampGroupRowSegments(segments, width)
 	plain := plainTextFromSegments(segments)
 	if row.Selected {
-		return styles.Selected.Render(plain)
+		selected := styles.Selected
+		if row.Styles == nil {
+			selected = selectionStyle(row.Selection).Bold(true)
+		}
+		return gutter + selected.Render(plain)
 	}
-	return renderSegments(segments, groupBaseStyle(row, styles))
+	return gutter + renderSegments(segments, groupBaseStyle(row, styles))
 }
 
 func ensureGroupRowStyles(styles *GroupRowStyles) *GroupRowStyles {
@@ -78,21 +93,54 @@ func ensureGroupRowStyles(styles *GroupRowStyles) *GroupRowStyles {
 }
 
 func buildGroupRowSegments(row GroupRow, options settings.GroupHeaderOptions) []groupRowSegment {
-	segments := []groupRowSegment{buildGroupTitleSegment(row)}
+	segments := buildGroupTitleSegments(row, options)
 	segments = appendTimeRangeSegment(segments, row, options)
 	segments = appendBadgeSegments(segments, row, options)
+	segments = appendStaleSegment(segments, row.Node)
 	return appendSourceSegment(segments, row.Sources, options)
 }
 
-func buildGroupTitleSegment(row GroupRow) groupRowSegment {
-	indent := strings.Repeat(" ", groupIndentSize*row.Level)
-	symbol := groupCollapsedSymbol
-	if row.Node.Expanded {
-		symbol = groupExpandedSymbol
+// staleBadge marks group headers holding notifications for closed panes.
+const staleBadge = "⚠ stale"
+
+func appendStaleSegment(segments []groupRowSegment, node *GroupNode) []groupRowSegment {
+	if node == nil || !node.Stale {
+		return segments
 	}
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color(ansiColorNumber(colors.Yellow)))
+	return appendSegmentWithGap(segments, groupRowSegment{text: staleBadge, style: &style}, "  ")
+}
+
+func buildGroupTitleSegments(row GroupRow, options settings.GroupHeaderOptions) []groupRowSegment {
+	indent := strings.Repeat(" ", groupIndentSize*row.Level)
 	title := resolveGroupTitle(row.Node)
 	countLabel := formatGroupCount(row.Node.Count, row.Node.UnreadCount)
-	return groupRowSegment{text: fmt.Sprintf("%s%s %s (%s)", indent, symbol, title, countLabel)}
+
+	symbol := groupRowSegment{text: foldGlyph(row.Node.Expanded, options)}
+	if options.FoldColor != "" {
+		style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(ansiColorNumber(options.FoldColor)))
+		symbol.style = &style
+	}
+
+	return []groupRowSegment{
+		{text: indent},
+		symbol,
+		{text: fmt.Sprintf(" %s (%s)", title, countLabel)},
+	}
+}
+
+// foldGlyph returns the configured fold indicator, falling back to the defaults.
+func foldGlyph(expanded bool, options settings.GroupHeaderOptions) string {
+	if expanded {
+		if options.ExpandedGlyph != "" {
+			return options.ExpandedGlyph
+		}
+		return groupExpandedSymbol
+	}
+	if options.CollapsedGlyph != "" {
+		return options.CollapsedGlyph
+	}
+	return groupCollapsedSymbol
 }
 
 func resolveGroupTitle(node *GroupNode) string {
@@ -2,6 +2,7 @@ package render
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -22,14 +23,21 @@ const (
 	spacesBetweenColumns = 12
 	defaultMessageWidth  = 50
 	groupIndentSize      = 2
-	groupCollapsedSymbol = "▸"
-	groupExpandedSymbol  = "▾"
+	groupCollapsedSymbol = settings.DefaultCollapsedGlyph
+	groupExpandedSymbol  = settings.DefaultExpandedGlyph
 )
 
 // FooterState defines the inputs needed to render footer help text.
 type FooterState struct {
 	SearchMode  bool
 	SearchQuery string
+	// SearchMatches and SearchTotal give the "12/340 match" count shown
+	// while a search query is active.
+	SearchMatches int
+	SearchTotal   int
+
+	CommandMode  bool
+	CommandQuery string
 
 	Grouped      bool
 	ViewMode     string
@@ -38,6 +46,14 @@ type FooterState struct {
 	ErrorMessage string
 	ReadFilter   string
 	ShowHelp     bool
+	// GroupCounts summarizes the grouped tree, outermost kind first.
+	GroupCounts []GroupCount
+}
+
+// GroupCount is the number of tree nodes of one kind, e.g. "session" or "notification".
+type GroupCount struct {
+	Kind  string
+	Count int
 }
 
 // RowState defines the inputs needed to render a notification row.
@@ -47,6 +63,9 @@ type RowState struct {
 	Width        int
 	Selected     bool
 	Now          time.Time
+	Selection    settings.SelectionOptions
+	// SourceGutter draws a bar colored by the row's session left of the row.
+	SourceGutter bool
 }
 
 // Tabs renders the Recents/All/Sessions tab controls.
@@ -71,15 +90,49 @@ func Tabs(activeTab settings.Tab, width int) string {
 	return truncateFooter(line, width)
 }
 
+// TabLabel renders a separator line naming the active tab and how many
+// notifications it lists, e.g. "── All (12) ─────".
+func TabLabel(activeTab settings.Tab, count, width int) string {
+	name := "Recents"
+	switch settings.NormalizeTab(string(activeTab)) {
+	case settings.TabAll:
+		name = "All"
+	case settings.TabSessions:
+		name = "Sessions"
+	}
+
+	line := fmt.Sprintf("── %s (%d) ", name, count)
+	if fill := width - lipgloss.Width(line); fill > 0 {
+		line += strings.Repeat("─", fill)
+	}
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	return style.Render(truncateFooter(line, width))
+}
+
 // Header renders the table header.
 func Header(width int) string {
 	headerStyle := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(ansiColorNumber(colors.Blue)))
 
+	return headerStyle.Render(headerText(width))
+}
+
+// FlashHeader renders the table header highlighted, to draw attention to
+// notifications that just arrived.
+func FlashHeader(width int) string {
+	flashStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("0")).
+		Background(lipgloss.Color(ansiColorNumber(colors.Yellow)))
+
+	return flashStyle.Render(headerText(width))
+}
+
+func headerText(width int) string {
 	messageWidth := calculateMessageWidth(width)
 
-	header := fmt.Sprintf("%-*s  %-*s  %-*s  %-*s  %-*s  %-*s  %-*s",
+	return fmt.Sprintf("%-*s  %-*s  %-*s  %-*s  %-*s  %-*s  %-*s",
 		readStatusWidth, "RD",
 		typeWidth, "TYPE",
 		statusWidth, "STATUS",
@@ -88,17 +141,63 @@ func Header(width int) string {
 		paneWidth, "PANE",
 		ageWidth, "AGE",
 	)
+}
+
+// headerColumnGap is the spacing between header columns.
+const headerColumnGap = 2
+
+// HeaderSortField returns the sort field of the header column at x, counted
+// from the first header cell. RD, MESSAGE and PANE have no sort field, and
+// neither do the gaps between columns.
+func HeaderSortField(x, width int) (string, bool) {
+	columns := []struct {
+		width int
+		field string
+	}{
+		{readStatusWidth, ""},
+		{typeWidth, settings.SortByLevel},
+		{statusWidth, settings.SortByState},
+		{sessionWidth, settings.SortBySession},
+		{calculateMessageWidth(width), ""},
+		{paneWidth, ""},
+		{ageWidth, settings.SortByTimestamp},
+	}
 
-	return headerStyle.Render(header)
+	start := 0
+	for _, column := range columns {
+		if x >= start && x < start+column.width {
+			return column.field, column.field != ""
+		}
+		start += column.width + headerColumnGap
+	}
+	return "", false
+}
+
+// CountWarningBanner renders the header banner shown when too many notifications are active.
+func CountWarningBanner(count, width int) string {
+	bannerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(ansiColorNumber(colors.Yellow)))
+	banner := fmt.Sprintf("%d active notifications — consider cleanup (tmux-intray cleanup)", count)
+	return bannerStyle.Render(truncateFooter(banner, width))
 }
 
 // Row renders a single notification row.
 func Row(state RowState) string {
 	levelIcon := levelIcon(state.Notification.Level.String())
 	statusIcon := statusIcon(state.Notification.State.String())
-	readIndicator := ReadStatusIndicator(state.Notification.IsRead(), state.Selected)
+	readIndicator := readStatusIndicator(state.Notification.IsRead(), state.Selected, selectionBackground(state.Selection))
 
 	message := state.Notification.Message
+	if state.Notification.Occurrences > 1 {
+		message = occurrencesTag(state.Notification.Occurrences) + message
+	}
+	if state.Notification.Owner != "" {
+		message = ownerTag(state.Notification.Owner) + message
+	}
+	if state.Notification.Important {
+		message = importantMarker + message
+	}
 	if len(message) > defaultMessageWidth {
 		message = message[:defaultMessageWidth-3] + "..."
 	}
@@ -108,7 +207,12 @@ func Row(state RowState) string {
 	session := state.SessionName
 	pane := state.Notification.Pane
 
-	messageWidth := calculateMessageWidth(state.Width)
+	source := state.SessionName
+	if source == "" {
+		source = state.Notification.Session
+	}
+	gutter := SourceGutter(state.SourceGutter, source) + Gutter(state.Selected, state.Selection)
+	messageWidth := calculateMessageWidth(state.Width - lipgloss.Width(gutter))
 	if state.Width == 0 || messageWidth < 10 {
 		messageWidth = defaultMessageWidth
 	}
@@ -136,11 +240,17 @@ func Row(state RowState) string {
 	}
 
 	if !state.Selected {
-		return strings.Join(columns, "  ")
+		levelStyle := lipgloss.NewStyle().Foreground(rowColor(state.Notification))
+		columns[1] = levelStyle.Render(columns[1])
+		if domain.IsValidColor(state.Notification.Color) {
+			columns[4] = levelStyle.Render(columns[4])
+		}
+		return gutter + strings.Join(columns, "  ")
 	}
 
-	selectedStyle := lipgloss.NewStyle().Background(lipgloss.Color(ansiColorNumber(colors.Blue))).Foreground(lipgloss.Color("0"))
+	selectedStyle := selectionStyle(state.Selection)
 	var row strings.Builder
+	row.WriteString(gutter)
 	for index, column := range columns {
 		if index > 0 {
 			row.WriteString(selectedStyle.Render("  "))
@@ -159,6 +269,9 @@ func Row(state RowState) string {
 func buildFullHelpSearchModeItems(state FooterState) []string {
 	var items []string
 	items = append(items, fmt.Sprintf("Search: %s", state.SearchQuery))
+	if count := searchMatchCount(state); count != "" {
+		items = append(items, count)
+	}
 	items = append(items, fmt.Sprintf("tab: %s", tabIndicator(state.ActiveTab)))
 	items = append(items, fmt.Sprintf("mode: %s", viewModeIndicator(state.ViewMode)))
 	items = append(items, fmt.Sprintf("read: %s", readFilterIndicator(state.ReadFilter)))
@@ -172,6 +285,7 @@ func buildFullHelpSearchModeItems(state FooterState) []string {
 	items = append(items, "R: read")
 	items = append(items, "u: unread")
 	items = append(items, "d: dismiss")
+	items = append(items, "Ctrl+z: undo")
 	items = append(items, "Enter: jump")
 	items = append(items, "q: quit")
 	items = append(items, "?: toggle help")
@@ -188,16 +302,27 @@ func buildFullHelpNormalModeItems(state FooterState) []string {
 	items = append(items, fmt.Sprintf("read: %s", readFilterIndicator(state.ReadFilter)))
 	items = append(items, "j/k: move")
 	items = append(items, "gg/G: top/bottom")
+	items = append(items, "]e/[e: next/prev error")
 	items = append(items, "/: search messages")
 	items = append(items, "Ctrl+v: cycle view mode")
 	if state.Grouped {
 		items = append(items, "h/l: collapse/expand")
 		items = append(items, "za: toggle fold")
+		items = append(items, "zf: focus group")
 		items = append(items, "D: dismiss group")
+		items = append(items, "o: jump to newest")
 	}
 	items = append(items, "R: read")
 	items = append(items, "u: unread")
+	items = append(items, "*: important")
+	items = append(items, "C: claim")
 	items = append(items, "d: dismiss")
+	items = append(items, "y: copy jump")
+	items = append(items, "Y: copy location")
+	items = append(items, "i: details")
+	items = append(items, ":clear: dismiss view")
+	items = append(items, "Ctrl+z: undo")
+	items = append(items, "f: focus")
 	enterHelp := "Enter: jump"
 	if state.Grouped {
 		enterHelp = "Enter: toggle/jump"
@@ -212,6 +337,9 @@ func buildFullHelpNormalModeItems(state FooterState) []string {
 func buildMinimalSearchModeItems(state FooterState) []string {
 	var items []string
 	items = append(items, fmt.Sprintf("Search: %s", state.SearchQuery))
+	if count := searchMatchCount(state); count != "" {
+		items = append(items, count)
+	}
 	items = append(items, fmt.Sprintf("tab: %s", tabIndicator(state.ActiveTab)))
 	items = append(items, "ESC: exit search")
 	items = append(items, "Ctrl+j/k: navigate")
@@ -245,6 +373,8 @@ func Footer(state FooterState) string {
 	// Error message is rendered above the footer, not included here
 
 	switch {
+	case state.CommandMode:
+		items = []string{":" + state.CommandQuery, "Enter: run", "ESC: cancel"}
 	case state.ShowHelp && state.SearchMode:
 		items = buildFullHelpSearchModeItems(state)
 	case state.ShowHelp && !state.SearchMode:
@@ -254,11 +384,14 @@ func Footer(state FooterState) string {
 	default: // !state.ShowHelp && !state.SearchMode
 		items = buildMinimalNormalModeItems(state)
 	}
+	if summary := groupCountSummary(state); summary != "" {
+		items = append([]string{summary}, items...)
+	}
 
 	// Apply styling to each item
 	var styledParts []string
 	for _, item := range items {
-		if strings.HasPrefix(item, "Search: ") {
+		if strings.HasPrefix(item, "Search: ") || (state.CommandMode && strings.HasPrefix(item, ":")) {
 			styledParts = append(styledParts, searchStyle.Render(item))
 		} else if item == "?: toggle help" && !state.ShowHelp {
 			styledParts = append(styledParts, hintStyle.Render(item))
@@ -273,6 +406,32 @@ func Footer(state FooterState) string {
 	return footer + "\x1b[K"
 }
 
+// searchMatchCount renders "12/340 match" for an active search query. It is
+// empty while the query is blank, so the count resets when the query clears.
+func searchMatchCount(state FooterState) string {
+	if !state.SearchMode || state.SearchQuery == "" {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d match", state.SearchMatches, state.SearchTotal)
+}
+
+// groupCountSummary renders "5 sessions · 12 windows · 40 notifications" for
+// grouped views. It is empty in command mode and outside grouped views.
+func groupCountSummary(state FooterState) string {
+	if !state.Grouped || state.CommandMode || len(state.GroupCounts) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(state.GroupCounts))
+	for _, count := range state.GroupCounts {
+		label := count.Kind
+		if count.Count != 1 {
+			label += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", count.Count, label))
+	}
+	return strings.Join(parts, " · ")
+}
+
 func truncateFooter(value string, width int) string {
 	if width <= 0 {
 		return value
@@ -291,6 +450,8 @@ func viewModeIndicator(mode string) string {
 		return "[G]"
 	case settings.ViewModeSearch:
 		return "[S]"
+	case settings.ViewModeSummary:
+		return "[Σ]"
 	default:
 		return "[?]"
 	}
@@ -336,6 +497,23 @@ func levelIcon(level string) string {
 	}
 }
 
+// levelColors are the level column colors, matching the default group badge colors.
+var levelColors = map[string]string{
+	"info":     colors.Blue,
+	"warning":  colors.Yellow,
+	"error":    colors.Red,
+	"critical": colors.Red,
+}
+
+// rowColor returns the notification's color override when it is valid, and
+// its level color otherwise. A valid override also colors the message.
+func rowColor(notif domain.Notification) lipgloss.Color {
+	if domain.IsValidColor(notif.Color) {
+		return lipgloss.Color(notif.Color)
+	}
+	return lipgloss.Color(ansiColorNumber(levelColors[notif.Level.String()]))
+}
+
 func statusIcon(state string) string {
 	switch state {
 	case "active", "":
@@ -347,8 +525,27 @@ func statusIcon(state string) string {
 	}
 }
 
+// importantMarker prefixes the message of notifications flagged important.
+const importantMarker = "★ "
+
+// ownerTag prefixes the message of claimed notifications with their owner.
+func ownerTag(owner string) string {
+	return "[" + owner + "] "
+}
+
+// occurrencesTag prefixes the message of notifications that
+// dedup.collapse_window collapsed repeats into, so the count survives message
+// truncation.
+func occurrencesTag(count int) string {
+	return fmt.Sprintf("(x%d) ", count)
+}
+
 // ReadStatusIndicator renders the read/unread indicator with color.
 func ReadStatusIndicator(isRead bool, isSelected bool) string {
+	return readStatusIndicator(isRead, isSelected, selectionBackground(settings.SelectionOptions{}))
+}
+
+func readStatusIndicator(isRead bool, isSelected bool, background lipgloss.Color) string {
 	symbol := "●"
 	style := lipgloss.NewStyle().Foreground(lipgloss.Color(ansiColorNumber(colors.Red)))
 	if isRead {
@@ -356,11 +553,77 @@ func ReadStatusIndicator(isRead bool, isSelected bool) string {
 		style = style.Foreground(lipgloss.Color("241"))
 	}
 	if isSelected {
-		style = style.Background(lipgloss.Color(ansiColorNumber(colors.Blue))).Bold(true)
+		style = style.Background(background).Bold(true)
 	}
 	return style.Width(readStatusWidth).Align(lipgloss.Left).Render(symbol)
 }
 
+// cursorGutterWidth is the width of the cursor column drawn when a cursor glyph is set.
+const cursorGutterWidth = 2
+
+// Gutter returns the cursor column drawn left of a row: the cursor glyph on the
+// selected row and blanks elsewhere. It is empty when no cursor glyph is set.
+func Gutter(selected bool, options settings.SelectionOptions) string {
+	if options.CursorGlyph == "" {
+		return ""
+	}
+	if selected {
+		return options.CursorGlyph + " "
+	}
+	return strings.Repeat(" ", cursorGutterWidth)
+}
+
+// sourceGutterGlyph is the bar drawn in the source gutter.
+const sourceGutterGlyph = "▌"
+
+// sourceGutterPalette lists the ANSI colors a source can be drawn in.
+var sourceGutterPalette = []string{"1", "2", "3", "4", "5", "6", "9", "10", "11", "12", "13", "14"}
+
+// SourceGutter returns the source column drawn left of a row when enabled: a
+// bar whose color is derived from source, so rows from the same source share a
+// color. Rows without a source, such as the header, get a blank column. It is
+// empty when disabled.
+func SourceGutter(enabled bool, source string) string {
+	if !enabled {
+		return ""
+	}
+	if source == "" {
+		return " "
+	}
+	return lipgloss.NewStyle().Foreground(SourceColor(source)).Render(sourceGutterGlyph)
+}
+
+// SourceColor returns the palette color for source. The same source always
+// gets the same color.
+func SourceColor(source string) lipgloss.Color {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(source))
+	return lipgloss.Color(sourceGutterPalette[hash.Sum32()%uint32(len(sourceGutterPalette))])
+}
+
+// selectionStyle returns the style of the row under the cursor.
+func selectionStyle(options settings.SelectionOptions) lipgloss.Style {
+	return lipgloss.NewStyle().
+		Background(selectionBackground(options)).
+		Foreground(selectionColor(options.Foreground, "0"))
+}
+
+func selectionBackground(options settings.SelectionOptions) lipgloss.Color {
+	return selectionColor(options.Background, ansiColorNumber(colors.Blue))
+}
+
+// selectionColor accepts an ANSI escape sequence or a lipgloss color value.
+func selectionColor(value, fallback string) lipgloss.Color {
+	switch {
+	case value == "":
+		return lipgloss.Color(fallback)
+	case strings.HasPrefix(value, "\x1b"):
+		return lipgloss.Color(ansiColorNumber(value))
+	default:
+		return lipgloss.Color(value)
+	}
+}
+
 func calculateAge(timestamp string, now time.Time) string {
 	if timestamp == "" {
 		return ""
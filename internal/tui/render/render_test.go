@@ -11,6 +11,7 @@ import (
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/muesli/termenv"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -119,6 +120,32 @@ func TestRowSessionAndPaneColumns(t *testing.T) {
 	assert.False(t, strings.Contains(row, "@2:%3"))
 }
 
+func TestRowMarksImportantNotifications(t *testing.T) {
+	notif := domain.Notification{ID: 1, Message: "Release tagged", Level: "info", State: "active"}
+	assert.NotContains(t, Row(RowState{Notification: notif, Width: 100}), "★")
+
+	notif.Important = true
+	assert.Contains(t, Row(RowState{Notification: notif, Width: 100}), "★ Release tagged")
+}
+
+func TestRowTagsClaimedNotificationsWithOwner(t *testing.T) {
+	notif := domain.Notification{ID: 1, Message: "Deploy failed", Level: "error", State: "active"}
+	assert.NotContains(t, Row(RowState{Notification: notif, Width: 100}), "[")
+
+	notif.Owner = "alice"
+	notif.Important = true
+	assert.Contains(t, Row(RowState{Notification: notif, Width: 100}), "★ [alice] Deploy failed")
+}
+
+func TestRowShowsOccurrenceCount(t *testing.T) {
+	notif := domain.Notification{ID: 1, Message: "No connection", Level: "error", State: "active"}
+	assert.NotContains(t, Row(RowState{Notification: notif, Width: 100}), "(x")
+
+	notif.Occurrences = 12
+	notif.Owner = "alice"
+	assert.Contains(t, Row(RowState{Notification: notif, Width: 100}), "[alice] (x12) No connection")
+}
+
 func TestRenderGroupRowIndentationAndSymbol(t *testing.T) {
 	styles := GroupRowStyles{
 		Base:     lipgloss.NewStyle(),
@@ -156,6 +183,75 @@ func TestRenderGroupRowIndentationAndSymbol(t *testing.T) {
 	assert.True(t, strings.HasPrefix(row, "    ▸ win-1 (2)"))
 }
 
+func TestRenderGroupRowUsesConfiguredFoldGlyphs(t *testing.T) {
+	styles := GroupRowStyles{
+		Base:     lipgloss.NewStyle(),
+		Selected: lipgloss.NewStyle(),
+	}
+	options := disabledGroupHeaderOptions()
+	options.CollapsedGlyph = "+"
+	options.ExpandedGlyph = "-"
+
+	expanded := RenderGroupRow(GroupRow{
+		Node:    &GroupNode{Title: "session-one", Expanded: true, Count: 3},
+		Width:   80,
+		Styles:  &styles,
+		Options: options,
+	})
+	assert.True(t, strings.HasPrefix(expanded, "- session-one (3)"))
+
+	collapsed := RenderGroupRow(GroupRow{
+		Node:    &GroupNode{Title: "session-one", Expanded: false, Count: 3},
+		Width:   80,
+		Styles:  &styles,
+		Options: options,
+	})
+	assert.True(t, strings.HasPrefix(collapsed, "+ session-one (3)"))
+	assert.NotContains(t, collapsed, "▸")
+}
+
+func TestRenderGroupRowShowsStaleBadge(t *testing.T) {
+	styles := GroupRowStyles{
+		Base:     lipgloss.NewStyle(),
+		Selected: lipgloss.NewStyle(),
+	}
+	options := disabledGroupHeaderOptions()
+
+	stale := RenderGroupRow(GroupRow{
+		Node:    &GroupNode{Title: "session-one", Expanded: true, Count: 2, Stale: true},
+		Width:   80,
+		Styles:  &styles,
+		Options: options,
+	})
+	assert.Contains(t, stale, "session-one (2)  "+staleBadge)
+
+	live := RenderGroupRow(GroupRow{
+		Node:    &GroupNode{Title: "session-one", Expanded: true, Count: 2},
+		Width:   80,
+		Styles:  &styles,
+		Options: options,
+	})
+	assert.NotContains(t, live, staleBadge)
+}
+
+func TestRenderGroupRowColorsFoldGlyph(t *testing.T) {
+	styles := GroupRowStyles{
+		Base:     lipgloss.NewStyle(),
+		Selected: lipgloss.NewStyle(),
+	}
+	options := disabledGroupHeaderOptions()
+	options.FoldColor = colors.Red
+	foldStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(ansiColorNumber(colors.Red)))
+
+	row := RenderGroupRow(GroupRow{
+		Node:    &GroupNode{Title: "session-one", Expanded: true, Count: 1},
+		Width:   80,
+		Styles:  &styles,
+		Options: options,
+	})
+	assert.Contains(t, row, foldStyle.Render("▾"))
+}
+
 func TestRenderGroupRowTruncatesToWidth(t *testing.T) {
 	styles := GroupRowStyles{
 		Base:     lipgloss.NewStyle(),
@@ -234,6 +330,16 @@ func TestRenderGroupRowDisplaysSources(t *testing.T) {
 	assert.Contains(t, stripANSI(row), "src: pane1,pane2")
 }
 
+func TestTabLabelNamesActiveTabAndCount(t *testing.T) {
+	label := stripANSI(TabLabel(settings.TabAll, 12, 40))
+	assert.True(t, strings.HasPrefix(label, "── All (12) ─"), label)
+	assert.Equal(t, 40, utf8.RuneCountInString(label))
+
+	assert.Contains(t, stripANSI(TabLabel(settings.TabRecents, 3, 40)), "── Recents (3) ")
+	assert.Contains(t, stripANSI(TabLabel(settings.TabSessions, 0, 40)), "── Sessions (0) ")
+	assert.Equal(t, "── All", stripANSI(TabLabel(settings.TabAll, 12, 6)))
+}
+
 func TestTabsRendersActiveIndicator(t *testing.T) {
 	recents := Tabs(settings.TabRecents, 80)
 	assert.Contains(t, stripANSI(recents), "[Recents]")
@@ -251,6 +357,33 @@ func TestTabsRendersActiveIndicator(t *testing.T) {
 	assert.Contains(t, stripANSI(sessions), "[Sessions]")
 }
 
+func TestFooterShowsGroupCountSummaryWhenGrouped(t *testing.T) {
+	counts := []GroupCount{{Kind: "session", Count: 5}, {Kind: "window", Count: 1}, {Kind: "notification", Count: 40}}
+
+	footer := Footer(FooterState{Grouped: true, ViewMode: settings.ViewModeGrouped, GroupCounts: counts})
+	assert.Contains(t, footer, "5 sessions · 1 window · 40 notifications")
+
+	footer = Footer(FooterState{ViewMode: settings.ViewModeDetailed, GroupCounts: counts})
+	assert.NotContains(t, footer, "5 sessions")
+
+	footer = Footer(FooterState{Grouped: true, CommandMode: true, GroupCounts: counts})
+	assert.NotContains(t, footer, "5 sessions")
+}
+
+func TestFooterShowsSearchMatchCount(t *testing.T) {
+	footer := Footer(FooterState{SearchMode: true, SearchQuery: "build", SearchMatches: 12, SearchTotal: 340})
+	assert.Contains(t, stripANSI(footer), "12/340 match")
+
+	footer = Footer(FooterState{SearchMode: true, ShowHelp: true, SearchQuery: "build", SearchMatches: 3, SearchTotal: 340})
+	assert.Contains(t, stripANSI(footer), "3/340 match")
+
+	footer = Footer(FooterState{SearchMode: true, SearchMatches: 340, SearchTotal: 340})
+	assert.NotContains(t, stripANSI(footer), "match", "an empty query shows no count")
+
+	footer = Footer(FooterState{SearchQuery: "build", SearchMatches: 12, SearchTotal: 340})
+	assert.NotContains(t, stripANSI(footer), "12/340", "the count only shows while searching")
+}
+
 func TestFooterGroupedHelpText(t *testing.T) {
 	footer := Footer(FooterState{Grouped: true, ViewMode: settings.ViewModeGrouped, ActiveTab: settings.TabRecents, ShowHelp: true})
 
@@ -474,3 +607,190 @@ var ansiRegexp = regexp.MustCompile(`\x1b\[[0-9;]*m`)
 func stripANSI(input string) string {
 	return ansiRegexp.ReplaceAllString(input, "")
 }
+
+func TestRowAppliesSelectionOptionsToSelectedRowOnly(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	selection := settings.SelectionOptions{Background: "22", Foreground: "#ffffff", CursorGlyph: "➜"}
+	notif := domain.Notification{ID: 1, Message: "Build finished", Level: "info", State: "active"}
+
+	selected := Row(RowState{Notification: notif, Width: 100, Selected: true, Selection: selection})
+	assert.True(t, strings.HasPrefix(selected, "➜ "))
+	assert.Contains(t, selected, "48;5;22")
+	assert.Contains(t, selected, "38;5;231")
+
+	other := Row(RowState{Notification: notif, Width: 100, Selection: selection})
+	assert.True(t, strings.HasPrefix(other, "  "))
+	assert.NotContains(t, other, "➜")
+	assert.NotContains(t, other, "48;5;22")
+}
+
+func TestRowWithoutCursorGlyphHasNoGutter(t *testing.T) {
+	notif := domain.Notification{ID: 1, Message: "Build finished", Level: "info", State: "active"}
+	row := Row(RowState{Notification: notif, Width: 100, Selected: true})
+	assert.True(t, strings.HasPrefix(row, ReadStatusIndicator(false, true)))
+}
+
+func TestRenderGroupRowAppliesSelectionOptionsToSelectedRowOnly(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	selection := settings.SelectionOptions{Background: "\x1b[0;35m", CursorGlyph: "›"}
+	node := &GroupNode{Title: "$1", Display: "work", Expanded: true, Count: 2}
+
+	selected := RenderGroupRow(GroupRow{Node: node, Selected: true, Width: 60, Selection: selection})
+	assert.True(t, strings.HasPrefix(selected, "› "))
+	assert.Contains(t, selected, "48;5;35")
+
+	other := RenderGroupRow(GroupRow{Node: node, Width: 60, Selection: selection})
+	assert.True(t, strings.HasPrefix(other, "  "))
+	assert.NotContains(t, other, "›")
+	assert.NotContains(t, other, "48;5;35")
+}
+
+func TestFlashHeaderHighlightsHeaderText(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	flash := FlashHeader(80)
+	assert.Contains(t, flash, "MESSAGE")
+	assert.NotEqual(t, Header(80), flash)
+	assert.Equal(t, stripANSI(Header(80)), stripANSI(flash))
+}
+
+func TestRowUsesColorOverrideAndFallsBackToLevelColor(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	notif := domain.Notification{ID: 1, Message: "Deploy blocked", Level: "error", State: "active"}
+	levelColored := Row(RowState{Notification: notif, Width: 100})
+	assert.Contains(t, levelColored, "38;5;31m❌ err", "error rows use the red level color")
+
+	notif.Color = "208"
+	overridden := Row(RowState{Notification: notif, Width: 100})
+	assert.Contains(t, overridden, "38;5;208m❌ err")
+	assert.Contains(t, overridden, "38;5;208mDeploy blocked")
+	assert.Equal(t, stripANSI(levelColored), stripANSI(overridden))
+
+	notif.Color = "orange"
+	assert.Equal(t, levelColored, Row(RowState{Notification: notif, Width: 100}), "an invalid color falls back to the level color")
+}
+
+func TestHeaderSortFieldMapsColumnsToSortFields(t *testing.T) {
+	header := headerText(120)
+	column := func(name string) int {
+		t.Helper()
+		index := strings.LastIndex(header, name)
+		if index < 0 {
+			t.Fatalf("header %q has no %s column", header, name)
+		}
+		return index
+	}
+
+	tests := []struct {
+		name   string
+		x      int
+		field  string
+		wantOK bool
+	}{
+		{"type", column("TYPE"), settings.SortByLevel, true},
+		{"type last cell", column("TYPE") + typeWidth - 1, settings.SortByLevel, true},
+		{"status", column("STATUS") + 2, settings.SortByState, true},
+		{"session", column("SESSION"), settings.SortBySession, true},
+		{"age", column("AGE"), settings.SortByTimestamp, true},
+		{"read column", column("RD"), "", false},
+		{"message", column("MESSAGE"), "", false},
+		{"pane", column("PANE"), "", false},
+		{"gap", column("TYPE") - 1, "", false},
+		{"past the end", len(header) + 5, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, ok := HeaderSortField(tt.x, 120)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.field, field)
+		})
+	}
+}
+
+func TestRowSourceGutterColorFollowsSession(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	row := func(id int, session string) string {
+		notif := domain.Notification{ID: id, Message: "Build finished", Level: "info", State: "active", Session: "$" + session}
+		return Row(RowState{Notification: notif, SessionName: session, Width: 100, SourceGutter: true})
+	}
+	gutter := func(row string) string {
+		return row[:strings.Index(row, sourceGutterGlyph)+len(sourceGutterGlyph)]
+	}
+
+	work, workAgain, web := row(1, "work"), row(2, "work"), row(3, "web")
+	assert.True(t, strings.HasPrefix(stripANSI(work), sourceGutterGlyph))
+	assert.Equal(t, gutter(work), gutter(workAgain), "the same source gets the same color")
+	assert.NotEqual(t, gutter(work), gutter(web), "different sources get different colors")
+	assert.Equal(t, SourceColor("work"), SourceColor("work"))
+
+	plain := Row(RowState{Notification: domain.Notification{ID: 1, Message: "Build finished", Level: "info", State: "active"}, Width: 100})
+	assert.NotContains(t, plain, sourceGutterGlyph, "the gutter is off unless enabled")
+	assert.Equal(t, lipgloss.Width(plain), lipgloss.Width(work), "the gutter takes its column from the message")
+	assert.Equal(t, " ", SourceGutter(true, ""), "rows without a source keep the column blank")
+}
+
+func TestNotificationDetailsShowsLastSeen(t *testing.T) {
+	now := time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC)
+	details := Details{
+		Notification: domain.Notification{
+			ID:        7,
+			Timestamp: "2025-01-01T14:00:00Z",
+			Message:   "build failed",
+			Level:     domain.LevelError,
+			Owner:     "alice",
+		},
+		Location:           "api:editor.0",
+		PreviousOccurrence: "2025-01-01T12:00:00Z",
+		Width:              80,
+		Now:                now,
+	}
+
+	out := NotificationDetails(details)
+	assert.Contains(t, out, "Notification 7")
+	assert.Contains(t, out, "Level: error")
+	assert.Contains(t, out, "Location: api:editor.0")
+	assert.Contains(t, out, "Owner: alice")
+	assert.Contains(t, out, "Last seen: 2025-01-01T12:00:00Z (3h ago)")
+	assert.Contains(t, out, "build failed")
+
+	details.PreviousOccurrence = ""
+	assert.Contains(t, NotificationDetails(details), "Last seen: first occurrence")
+}
+
+func TestNotificationDetailsPrefersFullMessage(t *testing.T) {
+	out := NotificationDetails(Details{
+		Notification: domain.Notification{ID: 7, Message: "build fai…"},
+		FullMessage:  "build failed: exit status 2",
+		Width:        80,
+	})
+
+	assert.Contains(t, out, "build failed: exit status 2")
+	assert.NotContains(t, out, "build fai…")
+	assert.NotContains(t, out, "Pane context:")
+}
+
+func TestNotificationDetailsShowsPaneContext(t *testing.T) {
+	out := NotificationDetails(Details{
+		Notification: domain.Notification{ID: 7, Message: "build failed"},
+		PaneContext:  "$ make test\nFAIL\n",
+		Width:        80,
+	})
+
+	assert.Contains(t, out, "Pane context:")
+	assert.Contains(t, out, "$ make test")
+	assert.Contains(t, out, "FAIL")
+}
@@ -46,6 +46,20 @@ func TestSortNotificationsUnreadFirstKeepsSortOrderWithinBuckets(t *testing.T) {
 	assert.Equal(t, []int{10, 11, 12, 13}, []int{sorted[0].ID, sorted[1].ID, sorted[2].ID, sorted[3].ID})
 }
 
+func TestApplyFiltersAndSearchSortsByPriority(t *testing.T) {
+	svc := NewNotificationService(nil, nil)
+	svc.SetNotifications([]domain.Notification{
+		{ID: 1, Message: "generic error", Timestamp: "2024-01-01T12:00:00Z", State: domain.StateActive, Level: domain.LevelError},
+		{ID: 2, Message: "deploy error", Timestamp: "2024-01-01T10:00:00Z", State: domain.StateActive, Level: domain.LevelError, Priority: 10},
+		{ID: 3, Message: "lint warning", Timestamp: "2024-01-01T11:00:00Z", State: domain.StateActive, Level: domain.LevelWarning},
+	})
+
+	svc.ApplyFiltersAndSearch(settings.TabAll, "", "", "", "", "", "", "", settings.SortByPriority, settings.SortOrderDesc)
+	filtered := svc.GetFilteredNotifications()
+	require.Len(t, filtered, 3)
+	assert.Equal(t, []int{2, 1, 3}, []int{filtered[0].ID, filtered[1].ID, filtered[2].ID})
+}
+
 func TestFilterByReadStatus(t *testing.T) {
 	svc := NewNotificationService(nil, nil)
 	notifications := []domain.Notification{
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// unknownTimeBucket titles the group for notifications whose timestamp cannot be parsed.
+const unknownTimeBucket = "unknown date"
+
+// timeBucketTitle returns the group title for a notification timestamp.
+// Day buckets are titled "2006-01-02 Mon". Week buckets use the ISO week
+// ("2006-W01") when weeks start on Monday, otherwise "week of 2006-01-02".
+// Titles sort chronologically within a bucket kind.
+func (s *DefaultTreeService) timeBucketTitle(kind model.NodeKind, timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return unknownTimeBucket
+	}
+	t = t.Local()
+
+	if kind == model.NodeKindDay {
+		return t.Format("2006-01-02 Mon")
+	}
+
+	if s.weekStart == time.Monday {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	}
+	return "week of " + weekStartDate(t, s.weekStart).Format("2006-01-02")
+}
+
+// weekStartDate returns the date on or before t that falls on weekStart.
+func weekStartDate(t time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
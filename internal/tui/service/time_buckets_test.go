@@ -0,0 +1,92 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func localTimestamp(year int, month time.Month, day, hour int) string {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.Local).Format(time.RFC3339)
+}
+
+// timeBucketNotifications spans Saturday 2026-03-07 to Tuesday 2026-03-10.
+func timeBucketNotifications() []domain.Notification {
+	return []domain.Notification{
+		{ID: 1, Message: "saturday", Timestamp: localTimestamp(2026, time.March, 7, 23)},
+		{ID: 2, Message: "sunday", Timestamp: localTimestamp(2026, time.March, 8, 10)},
+		{ID: 3, Message: "monday", Timestamp: localTimestamp(2026, time.March, 9, 0)},
+		{ID: 4, Message: "tuesday", Timestamp: localTimestamp(2026, time.March, 10, 9)},
+		{ID: 5, Message: "tuesday again", Timestamp: localTimestamp(2026, time.March, 10, 18)},
+	}
+}
+
+func bucketCounts(root *model.TreeNode) map[string]int {
+	counts := make(map[string]int)
+	for _, child := range root.Children {
+		counts[child.Title] = child.Count
+	}
+	return counts
+}
+
+func TestBuildTreeGroupsByDay(t *testing.T) {
+	service := NewTreeService(model.GroupByDay).(*DefaultTreeService)
+
+	require.NoError(t, service.BuildTree(timeBucketNotifications(), settings.GroupByDay))
+
+	root := service.GetTreeRoot()
+	require.Len(t, root.Children, 4)
+	for _, child := range root.Children {
+		assert.Equal(t, model.NodeKindDay, child.Kind)
+	}
+	assert.Equal(t, "2026-03-07 Sat", root.Children[0].Title)
+	assert.Equal(t, map[string]int{
+		"2026-03-07 Sat": 1,
+		"2026-03-08 Sun": 1,
+		"2026-03-09 Mon": 1,
+		"2026-03-10 Tue": 2,
+	}, bucketCounts(root))
+}
+
+func TestBuildTreeGroupsByISOWeekStartingMonday(t *testing.T) {
+	service := NewTreeService(model.GroupByWeek).(*DefaultTreeService)
+
+	require.NoError(t, service.BuildTree(timeBucketNotifications(), settings.GroupByWeek))
+
+	root := service.GetTreeRoot()
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, model.NodeKindWeek, root.Children[0].Kind)
+	assert.Equal(t, map[string]int{
+		"2026-W10": 2,
+		"2026-W11": 3,
+	}, bucketCounts(root))
+}
+
+func TestBuildTreeGroupsByWeekStartingSunday(t *testing.T) {
+	service := NewTreeService(model.GroupByWeek).(*DefaultTreeService)
+	service.SetWeekStart(settings.WeekStartDay(settings.WeekStartSunday))
+
+	require.NoError(t, service.BuildTree(timeBucketNotifications(), settings.GroupByWeek))
+
+	assert.Equal(t, map[string]int{
+		"week of 2026-03-01": 1,
+		"week of 2026-03-08": 4,
+	}, bucketCounts(service.GetTreeRoot()))
+}
+
+func TestBuildTreeTimeBucketUnparseableTimestamp(t *testing.T) {
+	service := NewTreeService(model.GroupByDay).(*DefaultTreeService)
+
+	require.NoError(t, service.BuildTree([]domain.Notification{{ID: 1, Message: "odd", Timestamp: "yesterday"}}, settings.GroupByDay))
+
+	root := service.GetTreeRoot()
+	require.Len(t, root.Children, 1)
+	assert.Equal(t, unknownTimeBucket, root.Children[0].Title)
+	require.Len(t, root.Children[0].Children, 1)
+	assert.Equal(t, model.NodeKindNotification, root.Children[0].Children[0].Kind)
+}
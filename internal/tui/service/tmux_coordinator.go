@@ -181,6 +181,11 @@ func (c *DefaultRuntimeCoordinator) RefreshNames() error {
 	return nil
 }
 
+// CopyToClipboard copies text into a tmux paste buffer and the system clipboard.
+func (c *DefaultRuntimeCoordinator) CopyToClipboard(text string) error {
+	return c.client.SetBuffer(text)
+}
+
 // GetTmuxVisibility returns the visibility state from tmux environment.
 func (c *DefaultRuntimeCoordinator) GetTmuxVisibility() (bool, error) {
 	value := core.GetTmuxVisibility()
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// SetMaxDepth caps how many tree levels are visible. Children of an expanded
+// group at the cap are replaced by a single "+N more" summary row until that
+// row is expanded. Zero or less shows every level.
+func (s *DefaultTreeService) SetMaxDepth(depth int) {
+	if depth < 0 {
+		depth = 0
+	}
+	s.maxDepth = depth
+	s.InvalidateCache()
+}
+
+// depthCapped reports whether the children of node, at depth, are hidden
+// behind a summary row. Expanding that row reveals every level below node.
+func (s *DefaultTreeService) depthCapped(node *model.TreeNode, depth int, identifier string) bool {
+	if s.maxDepth <= 0 || depth < s.maxDepth || len(node.Children) == 0 {
+		return false
+	}
+	return !s.depthRevealed[identifier]
+}
+
+// newMoreNode builds the summary row that stands in for the hidden children
+// of parent. It is not part of the tree and is rebuilt with the visible nodes.
+func (s *DefaultTreeService) newMoreNode(parent *model.TreeNode, identifier string) *model.TreeNode {
+	title := fmt.Sprintf("+%d more", len(parent.Children))
+	more := &model.TreeNode{
+		Kind:          model.NodeKindMore,
+		Title:         title,
+		Display:       title,
+		Count:         parent.Count,
+		UnreadCount:   parent.UnreadCount,
+		LatestEvent:   parent.LatestEvent,
+		EarliestEvent: parent.EarliestEvent,
+		LevelCounts:   parent.LevelCounts,
+		Sources:       parent.Sources,
+	}
+	s.moreNodes[more] = moreNodeTarget{parent: parent, identifier: identifier}
+	return more
+}
+
+// revealMoreNode shows the levels hidden behind a summary row. It reports
+// whether node was a summary row.
+func (s *DefaultTreeService) revealMoreNode(node *model.TreeNode) bool {
+	target, ok := s.moreNodes[node]
+	if !ok {
+		return false
+	}
+	s.depthRevealed[target.identifier] = true
+	s.InvalidateCache()
+	return true
+}
+
+// childIdentifier extends a parent identifier the way GetNodeIdentifier
+// builds group paths.
+func childIdentifier(parent string, node *model.TreeNode) string {
+	identifier := string(node.Kind) + ":" + node.Title
+	if parent == "" {
+		return identifier
+	}
+	return parent + ":" + identifier
+}
@@ -36,6 +36,11 @@ func (s *DefaultTreeService) getOrCreateGroupNode(parent *model.TreeNode, cache
 	return node
 }
 
+// isUnassigned reports whether notif has no session, window or pane.
+func isUnassigned(notif domain.Notification) bool {
+	return notif.Session == "" && notif.Window == "" && notif.Pane == ""
+}
+
 func (s *DefaultTreeService) incrementGroupStats(node *model.TreeNode, notif domain.Notification) {
 	if node == nil {
 		return
@@ -45,6 +50,7 @@ func (s *DefaultTreeService) incrementGroupStats(node *model.TreeNode, notif dom
 	s.updateTimeRange(node, notif)
 	s.updateLevelCounts(node, notif)
 	s.updateSourceSet(node, notif)
+	s.updateStale(node, notif)
 }
 
 func (s *DefaultTreeService) isNewerTimestamp(current string, latest string) bool {
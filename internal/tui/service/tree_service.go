@@ -2,6 +2,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/cristianoliveira/tmux-intray/internal/dedup"
 	"github.com/cristianoliveira/tmux-intray/internal/dedupconfig"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -16,30 +18,65 @@ type DefaultTreeService struct {
 	visibleNodes      []*model.TreeNode
 	visibleNodesCache []*model.TreeNode
 	cacheValid        bool
+	weekStart         time.Weekday
+	groupUnassigned   bool
+	maxDepth          int
+	depthRevealed     map[string]bool
+	moreNodes         map[*model.TreeNode]moreNodeTarget
+	paneExists        func(sessionID, windowID, paneID string) bool
+	stalePanes        map[string]bool
+}
+
+// moreNodeTarget is the group whose children a "+N more" row hides.
+type moreNodeTarget struct {
+	parent     *model.TreeNode
+	identifier string
 }
 
+// UnassignedGroupTitle is the title of the group holding notifications that
+// have no session, window or pane.
+const UnassignedGroupTitle = "(unassigned)"
+
 type treeBuildOptions struct {
 	includeSession           bool
 	includeWindow            bool
 	includePane              bool
 	groupByMessage           bool
 	appendNotificationLeaves bool
+	timeBucket               model.NodeKind
 }
 
 type treeBuildCaches struct {
-	sessionNodes map[string]*model.TreeNode
-	windowNodes  map[string]*model.TreeNode
-	paneNodes    map[string]*model.TreeNode
-	messageNodes map[string]*model.TreeNode
+	sessionNodes    map[string]*model.TreeNode
+	windowNodes     map[string]*model.TreeNode
+	paneNodes       map[string]*model.TreeNode
+	messageNodes    map[string]*model.TreeNode
+	timeNodes       map[string]*model.TreeNode
+	unassignedNodes map[string]*model.TreeNode
 }
 
 // NewTreeService creates a new DefaultTreeService.
 func NewTreeService(groupBy model.GroupBy) model.TreeService {
 	return &DefaultTreeService{
-		groupBy: groupBy,
+		groupBy:         groupBy,
+		weekStart:       time.Monday,
+		groupUnassigned: true,
+		depthRevealed:   make(map[string]bool),
+		moreNodes:       make(map[*model.TreeNode]moreNodeTarget),
 	}
 }
 
+// SetWeekStart sets the weekday that opens each bucket when grouping by week.
+func (s *DefaultTreeService) SetWeekStart(weekStart time.Weekday) {
+	s.weekStart = weekStart
+}
+
+// SetGroupUnassigned sets whether notifications with no session, window or
+// pane are collected under a single unassigned group.
+func (s *DefaultTreeService) SetGroupUnassigned(enabled bool) {
+	s.groupUnassigned = enabled
+}
+
 // BuildTree creates a tree structure from a list of notifications.
 func (s *DefaultTreeService) BuildTree(notifications []domain.Notification, groupBy string) error {
 	resolvedGroupBy := s.resolveGroupBy(groupBy)
@@ -53,6 +90,7 @@ func (s *DefaultTreeService) BuildTree(notifications []domain.Notification, grou
 	}
 
 	caches := newTreeBuildCaches()
+	s.stalePanes = nil
 	messageKeys := buildMessageKeys(notifications, options.groupByMessage)
 
 	for idx, notif := range notifications {
@@ -79,6 +117,13 @@ func (s *DefaultTreeService) newTreeBuildOptions(groupBy string) treeBuildOption
 		groupBy == settings.GroupByMessage ||
 		groupBy == settings.GroupByPaneMessage
 	groupByMessage := groupBy == settings.GroupByMessage || groupBy == settings.GroupByPaneMessage
+	var timeBucket model.NodeKind
+	switch groupBy {
+	case settings.GroupByDay:
+		timeBucket = model.NodeKindDay
+	case settings.GroupByWeek:
+		timeBucket = model.NodeKindWeek
+	}
 
 	return treeBuildOptions{
 		includeSession:           includeSession,
@@ -86,15 +131,18 @@ func (s *DefaultTreeService) newTreeBuildOptions(groupBy string) treeBuildOption
 		includePane:              includePane,
 		groupByMessage:           groupByMessage,
 		appendNotificationLeaves: groupBy != settings.GroupByPaneMessage,
+		timeBucket:               timeBucket,
 	}
 }
 
 func newTreeBuildCaches() treeBuildCaches {
 	return treeBuildCaches{
-		sessionNodes: make(map[string]*model.TreeNode),
-		windowNodes:  make(map[string]*model.TreeNode),
-		paneNodes:    make(map[string]*model.TreeNode),
-		messageNodes: make(map[string]*model.TreeNode),
+		sessionNodes:    make(map[string]*model.TreeNode),
+		windowNodes:     make(map[string]*model.TreeNode),
+		paneNodes:       make(map[string]*model.TreeNode),
+		messageNodes:    make(map[string]*model.TreeNode),
+		timeNodes:       make(map[string]*model.TreeNode),
+		unassignedNodes: make(map[string]*model.TreeNode),
 	}
 }
 
@@ -111,20 +159,34 @@ func (s *DefaultTreeService) addNotificationToTree(root *model.TreeNode, notif d
 	parent := root
 	paneKey := ""
 
-	if options.includeSession {
+	if options.timeBucket != "" {
+		title := s.timeBucketTitle(options.timeBucket, notif.Timestamp)
+		timeNode := s.getOrCreateGroupNode(root, caches.timeNodes, options.timeBucket, title)
+		s.incrementGroupStats(timeNode, notif)
+		parent = timeNode
+	}
+
+	unassigned := options.includeSession && s.groupUnassigned && isUnassigned(notif)
+	if unassigned {
+		unassignedNode := s.getOrCreateGroupNode(root, caches.unassignedNodes, model.NodeKindUnassigned, UnassignedGroupTitle)
+		s.incrementGroupStats(unassignedNode, notif)
+		parent = unassignedNode
+	}
+
+	if options.includeSession && !unassigned {
 		sessionNode := s.getOrCreateGroupNode(root, caches.sessionNodes, model.NodeKindSession, notif.Session)
 		s.incrementGroupStats(sessionNode, notif)
 		parent = sessionNode
 	}
 
-	if options.includeWindow {
+	if options.includeWindow && !unassigned {
 		windowKey := notif.Session + "\x00" + notif.Window
 		windowNode := s.getOrCreateGroupNode(parent, caches.windowNodes, model.NodeKindWindow, windowKey, notif.Window)
 		s.incrementGroupStats(windowNode, notif)
 		parent = windowNode
 	}
 
-	if options.includePane {
+	if options.includePane && !unassigned {
 		paneKey = notif.Session + "\x00" + notif.Window + "\x00" + notif.Pane
 		paneNode := s.getOrCreateGroupNode(parent, caches.paneNodes, model.NodeKindPane, paneKey, notif.Pane)
 		s.incrementGroupStats(paneNode, notif)
@@ -200,8 +262,11 @@ func (s *DefaultTreeService) GetVisibleNodes() []*model.TreeNode {
 	}
 
 	var visible []*model.TreeNode
-	var walk func(node *model.TreeNode)
-	walk = func(node *model.TreeNode) {
+	s.moreNodes = make(map[*model.TreeNode]moreNodeTarget)
+	// revealed is set below a group whose summary row was expanded, so its
+	// whole subtree shows regardless of depth.
+	var walk func(node *model.TreeNode, depth int, identifier string, revealed bool)
+	walk = func(node *model.TreeNode, depth int, identifier string, revealed bool) {
 		if node == nil {
 			return
 		}
@@ -214,12 +279,17 @@ func (s *DefaultTreeService) GetVisibleNodes() []*model.TreeNode {
 		if node.Kind != model.NodeKindRoot && !node.Expanded {
 			return
 		}
+		if !revealed && s.depthCapped(node, depth, identifier) {
+			visible = append(visible, s.newMoreNode(node, identifier))
+			return
+		}
+		revealed = revealed || (s.maxDepth > 0 && depth >= s.maxDepth)
 		for _, child := range node.Children {
-			walk(child)
+			walk(child, depth+1, childIdentifier(identifier, child), revealed)
 		}
 	}
 
-	walk(s.treeRoot)
+	walk(s.treeRoot, 0, "", false)
 	s.visibleNodes = visible
 	s.visibleNodesCache = visible
 	s.cacheValid = true
@@ -302,6 +372,9 @@ func (s *DefaultTreeService) ExpandNode(node *model.TreeNode) {
 	if node == nil || node.Kind == model.NodeKindNotification {
 		return
 	}
+	if s.revealMoreNode(node) {
+		return
+	}
 	if node.Expanded {
 		return
 	}
@@ -318,6 +391,9 @@ func (s *DefaultTreeService) CollapseNode(node *model.TreeNode) {
 		return
 	}
 	node.Expanded = false
+	if s.maxDepth > 0 {
+		delete(s.depthRevealed, s.GetNodeIdentifier(node))
+	}
 	s.InvalidateCache()
 }
 
@@ -326,6 +402,9 @@ func (s *DefaultTreeService) ToggleNodeExpansion(node *model.TreeNode) {
 	if node == nil || node.Kind == model.NodeKindNotification {
 		return
 	}
+	if s.revealMoreNode(node) {
+		return
+	}
 	node.Expanded = !node.Expanded
 	s.InvalidateCache()
 }
@@ -344,7 +423,48 @@ func (s *DefaultTreeService) GetTreeLevel(node *model.TreeNode) int {
 		return 2
 	case model.NodeKindMessage:
 		return 3
+	case model.NodeKindMore:
+		return s.GetTreeLevel(s.moreNodes[node].parent) + 1
 	default:
 		return 0
 	}
 }
+
+// GroupCounts returns the number of group nodes of each kind in the current
+// tree, outermost first, followed by the number of notifications. Group kinds
+// are ordered by the depth at which they first appear.
+func (s *DefaultTreeService) GroupCounts() []model.GroupCount {
+	if s.treeRoot == nil {
+		return nil
+	}
+	var counts []model.GroupCount
+	index := make(map[model.NodeKind]int)
+	notifications := 0
+	level := []*model.TreeNode{s.treeRoot}
+	for len(level) > 0 {
+		var next []*model.TreeNode
+		for _, node := range level {
+			for _, child := range node.Children {
+				if child.Kind == model.NodeKindNotification {
+					notifications++
+					continue
+				}
+				if i, ok := index[child.Kind]; ok {
+					counts[i].Count++
+				} else {
+					index[child.Kind] = len(counts)
+					counts = append(counts, model.GroupCount{Kind: child.Kind, Count: 1})
+				}
+				next = append(next, child)
+			}
+		}
+		level = next
+	}
+	if notifications == 0 && len(counts) > 0 {
+		// Some groupings (pane_message) keep no leaves; use the group totals.
+		for _, child := range s.treeRoot.Children {
+			notifications += child.Count
+		}
+	}
+	return append(counts, model.GroupCount{Kind: model.NodeKindNotification, Count: notifications})
+}
@@ -204,6 +204,198 @@ func TestGetVisibleNodesCacheConsistencyAndRefreshAfterInvalidation(t *testing.T
 	assert.True(t, service.cacheValid)
 }
 
+func TestGroupCountsMatchesTreeStructure(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	assert.Nil(t, service.GroupCounts())
+
+	notifications := append(sampleNotifications(), domain.Notification{
+		ID:        3,
+		Timestamp: "2025-01-01T10:02:00Z",
+		Session:   "session-a",
+		Window:    "window-3",
+		Pane:      "pane-3",
+		Message:   "third",
+	})
+	require.NoError(t, service.BuildTree(notifications, settings.GroupByWindow))
+	assert.Equal(t, []model.GroupCount{
+		{Kind: model.NodeKindSession, Count: 2},
+		{Kind: model.NodeKindWindow, Count: 3},
+		{Kind: model.NodeKindNotification, Count: 3},
+	}, service.GroupCounts())
+
+	require.NoError(t, service.BuildTree(notifications, settings.GroupBySession))
+	assert.Equal(t, []model.GroupCount{
+		{Kind: model.NodeKindSession, Count: 2},
+		{Kind: model.NodeKindNotification, Count: 3},
+	}, service.GroupCounts())
+}
+
+func TestBuildTreeGroupsLocationlessNotificationsAsUnassigned(t *testing.T) {
+	notifications := append(sampleNotifications(),
+		domain.Notification{ID: 3, Timestamp: "2025-01-01T10:02:00Z", Message: "from cron"},
+		domain.Notification{ID: 4, Timestamp: "2025-01-01T10:03:00Z", Message: "from ci"},
+	)
+
+	for _, groupBy := range []string{settings.GroupBySession, settings.GroupByWindow, settings.GroupByPane} {
+		t.Run(groupBy, func(t *testing.T) {
+			service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+			require.NoError(t, service.BuildTree(notifications, groupBy))
+
+			var unassigned *model.TreeNode
+			for _, child := range service.GetTreeRoot().Children {
+				if child.Kind == model.NodeKindUnassigned {
+					require.Nil(t, unassigned, "only one unassigned group")
+					unassigned = child
+					continue
+				}
+				assert.NotEmpty(t, child.Title, "located groups have a session")
+			}
+			require.NotNil(t, unassigned)
+			assert.Equal(t, UnassignedGroupTitle, unassigned.Title)
+			assert.Equal(t, 2, unassigned.Count)
+
+			var ids []int
+			for _, leaf := range unassigned.Children {
+				require.Equal(t, model.NodeKindNotification, leaf.Kind, "unassigned skips window and pane levels")
+				ids = append(ids, leaf.Notification.ID)
+			}
+			assert.ElementsMatch(t, []int{3, 4}, ids)
+		})
+	}
+}
+
+func TestBuildTreeKeepsPartiallyLocatedNotificationsInSessions(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	notifications := []domain.Notification{
+		{ID: 1, Timestamp: "2025-01-01T10:00:00Z", Window: "window-1", Message: "window only"},
+	}
+
+	require.NoError(t, service.BuildTree(notifications, settings.GroupBySession))
+
+	root := service.GetTreeRoot()
+	require.Len(t, root.Children, 1)
+	assert.Equal(t, model.NodeKindSession, root.Children[0].Kind)
+}
+
+func TestBuildTreeWithoutGroupUnassignedUsesEmptySession(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	service.SetGroupUnassigned(false)
+	notifications := append(sampleNotifications(),
+		domain.Notification{ID: 3, Timestamp: "2025-01-01T10:02:00Z", Message: "from cron"},
+	)
+
+	require.NoError(t, service.BuildTree(notifications, settings.GroupBySession))
+
+	for _, child := range service.GetTreeRoot().Children {
+		assert.Equal(t, model.NodeKindSession, child.Kind)
+	}
+	assert.Equal(t, []model.GroupCount{
+		{Kind: model.NodeKindSession, Count: 3},
+		{Kind: model.NodeKindNotification, Count: 3},
+	}, service.GroupCounts())
+}
+
+func TestBuildTreeMarksGroupsWithDeadPanesStale(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	checks := 0
+	service.SetPaneValidator(func(sessionID, windowID, paneID string) bool {
+		checks++
+		return paneID != "pane-2"
+	})
+	notifications := append(sampleNotifications(),
+		domain.Notification{ID: 3, Timestamp: "2025-01-01T10:02:00Z", Session: "session-b", Window: "window-2", Pane: "pane-2", Message: "third"},
+		domain.Notification{ID: 4, Timestamp: "2025-01-01T10:03:00Z", Message: "from cron"},
+	)
+
+	require.NoError(t, service.BuildTree(notifications, settings.GroupByPane))
+
+	stale := map[string]bool{}
+	var walk func(node *model.TreeNode)
+	walk = func(node *model.TreeNode) {
+		if node.Kind != model.NodeKindNotification {
+			stale[node.Title] = node.Stale
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(service.GetTreeRoot())
+
+	assert.False(t, stale["session-a"])
+	assert.False(t, stale["window-1"])
+	assert.False(t, stale["pane-1"])
+	assert.True(t, stale["session-b"])
+	assert.True(t, stale["window-2"])
+	assert.True(t, stale["pane-2"])
+	assert.False(t, stale[UnassignedGroupTitle], "notifications without a pane are never stale")
+	assert.Equal(t, 2, checks, "each pane is validated once per build")
+
+	service.SetPaneValidator(nil)
+	require.NoError(t, service.BuildTree(notifications, settings.GroupByPane))
+	for _, child := range service.GetTreeRoot().Children {
+		assert.False(t, child.Stale)
+	}
+}
+
+func TestGetVisibleNodesCapsDepthWithMoreNode(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	service.SetMaxDepth(2)
+	require.NoError(t, service.BuildTree(sampleNotifications()[:1], settings.GroupByPane))
+	service.expandAllGroups(service.GetTreeRoot())
+	service.InvalidateCache()
+
+	visible := service.GetVisibleNodes()
+	require.Len(t, visible, 3)
+	assert.Equal(t, model.NodeKindSession, visible[0].Kind)
+	assert.Equal(t, model.NodeKindWindow, visible[1].Kind)
+	more := visible[2]
+	assert.Equal(t, model.NodeKindMore, more.Kind)
+	assert.Equal(t, "+1 more", more.Display)
+	assert.Equal(t, 1, more.Count)
+	assert.Equal(t, service.GetTreeLevel(visible[1])+1, service.GetTreeLevel(more))
+
+	service.ExpandNode(more)
+	visible = service.GetVisibleNodes()
+	require.Len(t, visible, 4, "expanding the summary reveals every hidden level")
+	assert.Equal(t, model.NodeKindPane, visible[2].Kind)
+	assert.Equal(t, model.NodeKindNotification, visible[3].Kind)
+
+	window := visible[1]
+	service.CollapseNode(window)
+	service.ExpandNode(window)
+	visible = service.GetVisibleNodes()
+	require.Len(t, visible, 3, "collapsing the group hides its levels again")
+	assert.Equal(t, model.NodeKindMore, visible[2].Kind)
+}
+
+func TestGetVisibleNodesKeepsRevealedLevelsAcrossRebuilds(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	service.SetMaxDepth(1)
+	require.NoError(t, service.RebuildTreeForFilter(sampleNotifications(), settings.GroupBySession, nil))
+
+	visible := service.GetVisibleNodes()
+	require.Len(t, visible, 4)
+	assert.Equal(t, model.NodeKindMore, visible[1].Kind)
+	service.ToggleNodeExpansion(visible[1])
+	require.Len(t, service.GetVisibleNodes(), 4)
+	assert.Equal(t, model.NodeKindNotification, service.GetVisibleNodes()[1].Kind)
+
+	require.NoError(t, service.RebuildTreeForFilter(sampleNotifications(), settings.GroupBySession, nil))
+	visible = service.GetVisibleNodes()
+	assert.Equal(t, model.NodeKindNotification, visible[1].Kind, "revealed groups are keyed by identifier")
+	assert.Equal(t, model.NodeKindMore, visible[3].Kind)
+}
+
+func TestGetVisibleNodesWithoutMaxDepthShowsEveryLevel(t *testing.T) {
+	service := NewTreeService(model.GroupByPane).(*DefaultTreeService)
+	require.NoError(t, service.RebuildTreeForFilter(sampleNotifications()[:1], settings.GroupByPane, nil))
+
+	for _, node := range service.GetVisibleNodes() {
+		assert.NotEqual(t, model.NodeKindMore, node.Kind)
+	}
+	assert.Len(t, service.GetVisibleNodes(), 4)
+}
+
 func sampleNotifications() []domain.Notification {
 	return []domain.Notification{
 		{
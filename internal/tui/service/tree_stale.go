@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// SetPaneValidator sets how tree builds check that a notification's pane still
+// exists. Groups holding a notification whose pane is gone are marked stale.
+// Nil skips the check.
+func (s *DefaultTreeService) SetPaneValidator(paneExists func(sessionID, windowID, paneID string) bool) {
+	s.paneExists = paneExists
+}
+
+// updateStale marks node stale when notif points at a pane that no longer
+// exists. Each pane is validated once per build.
+func (s *DefaultTreeService) updateStale(node *model.TreeNode, notif domain.Notification) {
+	if node.Stale || s.paneExists == nil || notif.Session == "" || notif.Window == "" || notif.Pane == "" {
+		return
+	}
+	if s.stalePanes == nil {
+		s.stalePanes = make(map[string]bool)
+	}
+	key := model.NotificationSource{Session: notif.Session, Window: notif.Window, Pane: notif.Pane}.SourceKey()
+	stale, ok := s.stalePanes[key]
+	if !ok {
+		stale = !s.paneExists(notif.Session, notif.Window, notif.Pane)
+		s.stalePanes[key] = stale
+	}
+	node.Stale = stale
+}
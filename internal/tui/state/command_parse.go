@@ -0,0 +1,141 @@
+package state
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// commandSpec describes one ":" command: how many arguments it takes and how
+// to check them.
+type commandSpec struct {
+	args int
+	// allowed describes valid arguments in error messages.
+	allowed string
+	// validArg reports whether one argument is acceptable. Nil accepts any.
+	validArg func(arg string) bool
+}
+
+// commandSpecs lists the ":" commands by name.
+var commandSpecs = map[string]commandSpec{
+	"clear":       {},
+	"dismiss-all": {},
+	"reveal":      {},
+	"dismiss-older": {
+		args:     1,
+		allowed:  "a positive duration such as 30m or 48h",
+		validArg: isPositiveDuration,
+	},
+}
+
+// UnknownCommandError reports a ":" command that does not exist. Suggestion is
+// the closest known command, or empty when none is close.
+type UnknownCommandError struct {
+	Name       string
+	Suggestion string
+}
+
+func (e *UnknownCommandError) Error() string {
+	if e.Suggestion == "" {
+		return fmt.Sprintf("Unknown command %q", e.Name)
+	}
+	return fmt.Sprintf("Unknown command %q (did you mean %q?)", e.Name, e.Suggestion)
+}
+
+// ArityError reports a ":" command given the wrong number of arguments.
+type ArityError struct {
+	Name string
+	Want int
+	Got  int
+}
+
+func (e *ArityError) Error() string {
+	noun := "arguments"
+	if e.Want == 1 {
+		noun = "argument"
+	}
+	return fmt.Sprintf("%s expects %d %s, got %d", e.Name, e.Want, noun, e.Got)
+}
+
+// InvalidArgumentError reports a ":" command argument that is not accepted.
+type InvalidArgumentError struct {
+	Name    string
+	Arg     string
+	Allowed string
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return fmt.Sprintf("Invalid value %q for %s (allowed: %s)", e.Arg, e.Name, e.Allowed)
+}
+
+// parseCommand splits a ":" command line into its name and arguments and
+// checks them against commandSpecs. Errors are *UnknownCommandError,
+// *ArityError or *InvalidArgumentError. An empty line parses to an empty name.
+func parseCommand(input string) (string, []string, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return "", nil, nil
+	}
+	name, args := fields[0], fields[1:]
+	spec, ok := commandSpecs[name]
+	if !ok {
+		return "", nil, &UnknownCommandError{Name: name, Suggestion: suggestCommand(name)}
+	}
+	if len(args) != spec.args {
+		return "", nil, &ArityError{Name: name, Want: spec.args, Got: len(args)}
+	}
+	for _, arg := range args {
+		if spec.validArg != nil && !spec.validArg(arg) {
+			return "", nil, &InvalidArgumentError{Name: name, Arg: arg, Allowed: spec.allowed}
+		}
+	}
+	return name, args, nil
+}
+
+// isPositiveDuration reports whether arg is a Go duration greater than zero.
+func isPositiveDuration(arg string) bool {
+	d, err := time.ParseDuration(arg)
+	return err == nil && d > 0
+}
+
+// maxSuggestionDistance is how many edits a typo may be from a command and
+// still be suggested.
+const maxSuggestionDistance = 2
+
+// suggestCommand returns the known command closest to name, or empty when
+// none is within maxSuggestionDistance edits. Ties go to the first name in
+// alphabetical order.
+func suggestCommand(name string) string {
+	best, bestDistance := "", maxSuggestionDistance+1
+	for candidate := range commandSpecs {
+		d := editDistance(name, candidate)
+		if d < bestDistance || (d == bestDistance && candidate < best) {
+			best, bestDistance = candidate, d
+		}
+	}
+	if bestDistance > maxSuggestionDistance {
+		return ""
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
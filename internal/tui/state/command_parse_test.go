@@ -0,0 +1,50 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommandAcceptsKnownCommands(t *testing.T) {
+	name, args, err := parseCommand("  dismiss-older   2h ")
+	require.NoError(t, err)
+	assert.Equal(t, "dismiss-older", name)
+	assert.Equal(t, []string{"2h"}, args)
+
+	name, args, err = parseCommand("reveal")
+	require.NoError(t, err)
+	assert.Equal(t, "reveal", name)
+	assert.Empty(t, args)
+
+	name, _, err = parseCommand("   ")
+	require.NoError(t, err)
+	assert.Empty(t, name)
+}
+
+func TestParseCommandReturnsTypedErrors(t *testing.T) {
+	_, _, err := parseCommand("dismis-all")
+	var unknown *UnknownCommandError
+	require.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "dismis-all", unknown.Name)
+	assert.Equal(t, "dismiss-all", unknown.Suggestion)
+	assert.Equal(t, `Unknown command "dismis-all" (did you mean "dismiss-all"?)`, err.Error())
+
+	_, _, err = parseCommand("frobnicate")
+	require.True(t, errors.As(err, &unknown))
+	assert.Empty(t, unknown.Suggestion, "distant names get no suggestion")
+
+	_, _, err = parseCommand("reveal now")
+	var arity *ArityError
+	require.True(t, errors.As(err, &arity))
+	assert.Equal(t, ArityError{Name: "reveal", Want: 0, Got: 1}, *arity)
+	assert.Equal(t, "reveal expects 0 arguments, got 1", err.Error())
+
+	_, _, err = parseCommand("dismiss-older 0s")
+	var invalid *InvalidArgumentError
+	require.True(t, errors.As(err, &invalid))
+	assert.Equal(t, "dismiss-older", invalid.Name)
+	assert.Equal(t, "0s", invalid.Arg)
+}
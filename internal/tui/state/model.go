@@ -18,7 +18,9 @@ import (
 const (
 	viewModeDetailed      = settings.ViewModeDetailed
 	viewModeGrouped       = settings.ViewModeGrouped
-	headerFooterLines     = 3
+	headerLines           = 2 // tabs and table header
+	footerLines           = 1
+	headerFooterLines     = headerLines + footerLines
 	defaultViewportWidth  = 80
 	defaultViewportHeight = 22
 	errorClearDuration    = 5 * time.Second
@@ -47,7 +49,48 @@ type Model struct {
 	settingsSvc    *settingsService
 	// UI render options
 	groupHeaderOptions settings.GroupHeaderOptions
+	selection          settings.SelectionOptions
+	sourceGutter       bool
 	showStale          bool
+	// hideEmptySearch hides all results in search view mode until a query is typed.
+	hideEmptySearch bool
+	// undoStack holds recent mutating actions that can be reversed, newest last.
+	undoStack []undoEntry
+	// preFocusFilters holds the filters active before focus mode; nil when focus mode is off.
+	preFocusFilters *settings.Filter
+	// autoReadDwell is how long a notification must stay selected before it is
+	// marked read automatically; zero disables auto-read.
+	autoReadDwell time.Duration
+	dwell         dwellState
+	now           func() time.Time
+	// ageDividers renders "Last hour"/"Today"/"Earlier" dividers in detailed view.
+	ageDividers bool
+	// activeCountWarning is the active count that shows the cleanup banner; zero disables it.
+	activeCountWarning int
+	// confirmDismissAll asks for confirmation before ":dismiss-all" runs.
+	confirmDismissAll bool
+	// autoRefresh reloads notifications on this interval; zero disables it.
+	autoRefresh time.Duration
+	// wakeSnoozed runs the session snooze sweep before each refresh. Nil skips it.
+	wakeSnoozed func() (int, error)
+	// autoSave saves view changes on this interval; zero disables it.
+	autoSave time.Duration
+	// flashNew highlights the header when a refresh brings in new notifications.
+	flashNew bool
+	flash    flashState
+	// confirmSaveOnQuit asks before saving view changes on quit instead of
+	// saving them as they happen.
+	confirmSaveOnQuit bool
+	// confirmCrossSessionJump asks before a jump leaves the current session.
+	confirmCrossSessionJump bool
+	// searchEnterExits makes Enter leave search mode after jumping.
+	searchEnterExits bool
+	// searchAliases expands "@name" search tokens to saved queries.
+	searchAliases map[string]string
+	// peek makes the session read-only: no notification changes and no settings writes.
+	peek bool
+	// detail is the notification shown by the detail view; nil when it is closed.
+	detail *detailView
 
 	// Services - implementing BubbleTea nested model pattern
 	treeService         model.TreeService
@@ -65,7 +108,7 @@ type Model struct {
 
 // Init initializes the TUI model.
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.scheduleDwellTick(), m.scheduleRefreshTick(), m.scheduleAutoSaveTick())
 }
 
 // Update handles messages and updates the model state.
@@ -73,6 +116,17 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKeyMsg(msg)
+	case tea.MouseMsg:
+		return m.handleMouseMsg(msg)
+	case dwellTickMsg:
+		return m, m.handleDwellTick()
+	case refreshTickMsg:
+		return m, m.handleRefreshTick()
+	case autoSaveTickMsg:
+		return m, m.handleAutoSaveTick()
+	case flashClearMsg:
+		m.handleFlashClear(msg)
+		return m, nil
 	case saveSettingsSuccessMsg:
 		return m.handleSaveSettingsSuccess(msg)
 	case saveSettingsFailedMsg:
@@ -94,6 +148,9 @@ func NewModel(client tmux.TmuxClient) (*Model, error) {
 	if client == nil {
 		client = tmux.NewDefaultClient()
 	}
+	if errKeyBindings != nil {
+		return &Model{}, errKeyBindings
+	}
 
 	// Initialize UI state
 	uiState := NewUIState()
@@ -107,9 +164,8 @@ func NewModel(client tmux.TmuxClient) (*Model, error) {
 	// Initialize notification service with default search provider
 	searchProvider := search.NewTokenProvider(
 		search.WithCaseInsensitive(true),
-		search.WithSessionNames(runtimeCoordinator.GetSessionNames()),
-		search.WithWindowNames(runtimeCoordinator.GetWindowNames()),
-		search.WithPaneNames(runtimeCoordinator.GetPaneNames()),
+		search.WithCurrentUser(searchCurrentUser),
+		search.WithNameSource(runtimeCoordinator),
 	)
 	notificationService := service.NewNotificationService(searchProvider, runtimeCoordinator)
 	interactionCtrl := controller.NewInteractionController(runtimeCoordinator)
@@ -125,6 +181,7 @@ func NewModel(client tmux.TmuxClient) (*Model, error) {
 		notificationService: notificationService,
 		settingsSvc:         newSettingsService(),
 		unreadFirst:         true, // Default to true for backward compatibility
+		confirmDismissAll:   true,
 		// Legacy fields kept for backward compatibility but now using services
 		client:             client,
 		sessionNames:       runtimeCoordinator.GetSessionNames(),
@@ -132,7 +189,9 @@ func NewModel(client tmux.TmuxClient) (*Model, error) {
 		paneNames:          runtimeCoordinator.GetPaneNames(),
 		ensureTmuxRunning:  core.EnsureTmuxRunning,
 		jumpToPane:         core.JumpToPane,
+		wakeSnoozed:        core.WakeSnoozedNotifications,
 		groupHeaderOptions: settings.DefaultGroupHeaderOptions(),
+		now:                time.Now,
 	}
 
 	// Initialize error handler with callback that sets error message
@@ -148,6 +207,7 @@ func NewModel(client tmux.TmuxClient) (*Model, error) {
 	if coordinator, ok := runtimeCoordinator.(*service.DefaultRuntimeCoordinator); ok {
 		coordinator.SetErrorHandler(m.errorHandler)
 	}
+	treeService.SetPaneValidator(m.paneExists)
 
 	// Load initial notifications
 	err := m.loadNotifications(false)
@@ -3,6 +3,7 @@ package state
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
@@ -34,6 +35,7 @@ func (m *Model) handleDismiss() tea.Cmd {
 		m.errorHandler.Error(fmt.Sprintf("Failed to dismiss notification: %v", err))
 		return errorMsgAfter(errorClearDuration)
 	}
+	m.pushUndo(undoDismiss, selected.ID)
 
 	// Save the current cursor position before reload
 	oldCursor := m.uiState.GetCursor()
@@ -60,6 +62,38 @@ func (m *Model) handleDismiss() tea.Cmd {
 	return nil
 }
 
+// handleRestore makes the selected dismissed notification active again.
+// Dismissed notifications are listed on the Sessions tab.
+func (m *Model) handleRestore() tea.Cmd {
+	selected, ok := m.selectedNotification()
+	if !ok {
+		return nil
+	}
+	if selected.State != domain.StateDismissed {
+		m.errorHandler.Info("Notification is not dismissed")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.ensureInteractionController().UndismissNotification(strconv.Itoa(selected.ID)); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to restore notification: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	reload := func() error { return m.loadNotifications(true) }
+	if m.uiState.GetActiveTab() == settings.TabSessions {
+		reload = m.loadAllNotifications
+	}
+	if err := reload(); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	m.restoreCursor(fmt.Sprintf("notif:%d", selected.ID))
+	m.updateViewportContent()
+
+	m.errorHandler.Success("Notification restored")
+	return errorMsgAfter(errorClearDuration)
+}
+
 // handleDismissGroup handles the dismiss group action.
 // Shows confirmation dialog if current selection is a group node in grouped view.
 func (m *Model) handleDismissGroup() tea.Cmd {
@@ -82,6 +116,9 @@ func (m *Model) handleDismissGroup() tea.Cmd {
 	if !m.isGroupNode(node) {
 		return nil
 	}
+	if node.Kind == model.NodeKindUnassigned {
+		return m.confirmDismissUnassigned()
+	}
 	// Only session, window, and pane groups can be dismissed
 	if node.Kind != model.NodeKindSession && node.Kind != model.NodeKindWindow && node.Kind != model.NodeKindPane {
 		return nil
@@ -108,13 +145,37 @@ func (m *Model) handleDismissGroup() tea.Cmd {
 	return nil
 }
 
+// confirmDismissUnassigned asks to dismiss the active notifications of the
+// unassigned group. They are dismissed by ID, since empty location filters
+// would match every notification.
+func (m *Model) confirmDismissUnassigned() tea.Cmd {
+	ids := m.activeUnassignedNotificationIDs()
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m.uiState.SetPendingAction(PendingAction{
+		Type:     ActionDismissFiltered,
+		Message:  fmt.Sprintf("Dismiss %d notifications in this %s?", len(ids), getGroupTypeLabel(model.NodeKindUnassigned)),
+		Count:    len(ids),
+		IDs:      ids,
+		NodeKind: model.NodeKindUnassigned,
+	})
+	m.uiState.SetConfirmationMode(true)
+	return nil
+}
+
 // handleDismissByFilter dismisses notifications matching the provided filters.
 func (m *Model) handleDismissByFilter(session, window, pane string) tea.Cmd {
+	// Capture affected IDs before dismissal so the action can be undone
+	dismissedIDs := m.activeNotificationIDsByFilter(session, window, pane)
+
 	// Dismiss using storage
 	if err := m.ensureInteractionController().DismissByFilter(session, window, pane); err != nil {
 		m.errorHandler.Error(fmt.Sprintf("Failed to dismiss notifications: %v", err))
 		return errorMsgAfter(errorClearDuration)
 	}
+	m.pushUndo(undoDismiss, dismissedIDs...)
 
 	// Save the current cursor position before reload
 	oldCursor := m.uiState.GetCursor()
@@ -162,6 +223,9 @@ func (m *Model) markSelectedRead() tea.Cmd {
 		m.errorHandler.Error(fmt.Sprintf("Failed to mark notification read: %v", err))
 		return errorMsgAfter(errorClearDuration)
 	}
+	if !selected.IsRead() {
+		m.pushUndo(undoMarkRead, selectedID)
+	}
 
 	if err := m.loadNotifications(true); err != nil {
 		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
@@ -176,6 +240,111 @@ func (m *Model) markSelectedRead() tea.Cmd {
 	return nil
 }
 
+// markFilteredRead marks every unread notification in the current filtered
+// view read in one transaction, as one undoable action.
+func (m *Model) markFilteredRead() tea.Cmd {
+	return m.markFilteredReadState(true)
+}
+
+// markFilteredUnread marks every read notification in the current filtered
+// view unread in one transaction, as one undoable action.
+func (m *Model) markFilteredUnread() tea.Cmd {
+	return m.markFilteredReadState(false)
+}
+
+// markFilteredReadState changes the notifications in the filtered view whose
+// read state differs from read.
+func (m *Model) markFilteredReadState(read bool) tea.Cmd {
+	state, kind := "unread", undoMarkUnread
+	if read {
+		state, kind = "read", undoMarkRead
+	}
+
+	ids := make([]int, 0, len(m.filtered))
+	for _, notif := range m.filtered {
+		if notif.IsRead() != read {
+			ids = append(ids, notif.ID)
+		}
+	}
+	if len(ids) == 0 {
+		if read {
+			m.errorHandler.Info("Nothing unread in view")
+		} else {
+			m.errorHandler.Info("Nothing read in view")
+		}
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.setReadState(ids, read); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to mark notifications %s: %v", state, err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	m.pushUndo(kind, ids...)
+
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	m.updateViewportContent()
+
+	m.errorHandler.Success(fmt.Sprintf("Marked %d notifications %s", len(ids), state))
+	return errorMsgAfter(errorClearDuration)
+}
+
+// setReadState marks the notifications with ids read or unread in one call.
+func (m *Model) setReadState(ids []int, read bool) error {
+	strIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIDs = append(strIDs, strconv.Itoa(id))
+	}
+	ctrl := m.ensureInteractionController()
+	if read {
+		return ctrl.MarkNotificationsRead(strIDs)
+	}
+	return ctrl.MarkNotificationsUnread(strIDs)
+}
+
+// toggleSelectedRead marks the selected notification unread when it is read,
+// and read otherwise.
+func (m *Model) toggleSelectedRead() tea.Cmd {
+	selected, ok := m.selectedNotification()
+	if !ok {
+		return nil
+	}
+	if selected.IsRead() {
+		return m.markSelectedUnread()
+	}
+	return m.markSelectedRead()
+}
+
+// toggleSelectedImportant flips the important flag of the selected notification.
+func (m *Model) toggleSelectedImportant() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	selected, ok := m.selectedNotification()
+	if !ok {
+		return nil
+	}
+	selectedID := selected.ID
+
+	id := strconv.Itoa(selected.ID)
+	if err := m.ensureInteractionController().SetImportant(id, !selected.Important); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to update important flag: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.restoreCursor(fmt.Sprintf("notif:%d", selectedID))
+	m.updateViewportContent()
+	return nil
+}
+
 // markSelectedUnread marks the selected notification as unread.
 func (m *Model) markSelectedUnread() tea.Cmd {
 	if m.currentListLen() == 0 {
@@ -195,6 +364,9 @@ func (m *Model) markSelectedUnread() tea.Cmd {
 		m.errorHandler.Error(fmt.Sprintf("tui: failed to mark notification unread: %v", err))
 		return errorMsgAfter(errorClearDuration)
 	}
+	if selected.IsRead() {
+		m.pushUndo(undoMarkUnread, selectedID)
+	}
 
 	if err := m.loadNotifications(true); err != nil {
 		m.errorHandler.Error(fmt.Sprintf("tui: failed to reload notifications: %v", err))
@@ -221,6 +393,86 @@ func (m *Model) handleJump() tea.Cmd {
 		return errorMsgAfter(errorClearDuration)
 	}
 
+	var readIDs []int
+	if selected, ok := m.selectedNotification(); ok {
+		readIDs = []int{selected.ID}
+	}
+	return m.jumpOrConfirm(target, readIDs)
+}
+
+// handleJumpNewestInGroup jumps to the pane of the newest notification under
+// the selected group node, without expanding the group first. Outside grouped
+// view and on notification rows it does nothing.
+func (m *Model) handleJumpNewestInGroup() tea.Cmd {
+	if !m.isGroupedView() || m.currentListLen() == 0 {
+		return nil
+	}
+
+	node := m.selectedVisibleNode()
+	if node == nil || !m.isGroupNode(node) {
+		return nil
+	}
+
+	newest, ok := newestNotificationInNode(node)
+	if !ok {
+		m.errorHandler.Error("jump: group has no notifications")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	target := jumpTarget{Session: newest.Session, Window: newest.Window, Pane: newest.Pane}
+	return m.jumpOrConfirm(target, []int{newest.ID})
+}
+
+// jumpOrConfirm jumps to target, first asking for confirmation when
+// confirm_cross_session_jump is on and target is in another session.
+// readIDs are the notifications marked read once the jump succeeds.
+func (m *Model) jumpOrConfirm(target jumpTarget, readIDs []int) tea.Cmd {
+	if m.confirmCrossSessionJump && m.isOtherSession(target.Session) {
+		var sessions map[string]string
+		if m.runtimeCoordinator != nil {
+			sessions = m.runtimeCoordinator.GetSessionNames()
+		}
+		m.uiState.SetPendingAction(PendingAction{
+			Type:    ActionJump,
+			Message: fmt.Sprintf("Jump to session %s?", locationName(sessions, target.Session)),
+			Session: target.Session,
+			Window:  target.Window,
+			Pane:    target.Pane,
+			IDs:     readIDs,
+		})
+		m.uiState.SetConfirmationMode(true)
+		return nil
+	}
+	return m.completeJump(target, readIDs)
+}
+
+// completeJump jumps to target, marks readIDs read and quits the TUI.
+func (m *Model) completeJump(target jumpTarget, readIDs []int) tea.Cmd {
+	if cmd := m.jumpToTarget(target); cmd != nil {
+		return cmd
+	}
+	for _, id := range readIDs {
+		m.markJumpedRead(id)
+	}
+	return tea.Quit
+}
+
+// isOtherSession reports whether session differs from the session the TUI
+// runs in. An unknown current session never counts as different.
+func (m *Model) isOtherSession(session string) bool {
+	if m.runtimeCoordinator == nil || session == "" {
+		return false
+	}
+	current, err := m.runtimeCoordinator.GetCurrentContext()
+	if err != nil || current == nil || current.SessionID == "" {
+		return false
+	}
+	return current.SessionID != session
+}
+
+// jumpToTarget navigates to target. It returns nil when the jump happened and
+// an error-clearing command after reporting why it did not.
+func (m *Model) jumpToTarget(target jumpTarget) tea.Cmd {
 	// Check if target has valid session and window.
 	if target.Session == "" || target.Window == "" {
 		m.errorHandler.Error("jump: notification missing session or window information")
@@ -228,12 +480,12 @@ func (m *Model) handleJump() tea.Cmd {
 	}
 
 	// Ensure tmux is running
-	if !m.ensureInteractionController().EnsureTmuxRunning() {
+	ctrl := m.ensureInteractionController()
+	if !ctrl.EnsureTmuxRunning() {
 		m.errorHandler.Error("tmux not running")
 		return errorMsgAfter(errorClearDuration)
 	}
 
-	ctrl := m.ensureInteractionController()
 	jumped := false
 	if target.Pane == "" {
 		jumped = ctrl.JumpToWindow(target.Session, target.Window)
@@ -246,16 +498,144 @@ func (m *Model) handleJump() tea.Cmd {
 		// Error was already handled by m.errorHandler, just return error clear command
 		return errorMsgAfter(errorClearDuration)
 	}
+	return nil
+}
+
+// markJumpedRead marks the notification a jump reached as read.
+func (m *Model) markJumpedRead(id int) {
+	if m.peek {
+		return
+	}
+	if err := m.ensureInteractionController().MarkNotificationRead(strconv.Itoa(id)); err != nil {
+		m.errorHandler.Warning(fmt.Sprintf("jump: jumped, but failed to mark notification as read: %v", err))
+	}
+}
 
-	selected, selectedOK := m.selectedNotification()
-	if selectedOK {
-		id := strconv.Itoa(selected.ID)
-		if err := ctrl.MarkNotificationRead(id); err != nil {
-			m.errorHandler.Warning(fmt.Sprintf("jump: jumped, but failed to mark notification as read: %v", err))
+// newestNotificationInNode returns the notification with the latest timestamp
+// among the leaves under node.
+func newestNotificationInNode(node *model.TreeNode) (domain.Notification, bool) {
+	if node == nil {
+		return domain.Notification{}, false
+	}
+	if node.Notification != nil {
+		return *node.Notification, true
+	}
+	var newest domain.Notification
+	found := false
+	for _, child := range node.Children {
+		candidate, ok := newestNotificationInNode(child)
+		if !ok {
+			continue
+		}
+		if !found || isNewerTimestamp(candidate.Timestamp, newest.Timestamp) {
+			newest = candidate
+			found = true
 		}
 	}
+	return newest, found
+}
 
-	return tea.Quit
+// handleCopyJumpCommand copies a shell command that reproduces the jump for the
+// current selection, so the target can be reached from outside the TUI.
+func (m *Model) handleCopyJumpCommand() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	command, ok := m.jumpCommandForSelection()
+	if !ok {
+		m.errorHandler.Error("copy: unable to resolve jump target from current selection")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.ensureInteractionController().CopyToClipboard(command); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("copy: failed to copy jump command: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.errorHandler.Success("Copied: " + command)
+	return errorMsgAfter(errorClearDuration)
+}
+
+// handleCopyLocation copies the current selection's tmux location as
+// "session:window.pane" with resolved names, e.g. "api:editor.0", for sharing.
+func (m *Model) handleCopyLocation() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	target, ok := m.resolveJumpTarget()
+	if !ok || target.Session == "" || target.Window == "" {
+		m.errorHandler.Error("copy: unable to resolve location from current selection")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	location := m.tmuxLocation(target)
+	if err := m.ensureInteractionController().CopyToClipboard(location); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("copy: failed to copy location: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.errorHandler.Success("Copied: " + location)
+	return errorMsgAfter(errorClearDuration)
+}
+
+// tmuxLocation renders target as "session:window.pane" using the tmux names
+// known to the runtime coordinator. Unknown names fall back to the raw IDs.
+func (m *Model) tmuxLocation(target jumpTarget) string {
+	var sessions, windows, panes map[string]string
+	if m.runtimeCoordinator != nil {
+		sessions = m.runtimeCoordinator.GetSessionNames()
+		windows = m.runtimeCoordinator.GetWindowNames()
+		panes = m.runtimeCoordinator.GetPaneNames()
+	}
+
+	location := locationName(sessions, target.Session) + ":" + locationName(windows, target.Window)
+	if target.Pane != "" {
+		location += "." + locationName(panes, target.Pane)
+	}
+	return location
+}
+
+func locationName(names map[string]string, id string) string {
+	if name := names[id]; name != "" {
+		return name
+	}
+	return id
+}
+
+// jumpCommandForSelection builds the jump command for the current selection.
+// Notification rows copy `tmux-intray jump <id>`; group rows without a single
+// notification copy the raw tmux commands for the resolved target.
+func (m *Model) jumpCommandForSelection() (string, bool) {
+	target, ok := m.resolveJumpTarget()
+	if !ok || target.Session == "" || target.Window == "" {
+		return "", false
+	}
+
+	if selected, ok := m.selectedNotification(); ok {
+		return fmt.Sprintf("tmux-intray jump %d", selected.ID), true
+	}
+	return tmuxJumpCommand(target), true
+}
+
+// tmuxJumpCommand returns the tmux invocation matching the navigation performed
+// by JumpToPane: switch client, select window, then select pane when present.
+func tmuxJumpCommand(target jumpTarget) string {
+	windowTarget := target.Session + ":" + target.Window
+	parts := []string{
+		"tmux switch-client -t " + shellQuote(target.Session),
+		"select-window -t " + shellQuote(windowTarget),
+	}
+	if target.Pane != "" {
+		parts = append(parts, "select-pane -t "+shellQuote(windowTarget+"."+target.Pane))
+	}
+	return strings.Join(parts, ` \; `)
+}
+
+// shellQuote wraps value in single quotes so tmux IDs like $1 survive the shell.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
 }
 
 func (m *Model) resolveJumpTarget() (jumpTarget, bool) {
@@ -318,10 +698,18 @@ func jumpTargetFromLatestOrSource(node *model.TreeNode) (jumpTarget, bool) {
 
 // selectedNotification returns the currently selected notification.
 func (m *Model) selectedNotification() (domain.Notification, bool) {
-	cursor := m.uiState.GetCursor()
+	return m.notificationAtRow(m.uiState.GetCursor())
+}
+
+// notificationAtRow returns the notification rendered at the given row of the current view.
+func (m *Model) notificationAtRow(cursor int) (domain.Notification, bool) {
 	if m.isGroupedView() {
 		return m.selectedGroupedNotification(cursor)
 	}
+	if m.isSummaryView() {
+		// Summary rows aggregate sessions and never map to a single notification.
+		return domain.Notification{}, false
+	}
 
 	if cursor < 0 || cursor >= len(m.filtered) {
 		return domain.Notification{}, false
@@ -330,7 +718,7 @@ func (m *Model) selectedNotification() (domain.Notification, bool) {
 }
 
 func (m *Model) selectedGroupedNotification(cursor int) (domain.Notification, bool) {
-	visibleNodes := m.treeService.GetVisibleNodes()
+	visibleNodes := m.computeVisibleNodes()
 	if cursor < 0 || cursor >= len(visibleNodes) {
 		return domain.Notification{}, false
 	}
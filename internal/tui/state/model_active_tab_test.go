@@ -0,0 +1,69 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTUIModel builds a model the way the tui command does on launch: load
+// tui.toml, store it, then apply it as state.
+func openTUIModel(t *testing.T) *Model {
+	t.Helper()
+
+	loaded, err := settings.Load()
+	require.NoError(t, err)
+	model, err := NewModel(stubSessionFetchers(t))
+	require.NoError(t, err)
+	model.SetLoadedSettings(loaded)
+	require.NoError(t, model.FromState(settings.FromSettings(loaded)))
+	model.uiState.SetSearchMode(false)
+	return model
+}
+
+func TestActiveTabRestoredAfterQuittingOnAllTab(t *testing.T) {
+	exits := map[string]tea.KeyMsg{
+		"q":      {Type: tea.KeyRunes, Runes: []rune{'q'}},
+		"ctrl+c": {Type: tea.KeyCtrlC},
+		"esc":    {Type: tea.KeyEsc},
+	}
+	for name, exit := range exits {
+		t.Run(name, func(t *testing.T) {
+			setupConfig(t, t.TempDir())
+			setupStorage(t)
+
+			model := openTUIModel(t)
+			require.Equal(t, settings.TabRecents, model.uiState.GetActiveTab())
+			model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+			require.Equal(t, settings.TabAll, model.uiState.GetActiveTab())
+			_, cmd := model.Update(exit)
+			require.NotNil(t, cmd)
+			assert.Equal(t, tea.Quit(), cmd())
+
+			reopened := openTUIModel(t)
+			assert.Equal(t, settings.TabAll, reopened.uiState.GetActiveTab())
+			assert.Equal(t, settings.TabAll, reopened.ToState().ActiveTab)
+		})
+	}
+}
+
+func TestInvalidPersistedActiveTabRestoresRecents(t *testing.T) {
+	configDir := t.TempDir()
+	setupConfig(t, configDir)
+	setupStorage(t)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "tui.toml"), []byte("active_tab = \"inbox\"\n"), 0o644))
+
+	model := openTUIModel(t)
+	assert.Equal(t, settings.TabRecents, model.uiState.GetActiveTab())
+
+	require.NoError(t, model.FromState(settings.TUIState{ActiveTab: "ALL "}))
+	assert.Equal(t, settings.TabAll, model.uiState.GetActiveTab(), "persisted values are trimmed and lowercased")
+	require.NoError(t, model.FromState(settings.TUIState{ActiveTab: "inbox"}))
+	assert.Equal(t, settings.TabRecents, model.uiState.GetActiveTab())
+	assert.Equal(t, settings.TabRecents, model.ToState().ActiveTab)
+}
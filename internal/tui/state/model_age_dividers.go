@@ -0,0 +1,57 @@
+package state
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// Relative-age buckets used for detailed view dividers.
+const (
+	ageBucketLastHour = "Last hour"
+	ageBucketToday    = "Today"
+	ageBucketEarlier  = "Earlier"
+)
+
+// showsAgeDividers reports whether the flat list should render age dividers.
+// Dividers only group rows when the list is sorted by timestamp; under any
+// other sort the buckets would interleave.
+func (m *Model) showsAgeDividers() bool {
+	return m.ageDividers && m.uiState.GetViewMode() == model.ViewModeDetailed && m.sortedByTimestamp()
+}
+
+// sortedByTimestamp reports whether the list is sorted by timestamp, which is
+// also the fallback for an empty or unknown sort_by.
+func (m *Model) sortedByTimestamp() bool {
+	field, err := domain.ParseSortByField(m.sortBy)
+	return err != nil || field == domain.SortByTimestampField
+}
+
+// ageBucket classifies a notification timestamp relative to now.
+// Unparseable timestamps fall into the "Earlier" bucket.
+func ageBucket(timestamp string, now time.Time) string {
+	ts, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ageBucketEarlier
+	}
+	if now.Sub(ts) < time.Hour {
+		return ageBucketLastHour
+	}
+	local := ts.In(now.Location())
+	if local.Year() == now.Year() && local.YearDay() == now.YearDay() {
+		return ageBucketToday
+	}
+	return ageBucketEarlier
+}
+
+// renderAgeDivider renders a dim divider line labelled with an age bucket.
+func renderAgeDivider(label string, width int) string {
+	text := "── " + label + " "
+	if fill := width - lipgloss.Width(text); fill > 0 {
+		text += strings.Repeat("─", fill)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Faint(true).Render(text)
+}
@@ -0,0 +1,96 @@
+package state
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgeBucketBoundaries(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, ageBucketLastHour, ageBucket("2026-03-10T14:01:00Z", now))
+	assert.Equal(t, ageBucketToday, ageBucket("2026-03-10T14:00:00Z", now))
+	assert.Equal(t, ageBucketToday, ageBucket("2026-03-10T00:00:00Z", now))
+	assert.Equal(t, ageBucketEarlier, ageBucket("2026-03-09T23:59:59Z", now))
+	assert.Equal(t, ageBucketEarlier, ageBucket("not-a-time", now))
+}
+
+func TestDetailedViewRendersAgeDividers(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "fresh one", Timestamp: "2026-03-10T14:50:00Z"},
+		{ID: 2, Message: "fresh two", Timestamp: "2026-03-10T14:20:00Z"},
+		{ID: 3, Message: "this morning", Timestamp: "2026-03-10T09:00:00Z"},
+		{ID: 4, Message: "yesterday", Timestamp: "2026-03-09T18:00:00Z"},
+	})
+	model.now = func() time.Time { return time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC) }
+	model.ageDividers = true
+	model.uiState.SetWidth(120)
+	model.uiState.GetViewport().Width = 120
+	model.uiState.GetViewport().Height = 20
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+	model.applySearchFilter()
+	model.uiState.SetCursor(2)
+	model.updateViewportContent()
+
+	lines := strings.Split(model.uiState.GetViewport().View(), "\n")
+	require.GreaterOrEqual(t, len(lines), 7)
+	assert.Contains(t, lines[0], "Last hour")
+	assert.Contains(t, lines[1], "fresh one")
+	assert.Contains(t, lines[2], "fresh two")
+	assert.Contains(t, lines[3], "Today")
+	assert.Contains(t, lines[4], "this morning")
+	assert.Contains(t, lines[5], "Earlier")
+	assert.Contains(t, lines[6], "yesterday")
+
+	// Two dividers are rendered above the selected "this morning" row.
+	assert.Equal(t, 2, model.uiState.GetCursorLineOffset())
+}
+
+func TestAgeDividersOffByDefault(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "fresh one"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+	model.applySearchFilter()
+	model.updateViewportContent()
+
+	content := model.uiState.GetViewport().View()
+	assert.NotContains(t, content, "Last hour")
+	assert.Equal(t, 0, model.uiState.GetCursorLineOffset())
+}
+
+func TestAgeDividersHiddenUnlessSortedByTimestamp(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "fresh info", Timestamp: "2026-03-10T14:50:00Z", Level: "info"},
+		{ID: 2, Message: "old critical", Timestamp: "2026-03-09T18:00:00Z", Level: "critical"},
+		{ID: 3, Message: "fresh error", Timestamp: "2026-03-10T14:20:00Z", Level: "error"},
+	})
+	model.now = func() time.Time { return time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC) }
+	model.ageDividers = true
+	model.sortBy = settings.SortByLevel
+	model.uiState.SetWidth(120)
+	model.uiState.GetViewport().Width = 120
+	model.uiState.GetViewport().Height = 20
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+	model.applySearchFilter()
+	model.updateViewportContent()
+
+	content := model.uiState.GetViewport().View()
+	assert.NotContains(t, content, "Last hour")
+	assert.NotContains(t, content, "Earlier")
+	assert.Equal(t, 0, model.uiState.GetCursorLineOffset())
+
+	model.sortBy = settings.SortByTimestamp
+	model.applySearchFilter()
+	model.updateViewportContent()
+	assert.Contains(t, model.uiState.GetViewport().View(), "Last hour")
+}
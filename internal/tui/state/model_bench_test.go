@@ -3,6 +3,7 @@ package state
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
@@ -311,12 +312,19 @@ func (s *dummyTreeService) PruneEmptyGroups() {
 }
 func (s *dummyTreeService) ApplyExpansionState(expansionState map[string]bool) {
 }
-func (s *dummyTreeService) ExpandNode(node *model.TreeNode)          {}
-func (s *dummyTreeService) CollapseNode(node *model.TreeNode)        {}
-func (s *dummyTreeService) ToggleNodeExpansion(node *model.TreeNode) {}
+func (s *dummyTreeService) ExpandNode(node *model.TreeNode)                    {}
+func (s *dummyTreeService) CollapseNode(node *model.TreeNode)                  {}
+func (s *dummyTreeService) ToggleNodeExpansion(node *model.TreeNode)           {}
+func (s *dummyTreeService) SetWeekStart(weekStart time.Weekday)                {}
+func (s *dummyTreeService) SetGroupUnassigned(enabled bool)                    {}
+func (s *dummyTreeService) SetMaxDepth(depth int)                              {}
+func (s *dummyTreeService) SetPaneValidator(func(string, string, string) bool) {}
 func (s *dummyTreeService) GetTreeLevel(node *model.TreeNode) int {
 	return 0
 }
+func (s *dummyTreeService) GroupCounts() []model.GroupCount {
+	return nil
+}
 
 func benchmarkNotifications(size int) []domain.Notification {
 	notifications := make([]domain.Notification, size)
@@ -391,6 +399,10 @@ func (d *dummyRuntimeCoordinator) JumpToWindow(sessionID, windowID string) bool
 	return true
 }
 
+func (d *dummyRuntimeCoordinator) CopyToClipboard(text string) error {
+	return nil
+}
+
 func (d *dummyRuntimeCoordinator) ValidatePaneExists(sessionID, windowID, paneID string) (bool, error) {
 	return true, nil
 }
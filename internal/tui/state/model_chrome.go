@@ -0,0 +1,11 @@
+package state
+
+// toggleHeaderFooter hides the header and footer when either is shown, giving
+// their rows to the list, and shows both again otherwise. The show_header and
+// show_footer settings pick how the TUI starts; toggling does not save them.
+func (m *Model) toggleHeaderFooter() {
+	hide := !m.uiState.IsHeaderHidden() || !m.uiState.IsFooterHidden()
+	m.uiState.SetHeaderHidden(hide)
+	m.uiState.SetFooterHidden(hide)
+	m.updateViewportContent()
+}
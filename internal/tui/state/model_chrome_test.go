@@ -0,0 +1,81 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHiddenHeaderAndFooterGrowViewport(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 20})
+	assert.Equal(t, 20-headerFooterLines, model.uiState.GetViewport().Height)
+
+	model.uiState.SetHeaderHidden(true)
+	assert.Equal(t, 20-footerLines, model.uiState.GetViewport().Height)
+
+	model.uiState.SetFooterHidden(true)
+	assert.Equal(t, 20, model.uiState.GetViewport().Height)
+
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 12})
+	assert.Equal(t, 12, model.uiState.GetViewport().Height, "a resize keeps the hidden rows")
+
+	model.uiState.SetHeaderHidden(false)
+	assert.Equal(t, 12-headerLines, model.uiState.GetViewport().Height)
+}
+
+func TestHiddenHeaderDropsBannerAndTabLabelRows(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 20})
+	model.uiState.SetBannerVisible(true)
+	model.uiState.SetTabLabelVisible(true)
+	assert.Equal(t, 20-headerFooterLines-2, model.uiState.GetViewport().Height)
+
+	model.uiState.SetHeaderHidden(true)
+	assert.Equal(t, 20-footerLines, model.uiState.GetViewport().Height)
+}
+
+func TestToggleHeaderFooterKey(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.uiState.SetSearchMode(false)
+	model.Update(tea.WindowSizeMsg{Width: 400, Height: 20})
+	view := model.View()
+	assert.Contains(t, view, "q: quit")
+	assert.Contains(t, view, "MESSAGE")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	assert.True(t, model.uiState.IsHeaderHidden())
+	assert.True(t, model.uiState.IsFooterHidden())
+	assert.Equal(t, 20, model.uiState.GetViewport().Height)
+	view = model.View()
+	assert.NotContains(t, view, "q: quit")
+	assert.NotContains(t, view, "MESSAGE")
+	assert.Contains(t, view, "first")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	assert.False(t, model.uiState.IsHeaderHidden())
+	assert.False(t, model.uiState.IsFooterHidden())
+	assert.Equal(t, 20-headerFooterLines, model.uiState.GetViewport().Height)
+}
+
+func TestToggleHeaderFooterHidesBothWhenOneIsShown(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.SetLoadedSettings(&settings.Settings{ShowHeader: true, ShowFooter: false})
+	assert.False(t, model.uiState.IsHeaderHidden())
+	assert.True(t, model.uiState.IsFooterHidden())
+
+	model.toggleHeaderFooter()
+	assert.True(t, model.uiState.IsHeaderHidden())
+	assert.True(t, model.uiState.IsFooterHidden())
+}
+
+func TestDefaultSettingsShowHeaderAndFooter(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.SetLoadedSettings(settings.DefaultSettings())
+
+	assert.False(t, model.uiState.IsHeaderHidden())
+	assert.False(t, model.uiState.IsFooterHidden())
+}
@@ -0,0 +1,169 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+)
+
+// handleCommandInput handles key input while the ":" command line is active.
+func (m *Model) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		m.uiState.SetCommandMode(false)
+		return m.handleCtrlC()
+	case tea.KeyEsc:
+		m.uiState.SetCommandMode(false)
+	case tea.KeyEnter:
+		command := m.uiState.GetCommandQuery()
+		m.uiState.SetCommandMode(false)
+		return m, m.executeCommand(command)
+	case tea.KeyBackspace:
+		m.uiState.BackspaceCommandQuery()
+	case tea.KeyRunes, tea.KeySpace:
+		for _, r := range msg.Runes {
+			m.uiState.AppendToCommandQuery(r)
+		}
+	}
+	return m, nil
+}
+
+// executeCommand runs a ":" command. Parse errors are shown in the footer.
+func (m *Model) executeCommand(input string) tea.Cmd {
+	name, args, err := parseCommand(input)
+	if err != nil {
+		m.errorHandler.Error(err.Error())
+		return errorMsgAfter(errorClearDuration)
+	}
+	switch name {
+	case "clear":
+		return m.handleClearView()
+	case "dismiss-all":
+		return m.handleDismissAll()
+	case "reveal":
+		return m.handleReveal()
+	case "dismiss-older":
+		return m.handleDismissOlder(args[0])
+	default:
+		return nil
+	}
+}
+
+// handleClearView asks for confirmation before dismissing every active
+// notification in the current filtered view.
+func (m *Model) handleClearView() tea.Cmd {
+	ids := m.activeFilteredIDs()
+	if len(ids) == 0 {
+		m.errorHandler.Info("Nothing to clear")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.uiState.SetPendingAction(PendingAction{
+		Type:    ActionDismissFiltered,
+		Message: fmt.Sprintf("Dismiss %d notifications in the current view?", len(ids)),
+		Count:   len(ids),
+		IDs:     ids,
+	})
+	m.uiState.SetConfirmationMode(true)
+	return nil
+}
+
+// handleDismissAll dismisses every active notification regardless of tab,
+// filters, or search. It asks for confirmation first unless confirm_dismiss_all
+// is disabled.
+func (m *Model) handleDismissAll() tea.Cmd {
+	ids := make([]int, 0, len(m.notifications))
+	for _, notif := range m.notifications {
+		if notif.State != domain.StateDismissed {
+			ids = append(ids, notif.ID)
+		}
+	}
+	if len(ids) == 0 {
+		m.errorHandler.Info("Nothing to dismiss")
+		return errorMsgAfter(errorClearDuration)
+	}
+	if !m.confirmDismissAll {
+		return m.handleDismissIDs(ids)
+	}
+
+	m.uiState.SetPendingAction(PendingAction{
+		Type:    ActionDismissAll,
+		Message: fmt.Sprintf("Dismiss all %d active notifications?", len(ids)),
+		Count:   len(ids),
+		IDs:     ids,
+	})
+	m.uiState.SetConfirmationMode(true)
+	return nil
+}
+
+// handleDismissOlder asks for confirmation before dismissing every active
+// notification older than duration, measured back from now.
+func (m *Model) handleDismissOlder(duration string) tea.Cmd {
+	// parseCommand has already checked that duration is a positive duration.
+	age, _ := time.ParseDuration(duration)
+
+	cutoff := time.Now().Add(-age).UTC().Format(time.RFC3339)
+	older, err := storage.ListNotificationsParsed("active", "", "", "", "", cutoff, "", "")
+	if err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to list notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	if len(older) == 0 {
+		m.errorHandler.Info(fmt.Sprintf("Nothing older than %s to dismiss", duration))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	ids := make([]int, 0, len(older))
+	for _, notif := range older {
+		ids = append(ids, notif.ID)
+	}
+	m.uiState.SetPendingAction(PendingAction{
+		Type:    ActionDismissOlder,
+		Message: fmt.Sprintf("Dismiss %d notifications older than %s?", len(ids), duration),
+		Count:   len(ids),
+		IDs:     ids,
+	})
+	m.uiState.SetConfirmationMode(true)
+	return nil
+}
+
+// activeFilteredIDs returns IDs of active notifications in the current filtered set.
+func (m *Model) activeFilteredIDs() []int {
+	ids := make([]int, 0, len(m.filtered))
+	for _, notif := range m.filtered {
+		if notif.State == domain.StateDismissed {
+			continue
+		}
+		ids = append(ids, notif.ID)
+	}
+	return ids
+}
+
+// handleDismissIDs dismisses the given notifications in one transaction and
+// records them as one undoable action.
+func (m *Model) handleDismissIDs(ids []int) tea.Cmd {
+	idStrings := make([]string, len(ids))
+	for i, notifID := range ids {
+		idStrings[i] = strconv.Itoa(notifID)
+	}
+	if err := m.ensureInteractionController().DismissNotifications(idStrings); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to dismiss notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	m.pushUndo(undoDismiss, ids...)
+
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+	m.uiState.SetCursor(0)
+	m.adjustCursorBounds()
+	m.updateViewportContent()
+
+	m.errorHandler.Success(fmt.Sprintf("Dismissed %d notifications", len(ids)))
+	return errorMsgAfter(errorClearDuration)
+}
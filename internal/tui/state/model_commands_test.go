@@ -0,0 +1,371 @@
+package state
+
+import (
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typeCommand(t *testing.T, model *Model, command string) *Model {
+	t.Helper()
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	model = updated.(*Model)
+	require.True(t, model.uiState.IsCommandMode())
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(command)})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(*Model)
+}
+
+func TestClearCommandDismissesOnlyFilteredNotifications(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	buildA, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	buildB, err := storage.AddNotification("build passed", now, "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	deploy, err := storage.AddNotification("deploy done", now, "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	model.uiState.SetSearchQuery("build")
+	model.applySearchFilter()
+	model.resetCursor()
+	require.Len(t, model.filtered, 2)
+
+	model = typeCommand(t, model, "clear")
+	require.True(t, model.uiState.IsConfirmationMode())
+	action := model.uiState.GetPendingAction()
+	assert.Equal(t, ActionDismissFiltered, action.Type)
+	assert.Equal(t, 2, action.Count)
+	assert.Contains(t, action.Message, "Dismiss 2 notifications")
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(*Model)
+	assert.False(t, model.uiState.IsConfirmationMode())
+
+	for id, want := range map[string]domain.NotificationState{
+		buildA: domain.StateDismissed,
+		buildB: domain.StateDismissed,
+		deploy: domain.StateActive,
+	} {
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		assert.Equal(t, want, loaded.State, "notification %s", id)
+	}
+
+	model.handleUndo()
+	line, err := storage.GetNotificationByID(buildA)
+	require.NoError(t, err)
+	loaded, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateActive, loaded.State)
+}
+
+func TestClearCommandCancelledKeepsNotifications(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "one", State: domain.StateActive},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+
+	model = typeCommand(t, model, "clear")
+	require.True(t, model.uiState.IsConfirmationMode())
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	model = updated.(*Model)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	assert.Len(t, model.filtered, 1)
+}
+
+func TestCommandModeInputAndUnknownCommand(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "one"}})
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("nopx")})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model = updated.(*Model)
+	assert.Equal(t, "nop", model.uiState.GetCommandQuery())
+	assert.Contains(t, model.View(), ":nop")
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(*Model)
+	assert.NotNil(t, cmd)
+	assert.False(t, model.uiState.IsCommandMode())
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, `Unknown command "nop"`, msg.Text)
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(*Model)
+	assert.False(t, model.uiState.IsCommandMode())
+	assert.Empty(t, model.uiState.GetCommandQuery())
+}
+
+func TestDismissAllCommandPromptsWithActiveCount(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	build, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	deploy, err := storage.AddNotification("deploy done", now, "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.SetLoadedSettings(settings.DefaultSettings())
+	model.switchActiveTab(settings.TabAll)
+	model.uiState.SetSearchQuery("build")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+
+	model = typeCommand(t, model, "dismiss-all")
+	require.True(t, model.uiState.IsConfirmationMode())
+	action := model.uiState.GetPendingAction()
+	assert.Equal(t, ActionDismissAll, action.Type)
+	assert.Equal(t, 2, action.Count, "dismiss-all ignores the search query")
+	assert.Equal(t, "Dismiss all 2 active notifications?", action.Message)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(*Model)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	for _, id := range []string{build, deploy} {
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StateDismissed, loaded.State, "notification %s", id)
+	}
+}
+
+func TestDismissAllCommandSkipsPromptWhenConfirmationDisabled(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	loaded := settings.DefaultSettings()
+	loaded.ConfirmDismissAll = false
+	model.SetLoadedSettings(loaded)
+
+	model = typeCommand(t, model, "dismiss-all")
+	assert.False(t, model.uiState.IsConfirmationMode())
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	notif, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateDismissed, notif.State)
+
+	model.handleUndo()
+	line, err = storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	notif, err = domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateActive, notif.State)
+}
+
+// bulkDismissController counts the calls a bulk dismiss makes to the
+// controller it wraps.
+type bulkDismissController struct {
+	model.InteractionController
+	bulkCalls   int
+	singleCalls int
+}
+
+func (c *bulkDismissController) DismissNotifications(ids []string) error {
+	c.bulkCalls++
+	return c.InteractionController.DismissNotifications(ids)
+}
+
+func (c *bulkDismissController) DismissNotification(id string) error {
+	c.singleCalls++
+	return c.InteractionController.DismissNotification(id)
+}
+
+func TestDismissAllCommandDismissesOnlyCountedNotifications(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, message := range []string{"build failed", "deploy done", "tests passed"} {
+		_, err := storage.AddNotification(message, now, "$1", "@1", "%1", "", "info")
+		require.NoError(t, err)
+	}
+
+	m, err := NewModel(mockClient)
+	require.NoError(t, err)
+	m.SetLoadedSettings(settings.DefaultSettings())
+	ctrl := &bulkDismissController{InteractionController: m.ensureInteractionController()}
+	m.interactionCtrl = ctrl
+
+	m = typeCommand(t, m, "dismiss-all")
+	late, err := storage.AddNotification("arrived after load", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+
+	assert.Equal(t, 1, ctrl.bulkCalls)
+	assert.Zero(t, ctrl.singleCalls, "dismiss-all does not dismiss one at a time")
+	active, err := storage.ListNotificationsParsed("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	require.Len(t, active, 1, "a notification added after the count stays active")
+	assert.Equal(t, late, strconv.Itoa(active[0].ID))
+}
+
+func TestDismissOlderCommandDismissesInOneCall(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	old := time.Now().UTC().Add(-2 * time.Hour).Format(time.RFC3339)
+	for _, message := range []string{"nightly build", "backup done"} {
+		_, err := storage.AddNotification(message, old, "$1", "@1", "%1", "", "info")
+		require.NoError(t, err)
+	}
+
+	m, err := NewModel(mockClient)
+	require.NoError(t, err)
+	m.SetLoadedSettings(settings.DefaultSettings())
+	ctrl := &bulkDismissController{InteractionController: m.ensureInteractionController()}
+	m.interactionCtrl = ctrl
+
+	m = typeCommand(t, m, "dismiss-older 1h")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+
+	assert.Equal(t, 1, ctrl.bulkCalls)
+	assert.Zero(t, ctrl.singleCalls, "dismiss-older does not dismiss one at a time")
+	active, err := storage.ListNotificationsParsed("active", "", "", "", "", "", "", "")
+	require.NoError(t, err)
+	assert.Empty(t, active)
+	msg, ok := m.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Dismissed 2 notifications", msg.Text)
+}
+
+func TestDismissOlderCommandDismissesOnlyOlderNotifications(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC()
+	oldest, err := storage.AddNotification("nightly build", now.Add(-3*time.Hour).Format(time.RFC3339), "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	old, err := storage.AddNotification("backup done", now.Add(-2*time.Hour).Format(time.RFC3339), "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	recent, err := storage.AddNotification("deploy done", now.Add(-10*time.Minute).Format(time.RFC3339), "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.uiState.SetSearchQuery("deploy")
+	model.applySearchFilter()
+
+	model = typeCommand(t, model, "dismiss-older 1h")
+	require.True(t, model.uiState.IsConfirmationMode())
+	action := model.uiState.GetPendingAction()
+	assert.Equal(t, ActionDismissOlder, action.Type)
+	assert.Equal(t, 2, action.Count, "dismiss-older ignores the search query")
+	assert.Equal(t, "Dismiss 2 notifications older than 1h?", action.Message)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(*Model)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	for id, want := range map[string]domain.NotificationState{
+		oldest: domain.StateDismissed,
+		old:    domain.StateDismissed,
+		recent: domain.StateActive,
+	} {
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		assert.Equal(t, want, loaded.State, "notification %s", id)
+	}
+}
+
+func TestDismissOlderCommandRejectsBadDurations(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	_, err := storage.AddNotification("nightly build", time.Now().UTC().Add(-time.Hour).Format(time.RFC3339), "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+
+	for command, want := range map[string]string{
+		"dismiss-older":        "dismiss-older expects 1 argument, got 0",
+		"dismiss-older 3 days": "dismiss-older expects 1 argument, got 2",
+		"dismiss-older soon":   `Invalid value "soon" for dismiss-older (allowed: a positive duration such as 30m or 48h)`,
+		"dismiss-older -1h":    `Invalid value "-1h" for dismiss-older (allowed: a positive duration such as 30m or 48h)`,
+		"dismiss-older 2h":     "Nothing older than 2h to dismiss",
+	} {
+		model = typeCommand(t, model, command)
+		assert.False(t, model.uiState.IsConfirmationMode(), command)
+		msg, ok := model.errorHandler.GetLatest()
+		require.True(t, ok)
+		assert.Equal(t, want, msg.Text, command)
+	}
+}
+
+func stubOpenPath(t *testing.T, open func(string) error) {
+	t.Helper()
+	original := openPath
+	openPath = open
+	t.Cleanup(func() { openPath = original })
+}
+
+func TestRevealCommandOpensStateDir(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	var opened []string
+	stubOpenPath(t, func(path string) error {
+		opened = append(opened, path)
+		return nil
+	})
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model = typeCommand(t, model, "reveal")
+
+	assert.Equal(t, []string{storage.GetStateDir()}, opened)
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Opened state directory: "+storage.GetStateDir(), msg.Text)
+}
+
+func TestRevealCommandReportsStateDirWithoutOpener(t *testing.T) {
+	stateDir := setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+	stubOpenPath(t, func(string) error { return exec.ErrNotFound })
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model = typeCommand(t, model, "reveal")
+
+	require.Equal(t, stateDir, storage.GetStateDir())
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "State directory: "+stateDir, msg.Text)
+}
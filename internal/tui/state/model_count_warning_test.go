@@ -0,0 +1,62 @@
+package state
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func countWarningNotifications(count int) []domain.Notification {
+	notifs := make([]domain.Notification, 0, count)
+	for i := 1; i <= count; i++ {
+		notifs = append(notifs, domain.Notification{ID: i, Message: "msg"})
+	}
+	return notifs
+}
+
+func TestCountWarningBannerAppearsAtThreshold(t *testing.T) {
+	model := newTestModel(t, countWarningNotifications(3))
+	model.activeCountWarning = 3
+	model.uiState.SetViewportDimensions(120, 20)
+	model.syncNotificationMirrors()
+
+	assert.True(t, model.uiState.IsBannerVisible())
+	assert.Equal(t, 20-headerFooterLines-1, model.uiState.GetViewport().Height)
+	assert.Contains(t, model.View(), "3 active notifications — consider cleanup (tmux-intray cleanup)")
+}
+
+func TestCountWarningBannerHiddenBelowThreshold(t *testing.T) {
+	model := newTestModel(t, countWarningNotifications(3))
+	model.activeCountWarning = 3
+	model.uiState.SetViewportDimensions(120, 20)
+	model.syncNotificationMirrors()
+	assert.True(t, model.uiState.IsBannerVisible())
+
+	model.notificationService.SetNotifications(countWarningNotifications(2))
+	model.syncNotificationMirrors()
+
+	assert.False(t, model.uiState.IsBannerVisible())
+	assert.Equal(t, 20-headerFooterLines, model.uiState.GetViewport().Height)
+	assert.NotContains(t, model.View(), "consider cleanup")
+}
+
+func TestCountWarningBannerIgnoresDismissed(t *testing.T) {
+	notifs := countWarningNotifications(3)
+	notifs[0].State = domain.StateDismissed
+	model := newTestModel(t, notifs)
+	model.activeCountWarning = 3
+	model.syncNotificationMirrors()
+
+	assert.False(t, model.uiState.IsBannerVisible())
+}
+
+func TestCountWarningBannerDisabledByZero(t *testing.T) {
+	model := newTestModel(t, countWarningNotifications(5))
+	model.activeCountWarning = 0
+	model.syncNotificationMirrors()
+
+	assert.False(t, model.uiState.IsBannerVisible())
+	assert.False(t, strings.Contains(model.View(), "consider cleanup"))
+}
@@ -0,0 +1,71 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/render"
+)
+
+// detailView is the notification shown by the detail view, with the extra
+// data loaded for it when the view opened.
+type detailView struct {
+	notification domain.Notification
+	details      model.NotificationDetails
+}
+
+// handleShowDetails opens the detail view for the selected notification.
+func (m *Model) handleShowDetails() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	selected, ok := m.selectedNotification()
+	if !ok {
+		m.errorHandler.Error("details: select a notification to show its details")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	details, err := m.ensureInteractionController().LoadNotificationDetails(strconv.Itoa(selected.ID))
+	if err != nil {
+		m.errorHandler.Error(fmt.Sprintf("details: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.detail = &detailView{notification: selected, details: details}
+	return nil
+}
+
+// handleDetailKey handles key input while the detail view is open. Esc, Enter,
+// "i" and "q" close it; Ctrl+C still quits.
+func (m *Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		m.detail = nil
+		return m.handleCtrlC()
+	case "esc", "enter", "i", "q":
+		m.detail = nil
+	}
+	return m, nil
+}
+
+// renderDetailView renders the open detail view in place of the list.
+func (m *Model) renderDetailView() string {
+	n := m.detail.notification
+	location := ""
+	if n.Session != "" && n.Window != "" {
+		location = m.tmuxLocation(jumpTarget{Session: n.Session, Window: n.Window, Pane: n.Pane})
+	}
+	return render.NotificationDetails(render.Details{
+		Notification:       n,
+		Location:           location,
+		PreviousOccurrence: m.detail.details.PreviousOccurrence,
+		FullMessage:        m.detail.details.FullMessage,
+		PaneContext:        m.detail.details.PaneContext,
+		Width:              m.uiState.GetWidth(),
+		Now:                m.clock(),
+	})
+}
@@ -0,0 +1,91 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// detailController serves fixed details and records the requested ID.
+type detailController struct {
+	model.InteractionController
+	details model.NotificationDetails
+	err     error
+	id      *string
+}
+
+func (c detailController) LoadNotificationDetails(id string) (model.NotificationDetails, error) {
+	*c.id = id
+	return c.details, c.err
+}
+
+func newDetailTestModel(t *testing.T, ctrl detailController) *Model {
+	t.Helper()
+	m := newTestModel(t, []domain.Notification{
+		{ID: 7, Message: "build failed", Level: domain.LevelError, Timestamp: "2024-01-01T12:00:00Z"},
+	})
+	m.uiState.SetActiveTab(settings.TabAll)
+	m.applySearchFilter()
+	m.resetCursor()
+	m.interactionCtrl = ctrl
+	return m
+}
+
+func TestDetailsKeyShowsLastSeen(t *testing.T) {
+	var requested string
+	m := newDetailTestModel(t, detailController{
+		details: model.NotificationDetails{
+			PreviousOccurrence: "2024-01-01T10:00:00Z",
+			FullMessage:        "build failed: exit status 2",
+		},
+		id: &requested,
+	})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	require.NotNil(t, m.detail)
+	assert.Equal(t, "7", requested)
+	view := m.View()
+	assert.Contains(t, view, "Notification 7")
+	assert.Contains(t, view, "Last seen: 2024-01-01T10:00:00Z")
+	assert.Contains(t, view, "build failed: exit status 2")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, m.detail)
+	assert.NotContains(t, m.View(), "Last seen")
+}
+
+func TestDetailsKeyReportsLoadErrors(t *testing.T) {
+	var requested string
+	m := newDetailTestModel(t, detailController{err: errors.New("storage down"), id: &requested})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	assert.NotNil(t, cmd)
+	assert.Nil(t, m.detail)
+	msg, ok := m.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Contains(t, msg.Text, "storage down")
+}
+
+func TestDetailsViewSwallowsListKeys(t *testing.T) {
+	var requested string
+	m := newDetailTestModel(t, detailController{id: &requested})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	require.NotNil(t, m.detail)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+	assert.Nil(t, cmd)
+	assert.NotNil(t, m.detail, "only the close keys leave the detail view")
+	assert.Len(t, m.filtered, 1)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, m.detail)
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// dwellTickInterval is how often the selection is checked for auto-read.
+const dwellTickInterval = 250 * time.Millisecond
+
+// dwellTickMsg triggers a check of how long the selection has been held.
+type dwellTickMsg struct{}
+
+// dwellState tracks the unread notification currently held under the cursor.
+type dwellState struct {
+	id    int
+	since time.Time
+}
+
+// clock returns the current time, using the injected clock when set.
+func (m *Model) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// scheduleDwellTick returns the next dwell tick, or nil when auto-read is disabled.
+func (m *Model) scheduleDwellTick() tea.Cmd {
+	if m.autoReadDwell <= 0 {
+		return nil
+	}
+	return tea.Tick(dwellTickInterval, func(time.Time) tea.Msg {
+		return dwellTickMsg{}
+	})
+}
+
+// handleDwellTick marks the selected notification read once it has stayed
+// selected for the configured dwell time. Moving the cursor restarts the timer.
+func (m *Model) handleDwellTick() tea.Cmd {
+	if m.autoReadDwell <= 0 {
+		m.dwell = dwellState{}
+		return nil
+	}
+
+	selected, ok := m.selectedNotification()
+	switch {
+	case !ok || selected.IsRead():
+		m.dwell = dwellState{}
+	case selected.ID != m.dwell.id:
+		m.dwell = dwellState{id: selected.ID, since: m.clock()}
+	case m.clock().Sub(m.dwell.since) >= m.autoReadDwell:
+		m.dwell = dwellState{}
+		if cmd := m.markSelectedRead(); cmd != nil {
+			return tea.Batch(cmd, m.scheduleDwellTick())
+		}
+	}
+	return m.scheduleDwellTick()
+}
@@ -0,0 +1,97 @@
+package state
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	current time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.current
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.current = c.current.Add(d)
+}
+
+func newDwellTestModel(t *testing.T, dwell time.Duration) (*Model, *fakeClock) {
+	t.Helper()
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	_, err := storage.AddNotification("first", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = storage.AddNotification("second", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	require.Len(t, model.filtered, 2)
+
+	clock := &fakeClock{current: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	model.now = clock.Now
+	model.autoReadDwell = dwell
+	return model, clock
+}
+
+func isStoredRead(t *testing.T, id int) bool {
+	t.Helper()
+	line, err := storage.GetNotificationByID(strconv.Itoa(id))
+	require.NoError(t, err)
+	notif, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	return notif.IsRead()
+}
+
+func TestDwellMarksSelectedNotificationRead(t *testing.T) {
+	model, clock := newDwellTestModel(t, 2*time.Second)
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+
+	require.NotNil(t, model.handleDwellTick())
+	clock.Advance(time.Second)
+	model.handleDwellTick()
+	assert.False(t, isStoredRead(t, selected.ID))
+
+	clock.Advance(time.Second)
+	model.handleDwellTick()
+	assert.True(t, isStoredRead(t, selected.ID))
+}
+
+func TestDwellMovingAwayCancelsAutoRead(t *testing.T) {
+	model, clock := newDwellTestModel(t, 2*time.Second)
+	first, ok := model.selectedNotification()
+	require.True(t, ok)
+
+	model.handleDwellTick()
+	clock.Advance(time.Second)
+	model.handleMoveDown()
+	model.handleDwellTick()
+	clock.Advance(time.Second)
+	model.handleDwellTick()
+
+	assert.False(t, isStoredRead(t, first.ID))
+	second, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.False(t, isStoredRead(t, second.ID))
+}
+
+func TestDwellDisabledByDefault(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+
+	assert.Nil(t, model.Init())
+	assert.Nil(t, model.handleDwellTick())
+}
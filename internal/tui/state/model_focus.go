@@ -0,0 +1,41 @@
+package state
+
+import (
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+)
+
+// isFocusMode reports whether focus mode is active.
+func (m *Model) isFocusMode() bool {
+	return m.preFocusFilters != nil
+}
+
+// toggleFocusMode narrows the list to active unread notifications and hides
+// the tabs, header, and footer. Toggling again restores the previous filters.
+func (m *Model) toggleFocusMode() {
+	if m.isFocusMode() {
+		m.filters = *m.preFocusFilters
+		m.preFocusFilters = nil
+		m.uiState.SetChromeHidden(false)
+	} else {
+		previous := m.filters
+		m.preFocusFilters = &previous
+		m.filters.State = settings.StateFilterActive
+		m.filters.Read = settings.ReadFilterUnread
+		m.uiState.SetChromeHidden(true)
+	}
+
+	m.applySearchFilter()
+	m.resetCursor()
+
+	m.autoSaveSettings()
+}
+
+// persistedFilters returns the filters to save to disk.
+// While focus mode is active the pre-focus filters are saved so that
+// the next session starts with the user's own filters.
+func (m *Model) persistedFilters() settings.Filter {
+	if m.isFocusMode() {
+		return *m.preFocusFilters
+	}
+	return m.filters
+}
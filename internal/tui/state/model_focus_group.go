@@ -0,0 +1,96 @@
+package state
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// toggleFocusGroup focuses the group holding the selection, hiding every
+// other group until it is toggled off. On a notification row the focus goes
+// to the group it belongs to.
+func (m *Model) toggleFocusGroup() tea.Cmd {
+	if !m.isGroupedView() {
+		return nil
+	}
+	selectedID := m.getNodeIdentifier(m.selectedVisibleNode())
+
+	if m.uiState.GetFocusGroup() != "" {
+		m.uiState.ClearFocusGroup()
+		m.restoreCursor(selectedID)
+		m.updateViewportContent()
+		m.errorHandler.Info("Showing all groups")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	group := m.selectedGroup()
+	if group == nil {
+		return nil
+	}
+	m.uiState.SetFocusGroup(m.getNodeIdentifier(group))
+	m.restoreCursor(selectedID)
+	m.updateViewportContent()
+	m.errorHandler.Info(fmt.Sprintf("Focused on %s %s (zf to show all groups)", getGroupTypeLabel(group.Kind), group.Title))
+	return errorMsgAfter(errorClearDuration)
+}
+
+// selectedGroup returns the selected group node, or the group holding the
+// selected notification.
+func (m *Model) selectedGroup() *model.TreeNode {
+	node := m.selectedVisibleNode()
+	if node == nil {
+		return nil
+	}
+	if m.isGroupNode(node) {
+		return node
+	}
+	path := m.findNodePath(m.treeService.GetTreeRoot(), node)
+	for i := len(path) - 1; i >= 0; i-- {
+		if m.isGroupNode(path[i]) {
+			return path[i]
+		}
+	}
+	return nil
+}
+
+// filterFocusGroup keeps only the focused group and its descendants. When no
+// group is focused, or the focused group is no longer shown, every node is
+// kept.
+func (m *Model) filterFocusGroup(nodes []*model.TreeNode) []*model.TreeNode {
+	identifier := m.uiState.GetFocusGroup()
+	if identifier == "" {
+		return nodes
+	}
+	var focused *model.TreeNode
+	for _, node := range nodes {
+		if m.isGroupNode(node) && m.getNodeIdentifier(node) == identifier {
+			focused = node
+			break
+		}
+	}
+	if focused == nil {
+		return nodes
+	}
+
+	members := make(map[*model.TreeNode]bool)
+	var collect func(node *model.TreeNode)
+	collect = func(node *model.TreeNode) {
+		members[node] = true
+		for _, child := range node.Children {
+			collect(child)
+		}
+	}
+	collect(focused)
+
+	// A "+N more" row is not a child of its group; it directly follows it.
+	visible := make([]*model.TreeNode, 0, len(nodes))
+	kept := false
+	for _, node := range nodes {
+		kept = members[node] || (kept && node.Kind == model.NodeKindMore)
+		if kept {
+			visible = append(visible, node)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,135 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	uimodel "github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFocusGroupTestModel(t *testing.T) *Model {
+	t.Helper()
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "one"},
+		{ID: 2, Session: "$1", Window: "@2", Pane: "%2", Message: "two"},
+		{ID: 3, Session: "$2", Window: "@3", Pane: "%3", Message: "three"},
+		{ID: 4, Session: "$3", Window: "@4", Pane: "%4", Message: "four"},
+	})
+	model.uiState.SetWidth(80)
+	model.uiState.GetViewport().Width = 80
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByPane)
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	var expand func(node *uimodel.TreeNode)
+	expand = func(node *uimodel.TreeNode) {
+		if isGroupNode(node) {
+			model.treeService.ExpandNode(node)
+		}
+		for _, child := range node.Children {
+			expand(child)
+		}
+	}
+	expand(model.getTreeRootForTest())
+	model.invalidateCache()
+	model.resetCursor()
+	return model
+}
+
+func pressZF(model *Model) tea.Cmd {
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	return cmd
+}
+
+func visibleTitles(model *Model) []string {
+	var titles []string
+	for _, node := range model.getVisibleNodesForTest() {
+		titles = append(titles, node.Title)
+	}
+	return titles
+}
+
+func TestFocusGroupShowsOnlyFocusedSubtree(t *testing.T) {
+	model := newFocusGroupTestModel(t)
+	all := visibleTitles(model)
+	require.Contains(t, all, "$2")
+	require.Contains(t, all, "$3")
+
+	nodes := model.getVisibleNodesForTest()
+	require.Equal(t, uimodel.NodeKindSession, nodes[0].Kind)
+	require.Equal(t, "$1", nodes[0].Title)
+	model.uiState.SetCursor(1) // window @1 inside session $1
+	require.Equal(t, uimodel.NodeKindWindow, nodes[1].Kind)
+
+	cmd := pressZF(model)
+
+	assert.NotNil(t, cmd, "focusing reports the focused group")
+	assert.Equal(t, "session:$1:window:@1", model.uiState.GetFocusGroup())
+	visible := model.getVisibleNodesForTest()
+	require.NotEmpty(t, visible)
+	assert.Equal(t, "@1", visible[0].Title, "the focused group leads the list")
+	for _, node := range visible {
+		assert.NotContains(t, []string{"$1", "$2", "$3", "@2", "%2", "@3", "@4"}, node.Title, "sibling groups are hidden")
+	}
+	assert.Contains(t, visibleTitles(model), "%1")
+	assert.Equal(t, 0, model.uiState.GetCursor(), "the cursor stays on the focused group")
+
+	view := model.uiState.GetViewport().View()
+	assert.NotContains(t, view, "$2")
+}
+
+func TestFocusGroupOnNotificationFocusesItsGroup(t *testing.T) {
+	model := newFocusGroupTestModel(t)
+	notifIndex := -1
+	for idx, node := range model.getVisibleNodesForTest() {
+		if node.Kind == uimodel.NodeKindNotification && node.Notification.ID == 3 {
+			notifIndex = idx
+		}
+	}
+	require.NotEqual(t, -1, notifIndex)
+	model.uiState.SetCursor(notifIndex)
+
+	pressZF(model)
+
+	visible := model.getVisibleNodesForTest()
+	require.Len(t, visible, 2)
+	assert.Equal(t, uimodel.NodeKindPane, visible[0].Kind)
+	assert.Equal(t, "%3", visible[0].Title)
+	assert.Equal(t, 3, visible[1].Notification.ID)
+	assert.Equal(t, 1, model.uiState.GetCursor(), "the cursor stays on the notification")
+}
+
+func TestFocusGroupToggleOffRestoresAllGroups(t *testing.T) {
+	model := newFocusGroupTestModel(t)
+	all := visibleTitles(model)
+
+	pressZF(model)
+	require.Less(t, len(model.getVisibleNodesForTest()), len(all))
+
+	cmd := pressZF(model)
+
+	assert.NotNil(t, cmd)
+	assert.Empty(t, model.uiState.GetFocusGroup())
+	assert.Equal(t, all, visibleTitles(model))
+	assert.Equal(t, 0, model.uiState.GetCursor())
+}
+
+func TestFocusGroupShowsEverythingWhenGroupDisappears(t *testing.T) {
+	model := newFocusGroupTestModel(t)
+	model.uiState.SetFocusGroup("session:$9")
+
+	assert.Equal(t, len(model.treeService.GetVisibleNodes()), len(model.getVisibleNodesForTest()))
+}
+
+func TestFocusGroupIgnoredOutsideGroupedView(t *testing.T) {
+	model := newFocusGroupTestModel(t)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+
+	assert.Nil(t, model.toggleFocusGroup())
+	assert.Empty(t, model.uiState.GetFocusGroup())
+}
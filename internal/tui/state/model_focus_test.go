@@ -0,0 +1,75 @@
+package state
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFocusTestModel(t *testing.T) *Model {
+	t.Helper()
+	setupConfig(t, t.TempDir())
+
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "active unread", State: domain.StateActive},
+		{ID: 2, Message: "active read", State: domain.StateActive, ReadTimestamp: "2024-01-02T10:00:00Z"},
+		{ID: 3, Message: "dismissed unread", State: domain.StateDismissed},
+	})
+	model.uiState.SetHeight(24)
+	model.uiState.UpdateViewportSize()
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.filters.Level = settings.LevelFilterInfo
+	model.applySearchFilter()
+	return model
+}
+
+func TestFocusModeNarrowsToActiveUnread(t *testing.T) {
+	model := newFocusTestModel(t)
+	require.Len(t, model.filtered, 2)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	model = updated.(*Model)
+
+	require.True(t, model.isFocusMode())
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 1, model.filtered[0].ID)
+	assert.Equal(t, settings.LevelFilterInfo, model.filters.Level)
+
+	assert.True(t, model.uiState.IsChromeHidden())
+	assert.Equal(t, 24, model.uiState.GetViewport().Height)
+	view := model.View()
+	assert.NotContains(t, view, "Recents")
+	assert.NotContains(t, view, "q: quit")
+
+	// Settings keep the user's own filters while focused.
+	state := model.ToState()
+	assert.Equal(t, "", state.Filters.State)
+	assert.Equal(t, "", state.Filters.Read)
+}
+
+func TestFocusModeExitRestoresFiltersAndChrome(t *testing.T) {
+	model := newFocusTestModel(t)
+	model.filters.Read = settings.ReadFilterRead
+	model.applySearchFilter()
+	before := model.filters
+
+	model.toggleFocusMode()
+	require.True(t, model.isFocusMode())
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	model = updated.(*Model)
+
+	assert.False(t, model.isFocusMode())
+	assert.Equal(t, before, model.filters)
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 2, model.filtered[0].ID)
+
+	assert.False(t, model.uiState.IsChromeHidden())
+	assert.Equal(t, 24-headerFooterLines, model.uiState.GetViewport().Height)
+	assert.True(t, strings.Contains(model.View(), "Recents"))
+}
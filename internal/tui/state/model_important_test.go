@@ -0,0 +1,67 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStarKeyTogglesImportant(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id, err := storage.AddNotification("release tagged", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	model.resetCursor()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'*'}})
+	model = updated.(*Model)
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.True(t, selected.Important)
+	assert.Contains(t, model.View(), "★ ")
+
+	require.NoError(t, storage.DismissNotification(id))
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	stored, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.True(t, stored.Important, "dismiss preserves the flag")
+	require.NoError(t, storage.UndismissNotification(id))
+	require.NoError(t, model.loadNotifications(true))
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'*'}})
+	model = updated.(*Model)
+	selected, ok = model.selectedNotification()
+	require.True(t, ok)
+	assert.False(t, selected.Important)
+}
+
+func TestSearchFiltersByImportant(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "release tagged", Important: true},
+		{ID: 2, Message: "build passed"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+
+	model.uiState.SetSearchQuery("important:true")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 1, model.filtered[0].ID)
+
+	model.uiState.SetSearchQuery("important:false")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 2, model.filtered[0].ID)
+}
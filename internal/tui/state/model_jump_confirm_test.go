@@ -0,0 +1,86 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	uimodel "github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newJumpConfirmTestModel(t *testing.T, currentSession string, jumps *int) *Model {
+	t.Helper()
+	model := newTestModel(t, []domain.Notification{
+		{ID: 7, Session: "$2", Window: "@2", Pane: "%3", Message: "build done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+	model.confirmCrossSessionJump = true
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		ensureTmuxRunningFn: func() bool { return true },
+		jumpToPaneFn: func(sessionID, windowID, paneID string) bool {
+			*jumps++
+			return true
+		},
+		currentContext: &uimodel.TmuxContext{SessionID: currentSession},
+		sessionNames:   map[string]string{"$2": "api"},
+	}
+	model.interactionCtrl = nil
+	return model
+}
+
+func TestSameSessionJumpDoesNotConfirm(t *testing.T) {
+	jumps := 0
+	model := newJumpConfirmTestModel(t, "$2", &jumps)
+
+	cmd := model.handleJump()
+
+	assert.NotNil(t, cmd)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, 1, jumps)
+}
+
+func TestCrossSessionJumpConfirmsFirst(t *testing.T) {
+	jumps := 0
+	model := newJumpConfirmTestModel(t, "$1", &jumps)
+
+	cmd := model.handleJump()
+
+	assert.Nil(t, cmd)
+	require.True(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, "Jump to session api?", model.uiState.GetPendingAction().Message)
+	assert.Equal(t, 0, jumps, "nothing happens before the answer")
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	assert.NotNil(t, cmd)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, 1, jumps)
+}
+
+func TestCrossSessionJumpDeclinedStays(t *testing.T) {
+	jumps := 0
+	model := newJumpConfirmTestModel(t, "$1", &jumps)
+
+	model.handleJump()
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	assert.Nil(t, cmd)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, 0, jumps)
+}
+
+func TestCrossSessionJumpWithoutSettingDoesNotConfirm(t *testing.T) {
+	jumps := 0
+	model := newJumpConfirmTestModel(t, "$1", &jumps)
+	model.confirmCrossSessionJump = false
+
+	cmd := model.handleJump()
+
+	assert.NotNil(t, cmd)
+	assert.False(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, 1, jumps)
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
 )
 
-// handleCtrlC handles Ctrl+C to exit the TUI.
+// handleCtrlC handles Ctrl+C to exit the TUI. With confirm_save_on_quit it
+// exits without saving or asking.
 func (m *Model) handleCtrlC() (tea.Model, tea.Cmd) {
+	if m.confirmSaveOnQuit {
+		return m, tea.Quit
+	}
 	if err := m.saveSettings(); err != nil {
 		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
 		return m, tea.Batch(tea.Quit, errorMsgAfter(errorClearDuration))
@@ -28,10 +32,20 @@ func (m *Model) handleEsc() (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handleEnter handles Enter to confirm search or jump to pane.
+// handleEnter handles Enter to confirm search or jump to pane. While typing a
+// search it jumps to the selected notification; with search_enter = "exit" it
+// then leaves search mode the same way Esc does.
 func (m *Model) handleEnter() (tea.Model, tea.Cmd) {
+	if m.isSummaryView() {
+		m.drillIntoSummaryRow()
+		return m, nil
+	}
 	if m.uiState.IsSearchMode() {
-		return m, m.handleJump()
+		cmd := m.handleJump()
+		if m.searchEnterExits {
+			m.handleEsc()
+		}
+		return m, cmd
 	}
 	if m.isGroupedView() && m.toggleNodeExpansion() {
 		return m, nil
@@ -62,8 +76,22 @@ func (m *Model) handleBackspace() {
 	}
 }
 
-// handleQuit handles quit action, saving settings first.
+// handleQuit handles quit action, saving settings first. With
+// confirm_save_on_quit and unsaved view changes it asks before saving.
 func (m *Model) handleQuit() (tea.Model, tea.Cmd) {
+	if m.confirmSaveOnQuit && m.ensureSettingsService().hasChanges(m.ToState()) {
+		m.uiState.SetPendingAction(PendingAction{
+			Type:    ActionSaveOnQuit,
+			Message: "Save view changes?",
+		})
+		m.uiState.SetConfirmationMode(true)
+		return m, nil
+	}
+	return m.saveAndQuit()
+}
+
+// saveAndQuit saves settings and exits the TUI.
+func (m *Model) saveAndQuit() (tea.Model, tea.Cmd) {
 	if err := m.saveSettings(); err != nil {
 		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
 		return m, tea.Batch(tea.Quit, errorMsgAfter(errorClearDuration))
@@ -92,9 +120,7 @@ func (m *Model) switchActiveTab(tab settings.Tab) {
 	m.applySearchFilter()
 	m.resetCursor()
 
-	if err := m.saveSettings(); err != nil {
-		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
-	}
+	m.autoSaveSettings()
 }
 
 // handleSaveSettingsSuccess handles successful settings save (no-op).
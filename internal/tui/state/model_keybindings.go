@@ -0,0 +1,170 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// keyAction names what a key does once its binding context is known.
+type keyAction string
+
+const (
+	keyActionTextInput       keyAction = "text-input"
+	keyActionMoveDown        keyAction = "move-down"
+	keyActionMoveUp          keyAction = "move-up"
+	keyActionMoveBottom      keyAction = "move-bottom"
+	keyActionPrefixG         keyAction = "prefix-g"
+	keyActionTabRecents      keyAction = "tab-recents"
+	keyActionTabAll          keyAction = "tab-all"
+	keyActionMarkRead        keyAction = "mark-read"
+	keyActionMarkUnread      keyAction = "mark-unread"
+	keyActionToggleRead      keyAction = "toggle-read"
+	keyActionMarkAllRead     keyAction = "mark-all-read"
+	keyActionMarkAllUnread   keyAction = "mark-all-unread"
+	keyActionToggleImportant keyAction = "toggle-important"
+	keyActionClaim           keyAction = "claim"
+	keyActionSearch          keyAction = "search"
+	keyActionHelp            keyAction = "help"
+	keyActionCollapse        keyAction = "collapse"
+	keyActionExpand          keyAction = "expand"
+	keyActionPrefixZ         keyAction = "prefix-z"
+	keyActionDismiss         keyAction = "dismiss"
+	keyActionDismissGroup    keyAction = "dismiss-group"
+	keyActionRestore         keyAction = "restore"
+	keyActionFocus           keyAction = "focus"
+	keyActionToggleChrome    keyAction = "toggle-chrome"
+	keyActionCopyJump        keyAction = "copy-jump"
+	keyActionJumpNewest      keyAction = "jump-newest"
+	keyActionCopyLocation    keyAction = "copy-location"
+	keyActionCopyMarkdown    keyAction = "copy-markdown"
+	keyActionDetails         keyAction = "details"
+	keyActionCommandLine     keyAction = "command-line"
+	keyActionPrefixNext      keyAction = "prefix-next"
+	keyActionPrefixPrev      keyAction = "prefix-prev"
+	keyActionQuit            keyAction = "quit"
+)
+
+// keyBinding is what a pressed key resolves to: the action it performs and
+// the normal-mode key whose handler runs it. Ctrl fallbacks in search
+// contexts resolve "ctrl+u" to the "u" handler.
+type keyBinding struct {
+	key    string
+	action keyAction
+}
+
+// keyBindingEntry binds one key in one context.
+type keyBindingEntry struct {
+	context keyBindingContext
+	key     string
+	binding keyBinding
+}
+
+// normalKeyBindings lists the single-key bindings of normal mode.
+var normalKeyBindings = []keyBinding{
+	{"j", keyActionMoveDown},
+	{"k", keyActionMoveUp},
+	{"G", keyActionMoveBottom},
+	{"g", keyActionPrefixG},
+	{"r", keyActionTabRecents},
+	{"a", keyActionTabAll},
+	{"R", keyActionMarkRead},
+	{"u", keyActionMarkUnread},
+	{"t", keyActionToggleRead},
+	{"A", keyActionMarkAllRead},
+	{"N", keyActionMarkAllUnread},
+	{"*", keyActionToggleImportant},
+	{"C", keyActionClaim},
+	{"/", keyActionSearch},
+	{"?", keyActionHelp},
+	{"h", keyActionCollapse},
+	{"l", keyActionExpand},
+	{"z", keyActionPrefixZ},
+	{"d", keyActionDismiss},
+	{"D", keyActionDismissGroup},
+	{"U", keyActionRestore},
+	{"f", keyActionFocus},
+	{"H", keyActionToggleChrome},
+	{"y", keyActionCopyJump},
+	{"o", keyActionJumpNewest},
+	{"Y", keyActionCopyLocation},
+	{"M", keyActionCopyMarkdown},
+	{":", keyActionCommandLine},
+	{"]", keyActionPrefixNext},
+	{"[", keyActionPrefixPrev},
+	{"i", keyActionDetails},
+	{"q", keyActionQuit},
+}
+
+// defaultKeyBindingEntries builds the binding table for every context.
+// Normal mode and search view use the plain keys. Search contexts also reach
+// each binding through Ctrl+<key>, which is the only way to run it while
+// typing a query, since plain keys are text input there.
+func defaultKeyBindingEntries() []keyBindingEntry {
+	entries := make([]keyBindingEntry, 0, len(normalKeyBindings)*4)
+	for _, binding := range normalKeyBindings {
+		entries = append(entries,
+			keyBindingEntry{context: keyBindingContextDefault, key: binding.key, binding: binding},
+			keyBindingEntry{context: keyBindingContextSearchView, key: binding.key, binding: binding},
+			keyBindingEntry{context: keyBindingContextSearchView, key: "ctrl+" + binding.key, binding: binding},
+			keyBindingEntry{context: keyBindingContextSearchInput, key: "ctrl+" + binding.key, binding: binding},
+		)
+	}
+	return entries
+}
+
+// keyBindingTable resolves pressed keys to bindings, per context.
+type keyBindingTable map[keyBindingContext]map[string]keyBinding
+
+// newKeyBindingTable builds a table from entries. A key bound to two different
+// actions in the same context is a conflict; every conflict is reported and
+// no table is returned.
+func newKeyBindingTable(entries []keyBindingEntry) (keyBindingTable, error) {
+	table := make(keyBindingTable)
+	var conflicts []string
+	for _, entry := range entries {
+		bindings, ok := table[entry.context]
+		if !ok {
+			bindings = make(map[string]keyBinding)
+			table[entry.context] = bindings
+		}
+		if existing, ok := bindings[entry.key]; ok && existing.action != entry.binding.action {
+			conflicts = append(conflicts, fmt.Sprintf("%q in %s context is bound to both %s and %s",
+				entry.key, entry.context, existing.action, entry.binding.action))
+			continue
+		}
+		bindings[entry.key] = entry.binding
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("conflicting key bindings: %s", strings.Join(conflicts, "; "))
+	}
+	return table, nil
+}
+
+// resolve returns the binding for key in context. In contexts that do not
+// allow bindings, any unbound single character is text input.
+func (t keyBindingTable) resolve(context keyBindingContext, key string) (keyBinding, bool) {
+	if binding, ok := t[context][key]; ok {
+		return binding, true
+	}
+	if !keyBindingPolicyFor(context).allowBindings && len([]rune(key)) == 1 {
+		return keyBinding{key: key, action: keyActionTextInput}, true
+	}
+	return keyBinding{}, false
+}
+
+// defaultKeyBindings is built once at package load. NewModel refuses to start
+// when it holds conflicts.
+var defaultKeyBindings, errKeyBindings = newKeyBindingTable(defaultKeyBindingEntries())
+
+func (c keyBindingContext) String() string {
+	switch c {
+	case keyBindingContextSearchView:
+		return "search view"
+	case keyBindingContextSearchInput:
+		return "search input"
+	default:
+		return "normal"
+	}
+}
@@ -0,0 +1,89 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultKeyBindingsHaveNoConflicts(t *testing.T) {
+	require.NoError(t, errKeyBindings)
+	require.NotNil(t, defaultKeyBindings)
+}
+
+func TestKeyBindingTableResolvesUPerContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		context keyBindingContext
+		key     string
+		want    keyBinding
+	}{
+		{"normal mode marks unread", keyBindingContextDefault, "u", keyBinding{"u", keyActionMarkUnread}},
+		{"search view marks unread", keyBindingContextSearchView, "u", keyBinding{"u", keyActionMarkUnread}},
+		{"search input types u", keyBindingContextSearchInput, "u", keyBinding{"u", keyActionTextInput}},
+		{"search input ctrl+u marks unread", keyBindingContextSearchInput, "ctrl+u", keyBinding{"u", keyActionMarkUnread}},
+		{"search input types q", keyBindingContextSearchInput, "q", keyBinding{"q", keyActionTextInput}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := defaultKeyBindings.resolve(tt.context, tt.key)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, ok := defaultKeyBindings.resolve(keyBindingContextDefault, "ctrl+u")
+	assert.False(t, ok, "ctrl fallback only applies in search contexts")
+}
+
+func TestNewKeyBindingTableReportsConflicts(t *testing.T) {
+	_, err := newKeyBindingTable([]keyBindingEntry{
+		{context: keyBindingContextDefault, key: "u", binding: keyBinding{"u", keyActionMarkUnread}},
+		{context: keyBindingContextSearchView, key: "u", binding: keyBinding{"u", keyActionDismiss}},
+		{context: keyBindingContextDefault, key: "u", binding: keyBinding{"u", keyActionQuit}},
+		{context: keyBindingContextDefault, key: "j", binding: keyBinding{"j", keyActionMoveDown}},
+		{context: keyBindingContextDefault, key: "j", binding: keyBinding{"j", keyActionMoveDown}},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"u" in normal context is bound to both mark-unread and quit`)
+	assert.NotContains(t, err.Error(), "search view", "the same key may differ across contexts")
+	assert.NotContains(t, err.Error(), `"j"`, "repeating an identical binding is not a conflict")
+}
+
+func TestUKeyFollowsBindingTableInNormalAndSearchMode(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	id, err := storage.AddNotification("Build finished", time.Now().UTC().Format(time.RFC3339), "", "", "", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, storage.MarkNotificationRead(id))
+
+	m, err := NewModel(mockClient)
+	require.NoError(t, err)
+	m.switchActiveTab(settings.TabAll)
+	require.Len(t, m.filtered, 1)
+	require.True(t, m.filtered[0].IsRead())
+
+	m.uiState.SetSearchMode(true)
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	assert.Equal(t, "u", m.uiState.GetSearchQuery())
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	stored, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.True(t, stored.IsRead(), "u in search input is query text")
+
+	m.uiState.SetSearchMode(false)
+	m.uiState.SetSearchQuery("")
+	m.applySearchFilter()
+	m.uiState.SetCursor(0)
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	assert.False(t, m.filtered[0].IsRead(), "u in normal mode marks unread")
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
 )
 
+// keyBindingContext selects which bindings apply to a key press. The same key
+// can mean different things per context: "u" marks unread in normal mode and is
+// query text in search input. See model_keybindings.go for the table.
 type keyBindingContext int
 
 const (
@@ -29,6 +32,14 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleConfirmation(msg)
 	}
 
+	if m.detail != nil {
+		return m.handleDetailKey(msg)
+	}
+
+	if m.uiState.IsCommandMode() {
+		return m.handleCommandInput(msg)
+	}
+
 	if handled, cmd := m.handlePendingKey(msg); handled {
 		return m, cmd
 	}
@@ -40,13 +51,13 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return nextModel, cmd
 	}
 
-	bindingKey, allowBindings := m.bindingKeyForMsg(msg)
-	if !allowBindings {
-		// In search mode, only text input is handled unless Ctrl is held.
+	binding, ok := defaultKeyBindings.resolve(m.currentKeyBindingContext(), msg.String())
+	if !ok || binding.action == keyActionTextInput {
+		// Text input was already appended to the query by handleRunes.
 		return m, nil
 	}
 
-	return m.handleKeyBinding(bindingKey, m.uiState.IsSearchMode())
+	return m.handleKeyBinding(binding, m.uiState.IsSearchMode())
 }
 
 // handleConfirmation handles key input during confirmation mode.
@@ -72,7 +83,12 @@ func (m *Model) handleConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case 'y', 'Y':
 			return m, m.executeConfirmedAction()
 		case 'n', 'N':
+			declined := m.uiState.GetPendingAction().Type
 			m.uiState.SetConfirmationMode(false)
+			if declined == ActionSaveOnQuit {
+				// Declining the save prompt still quits, leaving tui.toml untouched.
+				return m, tea.Quit
+			}
 			return m, nil
 		}
 	}
@@ -84,9 +100,17 @@ func (m *Model) executeConfirmedAction() tea.Cmd {
 	action := m.uiState.GetPendingAction()
 	m.uiState.SetConfirmationMode(false)
 
-	if action.Type == ActionDismissGroup {
+	switch action.Type {
+	case ActionDismissGroup:
 		return m.handleDismissByFilter(action.Session, action.Window, action.Pane)
-	} else {
+	case ActionDismissFiltered, ActionDismissOlder, ActionDismissAll:
+		return m.handleDismissIDs(action.IDs)
+	case ActionSaveOnQuit:
+		_, cmd := m.saveAndQuit()
+		return cmd
+	case ActionJump:
+		return m.completeJump(jumpTarget{Session: action.Session, Window: action.Window, Pane: action.Pane}, action.IDs)
+	default:
 		m.errorHandler.Error(fmt.Sprintf("Unknown action type: %s", action.Type))
 		return nil
 	}
@@ -134,6 +158,9 @@ func (m *Model) handleKeyType(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Cycle view mode in all contexts.
 		m.cycleViewMode()
 		return m, nil
+	case tea.KeyCtrlZ:
+		// Undo the last mutating action in all contexts.
+		return m, m.handleUndo()
 	case tea.KeyCtrlH:
 		// In search contexts, Ctrl+h moves cursor left (same as normal navigation)
 		if m.isSearchContext() {
@@ -167,26 +194,49 @@ func (m *Model) canProcessBinding() bool {
 	return !m.uiState.IsSearchMode()
 }
 
-// handleKeyBinding handles string-based key bindings.
-func (m *Model) handleKeyBinding(key string, allowInSearch bool) (tea.Model, tea.Cmd) {
-	switch key {
-	case "j", "k", "G", "g":
+// handleKeyBinding runs the action a key resolved to in the binding table.
+func (m *Model) handleKeyBinding(binding keyBinding, allowInSearch bool) (tea.Model, tea.Cmd) {
+	key := binding.key
+	switch binding.action {
+	case keyActionMoveDown, keyActionMoveUp, keyActionMoveBottom, keyActionPrefixG:
 		return m.handleNavigationKeys(key, allowInSearch)
-	case "r", "a":
+	case keyActionTabRecents, keyActionTabAll:
 		return m.handleTabSwitchingKeys(key)
-	case "R", "u":
+	case keyActionMarkRead, keyActionMarkUnread, keyActionToggleRead, keyActionMarkAllRead, keyActionMarkAllUnread, keyActionToggleImportant, keyActionClaim:
 		return m.handleMarkKeys(key)
-	case "/", "?":
+	case keyActionSearch, keyActionHelp:
 		return m.handleModeKeys(key, allowInSearch)
-	case "h", "l", "z":
+	case keyActionCollapse, keyActionExpand, keyActionPrefixZ:
 		return m.handleTreeKeys(key, allowInSearch)
-	case "d", "D":
+	case keyActionDismiss, keyActionDismissGroup:
 		return m.handleDismissKeys(key)
-	case "i":
-		// In search mode, 'i' is handled by KeyRunes
-		// This is a no-op but kept for documentation
+	case keyActionRestore:
+		return m, m.handleRestore()
+	case keyActionFocus:
+		m.toggleFocusMode()
+		return m, nil
+	case keyActionToggleChrome:
+		m.toggleHeaderFooter()
 		return m, nil
-	case "q":
+	case keyActionCopyJump:
+		return m, m.handleCopyJumpCommand()
+	case keyActionCopyLocation:
+		return m, m.handleCopyLocation()
+	case keyActionCopyMarkdown:
+		return m, m.handleCopyMarkdown()
+	case keyActionDetails:
+		return m, m.handleShowDetails()
+	case keyActionJumpNewest:
+		return m, m.handleJumpNewestInGroup()
+	case keyActionCommandLine:
+		return m.handleBindingWithCheck(func() {
+			m.uiState.SetCommandMode(true)
+		}, allowInSearch)
+	case keyActionPrefixNext, keyActionPrefixPrev:
+		return m.handleBindingWithCheck(func() {
+			m.uiState.SetPendingKey(key)
+		}, allowInSearch)
+	case keyActionQuit:
 		return m.handleQuit()
 	}
 	return m, nil
@@ -234,6 +284,16 @@ func (m *Model) handleMarkKeys(key string) (tea.Model, tea.Cmd) {
 		return m, m.markSelectedRead()
 	case "u":
 		return m, m.markSelectedUnread()
+	case "t":
+		return m, m.toggleSelectedRead()
+	case "A":
+		return m, m.markFilteredRead()
+	case "N":
+		return m, m.markFilteredUnread()
+	case "*":
+		return m, m.toggleSelectedImportant()
+	case "C":
+		return m, m.toggleSelectedClaim()
 	}
 	return m, nil
 }
@@ -288,7 +348,7 @@ func (m *Model) handleBindingWithCheck(fn func(), allowInSearch bool) (tea.Model
 	return m, nil
 }
 
-// handlePendingKey handles multi-key sequences (gg, za, zz, etc.).
+// handlePendingKey handles multi-key sequences (gg, gi, za, zf, zz, etc.).
 func (m *Model) handlePendingKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 	key, allowBindings := m.bindingKeyForMsg(msg)
 	if !allowBindings {
@@ -301,11 +361,29 @@ func (m *Model) handlePendingKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			m.toggleFold()
 			return true, nil
 		}
+		if key == "f" && m.uiState.GetPendingKey() == "z" && m.isGroupedView() {
+			m.uiState.ClearPendingKey()
+			return true, m.toggleFocusGroup()
+		}
 		if key == "g" && m.uiState.GetPendingKey() == "g" {
 			m.uiState.ClearPendingKey()
 			m.handleMoveTop()
 			return true, nil
 		}
+		if key == "i" && m.uiState.GetPendingKey() == "g" {
+			m.uiState.ClearPendingKey()
+			return true, m.handleMoveToFirstUnread()
+		}
+		if key == "e" && m.uiState.GetPendingKey() == "]" {
+			m.uiState.ClearPendingKey()
+			m.handleMoveToError(1)
+			return true, nil
+		}
+		if key == "e" && m.uiState.GetPendingKey() == "[" {
+			m.uiState.ClearPendingKey()
+			m.handleMoveToError(-1)
+			return true, nil
+		}
 		if m.uiState.GetPendingKey() != "z" || key != "z" {
 			m.uiState.ClearPendingKey()
 		}
@@ -315,7 +393,7 @@ func (m *Model) handlePendingKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 
 func (m *Model) bindingKeyForMsg(msg tea.KeyMsg) (string, bool) {
 	key := msg.String()
-	policy := m.keyBindingPolicyForContext(m.currentKeyBindingContext())
+	policy := keyBindingPolicyFor(m.currentKeyBindingContext())
 
 	if policy.ctrlFallsBack && strings.HasPrefix(key, "ctrl+") {
 		fallback := strings.TrimPrefix(key, "ctrl+")
@@ -341,7 +419,9 @@ func (m *Model) currentKeyBindingContext() keyBindingContext {
 	return keyBindingContextDefault
 }
 
-func (m *Model) keyBindingPolicyForContext(context keyBindingContext) keyBindingPolicy {
+// keyBindingPolicyFor reports whether plain keys are bindings in context and
+// whether Ctrl+<key> falls back to the plain binding.
+func keyBindingPolicyFor(context keyBindingContext) keyBindingPolicy {
 	switch context {
 	case keyBindingContextSearchInput:
 		return keyBindingPolicy{allowBindings: false, ctrlFallsBack: true}
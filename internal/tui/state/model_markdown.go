@@ -0,0 +1,77 @@
+package state
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+)
+
+// handleCopyMarkdown copies the selected notification formatted as markdown,
+// ready to paste into an issue or chat.
+func (m *Model) handleCopyMarkdown() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	selected, ok := m.selectedNotification()
+	if !ok {
+		m.errorHandler.Error("copy: select a notification to copy as markdown")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	location := ""
+	if selected.Session != "" && selected.Window != "" {
+		location = m.tmuxLocation(jumpTarget{Session: selected.Session, Window: selected.Window, Pane: selected.Pane})
+	}
+
+	markdown := notificationMarkdown(selected, location)
+	if err := m.ensureInteractionController().CopyToClipboard(markdown); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("copy: failed to copy markdown: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.errorHandler.Success(fmt.Sprintf("Copied notification %d as markdown", selected.ID))
+	return errorMsgAfter(errorClearDuration)
+}
+
+// notificationMarkdown formats n as a level badge heading, the message in a
+// fenced code block, then its location and timestamp. location is the
+// resolved "session:window.pane" and is left out when empty.
+func notificationMarkdown(n domain.Notification, location string) string {
+	level := n.Level.String()
+	if level == "" {
+		level = domain.LevelInfo.String()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "**[%s]** Notification %d\n\n", strings.ToUpper(level), n.ID)
+	fence := markdownFence(n.Message)
+	fmt.Fprintf(&b, "%s\n%s\n%s\n\n", fence, n.Message, fence)
+	if location != "" {
+		fmt.Fprintf(&b, "- Location: `%s`\n", location)
+	}
+	fmt.Fprintf(&b, "- Time: %s\n", n.Timestamp)
+	return b.String()
+}
+
+// markdownFence returns a backtick fence longer than any backtick run in
+// text, so a message containing ``` cannot close the block early.
+func markdownFence(text string) string {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+			continue
+		}
+		run = 0
+	}
+	if longest < 3 {
+		return "```"
+	}
+	return strings.Repeat("`", longest+1)
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyMarkdownCopiesFencedMessageAndLocation(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 7, Session: "$1", Window: "@2", Pane: "%3", Message: "build failed\nexit 1", Level: domain.LevelError, Timestamp: "2024-01-01T12:00:00Z"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	var copied string
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		copyToClipboardFn: func(text string) error {
+			copied = text
+			return nil
+		},
+		sessionNames: map[string]string{"$1": "api"},
+		windowNames:  map[string]string{"@2": "editor"},
+		paneNames:    map[string]string{"%3": "0"},
+	}
+	model.interactionCtrl = nil
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'M'}})
+
+	assert.NotNil(t, cmd)
+	assert.Contains(t, copied, "**[ERROR]**")
+	assert.Contains(t, copied, "```\nbuild failed\nexit 1\n```")
+	assert.Contains(t, copied, "`api:editor.0`")
+	assert.Contains(t, copied, "2024-01-01T12:00:00Z")
+}
+
+func TestNotificationMarkdownFenceOutlastsBackticksInMessage(t *testing.T) {
+	markdown := notificationMarkdown(domain.Notification{ID: 1, Message: "run ```make``` first"}, "")
+
+	assert.Contains(t, markdown, "````\nrun ```make``` first\n````")
+	assert.Contains(t, markdown, "**[INFO]**")
+	assert.NotContains(t, markdown, "Location")
+}
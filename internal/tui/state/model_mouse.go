@@ -0,0 +1,66 @@
+package state
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/render"
+)
+
+// handleMouseMsg sorts by the header column under a left click. Clicks
+// elsewhere, and clicks while a prompt or the command line is open, are ignored.
+func (m *Model) handleMouseMsg(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	if m.uiState.IsConfirmationMode() || m.uiState.IsCommandMode() || m.uiState.IsChromeHidden() || m.uiState.IsHeaderHidden() {
+		return m, nil
+	}
+	if msg.Y != m.headerLine() {
+		return m, nil
+	}
+
+	gutter := lipgloss.Width(render.SourceGutter(m.sourceGutter, "") + render.Gutter(false, m.selection))
+	field, ok := render.HeaderSortField(msg.X-gutter, m.uiState.GetWidth())
+	if !ok {
+		return m, nil
+	}
+	return m, m.sortByColumn(field)
+}
+
+// headerLine returns the screen line of the table header: below the tabs and,
+// when shown, the count warning banner and the active tab label.
+func (m *Model) headerLine() int {
+	line := 1
+	if m.countWarningBanner() != "" {
+		line++
+	}
+	if m.uiState.IsTabLabelVisible() {
+		line++
+	}
+	return line
+}
+
+// sortByColumn sorts by field. Choosing the current field flips the order;
+// a new field starts in descending order.
+func (m *Model) sortByColumn(field string) tea.Cmd {
+	if m.sortBy == field {
+		if m.sortOrder == settings.SortOrderAsc {
+			m.sortOrder = settings.SortOrderDesc
+		} else {
+			m.sortOrder = settings.SortOrderAsc
+		}
+	} else {
+		m.sortBy = field
+		m.sortOrder = settings.SortOrderDesc
+	}
+
+	m.applySearchFilter()
+	m.resetCursor()
+	m.autoSaveSettings()
+
+	m.errorHandler.Info(fmt.Sprintf("Sorted by %s (%s)", m.sortBy, m.sortOrder))
+	return errorMsgAfter(errorClearDuration)
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMouseTestModel(t *testing.T) *Model {
+	t.Helper()
+	setupConfig(t, t.TempDir())
+
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "first", Level: domain.LevelInfo},
+		{ID: 2, Message: "second", Level: domain.LevelError},
+	})
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetWidth(120)
+	model.sortBy = settings.SortByTimestamp
+	model.sortOrder = settings.SortOrderDesc
+	return model
+}
+
+func clickAt(t *testing.T, model *Model, x, y int) *Model {
+	t.Helper()
+	updated, _ := model.Update(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	return updated.(*Model)
+}
+
+func TestHeaderClickSortsByColumnAndTogglesOrder(t *testing.T) {
+	model := newMouseTestModel(t)
+	typeX := 4 // first cell of the TYPE column
+
+	model = clickAt(t, model, typeX, 1)
+	require.Equal(t, settings.SortByLevel, model.sortBy)
+	assert.Equal(t, settings.SortOrderDesc, model.sortOrder)
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Sorted by level (desc)", msg.Text)
+
+	model = clickAt(t, model, typeX, 1)
+	assert.Equal(t, settings.SortByLevel, model.sortBy)
+	assert.Equal(t, settings.SortOrderAsc, model.sortOrder)
+
+	model = clickAt(t, model, typeX, 1)
+	assert.Equal(t, settings.SortOrderDesc, model.sortOrder)
+}
+
+func TestHeaderClickOnSessionColumnSortsBySession(t *testing.T) {
+	model := newMouseTestModel(t)
+
+	model = clickAt(t, model, 30, 1)
+
+	assert.Equal(t, settings.SortBySession, model.sortBy)
+	assert.Equal(t, settings.SortOrderDesc, model.sortOrder)
+}
+
+func TestMouseClicksOutsideSortableHeaderAreIgnored(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tea.MouseMsg
+	}{
+		{"row click", tea.MouseMsg{X: 4, Y: 4, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}},
+		{"read column", tea.MouseMsg{X: 0, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}},
+		{"right button", tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonRight}},
+		{"release", tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newMouseTestModel(t)
+
+			updated, cmd := model.Update(tt.msg)
+			model = updated.(*Model)
+
+			assert.Nil(t, cmd)
+			assert.Equal(t, settings.SortByTimestamp, model.sortBy)
+			assert.Equal(t, settings.SortOrderDesc, model.sortOrder)
+		})
+	}
+}
+
+func TestHeaderClickIgnoredInCommandMode(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.uiState.SetCommandMode(true)
+
+	model = clickAt(t, model, 4, 1)
+
+	assert.Equal(t, settings.SortByTimestamp, model.sortBy)
+}
+
+func TestHeaderClickAccountsForCursorGutter(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.selection.CursorGlyph = ">"
+
+	model = clickAt(t, model, 4, 1)
+	assert.Equal(t, settings.SortByTimestamp, model.sortBy, "gutter shifts the gap before TYPE under x=4")
+
+	model = clickAt(t, model, 6, 1)
+	assert.Equal(t, settings.SortByLevel, model.sortBy)
+}
+
+func TestHeaderClickFollowsTabLabelLine(t *testing.T) {
+	model := newMouseTestModel(t)
+	model.uiState.SetTabLabelVisible(true)
+
+	model = clickAt(t, model, 4, 1)
+	assert.Equal(t, settings.SortByTimestamp, model.sortBy, "line 1 is the tab label")
+
+	model = clickAt(t, model, 4, 2)
+	assert.Equal(t, settings.SortByLevel, model.sortBy)
+}
@@ -1,5 +1,11 @@
 package state
 
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
 // handleMoveDown moves the cursor down by one position.
 func (m *Model) handleMoveDown() {
 	listLen := m.currentListLen()
@@ -38,6 +44,105 @@ func (m *Model) handleMoveBottom() {
 	m.uiState.EnsureCursorVisible(listLen)
 }
 
+// handleMoveToError moves the cursor to the next (direction 1) or previous
+// (direction -1) notification at error level or higher, wrapping around the list.
+func (m *Model) handleMoveToError(direction int) {
+	listLen := m.currentListLen()
+	if listLen == 0 {
+		return
+	}
+	cursor := m.uiState.GetCursor()
+	for step := 1; step <= listLen; step++ {
+		row := ((cursor+direction*step)%listLen + listLen) % listLen
+		notif, ok := m.notificationAtRow(row)
+		if !ok || !isErrorOrHigher(notif.Level) {
+			continue
+		}
+		m.uiState.SetCursor(row)
+		m.updateViewportContent()
+		m.uiState.EnsureCursorVisible(listLen)
+		return
+	}
+}
+
+func isErrorOrHigher(level domain.NotificationLevel) bool {
+	return level == domain.LevelError || level == domain.LevelCritical
+}
+
+// handleMoveToFirstUnread moves the cursor to the first unread notification in
+// the current view. In grouped view it expands the groups hiding it.
+func (m *Model) handleMoveToFirstUnread() tea.Cmd {
+	if m.isGroupedView() {
+		if m.revealFirstUnreadNode() {
+			return nil
+		}
+	} else if !m.isSummaryView() {
+		for i, notif := range m.filtered {
+			if notif.IsRead() {
+				continue
+			}
+			m.uiState.SetCursor(i)
+			m.updateViewportContent()
+			m.uiState.EnsureCursorVisible(len(m.filtered))
+			return nil
+		}
+	}
+	m.errorHandler.Info("No unread notifications in view")
+	return errorMsgAfter(errorClearDuration)
+}
+
+// revealFirstUnreadNode expands the groups above the first unread notification
+// of the tree, including depth-capped "+N more" rows, and selects it. It
+// reports whether an unread notification was found.
+func (m *Model) revealFirstUnreadNode() bool {
+	root := m.treeService.GetTreeRoot()
+	target := firstUnreadNode(root)
+	if target == nil {
+		return false
+	}
+	path := m.findNodePath(root, target)
+	for _, node := range path {
+		if m.isGroupNode(node) && !node.Expanded {
+			m.treeService.ExpandNode(node)
+			m.updateExpansionState(node, true)
+		}
+	}
+	// A summary row stands right below the group whose children it hides.
+	for _, node := range path {
+		visibleNodes := m.computeVisibleNodes()
+		for i := 0; i+1 < len(visibleNodes); i++ {
+			if visibleNodes[i] == node && visibleNodes[i+1].Kind == model.NodeKindMore {
+				m.treeService.ExpandNode(visibleNodes[i+1])
+				break
+			}
+		}
+	}
+	m.invalidateCache()
+	m.restoreCursor(m.getNodeIdentifier(target))
+	m.updateViewportContent()
+	return true
+}
+
+// firstUnreadNode returns the first unread notification node under node in
+// display order, or nil when every notification is read.
+func firstUnreadNode(node *model.TreeNode) *model.TreeNode {
+	if node == nil {
+		return nil
+	}
+	if node.Kind == model.NodeKindNotification {
+		if node.Notification != nil && !node.Notification.IsRead() {
+			return node
+		}
+		return nil
+	}
+	for _, child := range node.Children {
+		if found := firstUnreadNode(child); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
 // handleSearchMode enters or exits search mode.
 func (m *Model) handleSearchMode() {
 	m.uiState.SetSearchMode(true)
@@ -2,12 +2,14 @@ package state
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/search"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	"github.com/cristianoliveira/tmux-intray/internal/tui/controller"
 	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/render"
 	"github.com/cristianoliveira/tmux-intray/internal/tui/service"
 )
 
@@ -18,6 +20,27 @@ func (m *Model) SetLoadedSettings(loaded *settings.Settings) {
 	if loaded != nil {
 		m.unreadFirst = loaded.UnreadFirst
 		m.groupHeaderOptions = loaded.GroupHeader.Clone()
+		m.selection = loaded.Selection
+		m.hideEmptySearch = !loaded.SearchEmptyShowsAll
+		m.autoReadDwell = time.Duration(loaded.AutoReadDwellSeconds) * time.Second
+		m.ageDividers = loaded.AgeDividers
+		m.uiState.SetWrapNavigation(loaded.WrapNavigation)
+		m.activeCountWarning = loaded.ActiveCountWarning
+		m.confirmDismissAll = loaded.ConfirmDismissAll
+		m.autoRefresh = time.Duration(loaded.AutoRefreshSeconds) * time.Second
+		m.autoSave = time.Duration(loaded.AutoSaveSeconds) * time.Second
+		m.flashNew = loaded.FlashNewNotifications
+		m.confirmSaveOnQuit = loaded.ConfirmSaveOnQuit
+		m.ensureTreeService().SetWeekStart(settings.WeekStartDay(loaded.WeekStart))
+		m.ensureTreeService().SetGroupUnassigned(loaded.GroupUnassigned)
+		m.ensureTreeService().SetMaxDepth(loaded.MaxTreeDepth)
+		m.uiState.SetTabLabelVisible(loaded.ShowTabLabel)
+		m.confirmCrossSessionJump = loaded.ConfirmCrossSessionJump
+		m.searchAliases = loaded.SearchAliases
+		m.uiState.SetHeaderHidden(!loaded.ShowHeader)
+		m.uiState.SetFooterHidden(!loaded.ShowFooter)
+		m.sourceGutter = loaded.SourceGutter
+		m.searchEnterExits = loaded.SearchEnter == settings.SearchEnterExit
 		// Pass settings to notification service for configurable sorting
 		if notifSvc, ok := m.notificationService.(*service.DefaultNotificationService); ok {
 			notifSvc.SetSettings(loaded)
@@ -25,13 +48,36 @@ func (m *Model) SetLoadedSettings(loaded *settings.Settings) {
 	} else {
 		m.unreadFirst = true // Default to true
 		m.groupHeaderOptions = settings.DefaultGroupHeaderOptions()
+		m.selection = settings.SelectionOptions{}
+		m.hideEmptySearch = false
+		m.autoReadDwell = 0
+		m.ageDividers = false
+		m.uiState.SetWrapNavigation(false)
+		m.activeCountWarning = 0
+		m.confirmDismissAll = true
+		m.autoRefresh = 0
+		m.autoSave = 0
+		m.flashNew = false
+		m.confirmSaveOnQuit = false
+		m.ensureTreeService().SetWeekStart(time.Monday)
+		m.ensureTreeService().SetGroupUnassigned(true)
+		m.ensureTreeService().SetMaxDepth(0)
+		m.uiState.SetTabLabelVisible(false)
+		m.confirmCrossSessionJump = false
+		m.searchAliases = nil
+		m.uiState.SetHeaderHidden(false)
+		m.uiState.SetFooterHidden(false)
+		m.sourceGutter = false
+		m.searchEnterExits = false
 	}
+	m.uiState.SetBannerVisible(m.countWarningBanner() != "")
+	m.applyPeekOverrides()
 }
 
 // ToState converts the Model to a TUIState DTO for settings persistence.
 // Only persists user-configurable settings (columns, sort, filters, view mode).
 func (m *Model) ToState() settings.TUIState {
-	return m.ensureSettingsService().toState(m.uiState, m.columns, m.sortBy, m.sortOrder, m.unreadFirst, m.filters)
+	return m.ensureSettingsService().toState(m.uiState, m.columns, m.sortBy, m.sortOrder, m.unreadFirst, m.persistedFilters())
 }
 
 // FromState applies settings from TUIState to the Model.
@@ -63,6 +109,7 @@ func (m *Model) ensureTreeService() model.TreeService {
 	}
 
 	m.treeService = service.NewTreeService(groupBy)
+	m.treeService.SetPaneValidator(m.paneExists)
 	return m.treeService
 }
 
@@ -82,13 +129,13 @@ func (m *Model) ensureNotificationService() model.NotificationService {
 		// Get the search provider from runtime coordinator
 		searchProvider := search.NewTokenProvider(
 			search.WithCaseInsensitive(true),
+			search.WithCurrentUser(searchCurrentUser),
 		)
 		if m.runtimeCoordinator != nil {
 			searchProvider = search.NewTokenProvider(
 				search.WithCaseInsensitive(true),
-				search.WithSessionNames(m.runtimeCoordinator.GetSessionNames()),
-				search.WithWindowNames(m.runtimeCoordinator.GetWindowNames()),
-				search.WithPaneNames(m.runtimeCoordinator.GetPaneNames()),
+				search.WithCurrentUser(searchCurrentUser),
+				search.WithNameSource(m.runtimeCoordinator),
 			)
 		}
 		m.notificationService = service.NewNotificationService(searchProvider, m.runtimeCoordinator)
@@ -107,6 +154,9 @@ func (m *Model) ensureInteractionController() model.InteractionController {
 		syncer.SetRuntimeCoordinator(m.runtimeCoordinator)
 	}
 
+	if m.peek {
+		return peekController{m.interactionCtrl}
+	}
 	return m.interactionCtrl
 }
 
@@ -123,7 +173,7 @@ func (m *Model) applySearchFilter() {
 
 	notificationService.ApplyFiltersAndSearch(
 		m.uiState.GetActiveTab(),
-		m.uiState.GetSearchQuery(),
+		search.ExpandAliases(m.uiState.GetSearchQuery(), m.searchAliases),
 		m.filters.State,
 		m.filters.Level,
 		m.filters.Session,
@@ -154,12 +204,49 @@ func (m *Model) allNotifications() []domain.Notification {
 }
 
 func (m *Model) filteredNotifications() []domain.Notification {
+	if m.isEmptySearchHidden() {
+		return []domain.Notification{}
+	}
 	return m.ensureNotificationService().GetFilteredNotifications()
 }
 
+// isEmptySearchHidden reports whether search view mode should list nothing
+// because the query is empty and search_empty_shows_all is disabled.
+func (m *Model) isEmptySearchHidden() bool {
+	if !m.hideEmptySearch {
+		return false
+	}
+	return m.uiState.GetViewMode() == model.ViewModeSearch && m.uiState.GetSearchQuery() == ""
+}
+
 func (m *Model) syncNotificationMirrors() {
 	m.notifications = m.allNotifications()
 	m.filtered = m.filteredNotifications()
+	m.uiState.SetBannerVisible(m.countWarningBanner() != "")
+}
+
+// activeNotificationCount returns how many loaded notifications are active.
+func (m *Model) activeNotificationCount() int {
+	count := 0
+	for _, notif := range m.notifications {
+		if notif.State != domain.StateDismissed {
+			count++
+		}
+	}
+	return count
+}
+
+// countWarningBanner returns the cleanup banner when the active count reaches
+// the configured threshold, or an empty string otherwise.
+func (m *Model) countWarningBanner() string {
+	if m.activeCountWarning <= 0 {
+		return ""
+	}
+	count := m.activeNotificationCount()
+	if count < m.activeCountWarning {
+		return ""
+	}
+	return render.CountWarningBanner(count, m.uiState.GetWidth())
 }
 
 // ApplySearchFilter is the public version of applySearchFilter.
@@ -177,10 +264,10 @@ func (m *Model) loadNotifications(preserveCursor bool) error {
 		// Save current cursor state
 		savedCursorPos = m.uiState.GetCursor()
 		cursor := m.uiState.GetCursor()
-		visibleNodes := m.treeService.GetVisibleNodes()
+		visibleNodes := m.computeVisibleNodes()
 		if m.isGroupedView() && cursor < len(visibleNodes) {
 			savedNodeID = m.getNodeIdentifier(visibleNodes[cursor])
-		} else if !m.isGroupedView() && cursor < len(m.filtered) {
+		} else if !m.isGroupedView() && !m.isSummaryView() && cursor < len(m.filtered) {
 			savedNodeID = fmt.Sprintf("notif:%d", m.filtered[cursor].ID)
 		}
 	}
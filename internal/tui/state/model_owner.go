@@ -0,0 +1,79 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// currentUser returns the name the TUI claims notifications as. Tests replace it.
+var currentUser = lookupCurrentUser
+
+// searchCurrentUser resolves "owner:me" in search. It defers to currentUser
+// on each call so search and claiming always agree on who "me" is.
+func searchCurrentUser() (string, error) {
+	return currentUser()
+}
+
+// lookupCurrentUser prefers $USER and falls back to the account name.
+func lookupCurrentUser() (string, error) {
+	if name := os.Getenv("USER"); name != "" {
+		return name, nil
+	}
+	account, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+	if account.Username == "" {
+		return "", errors.New("no user name")
+	}
+	return account.Username, nil
+}
+
+// toggleSelectedClaim assigns the selected notification to the current user,
+// or unassigns it when the current user already owns it. Claiming a
+// notification owned by someone else takes it over.
+func (m *Model) toggleSelectedClaim() tea.Cmd {
+	if m.currentListLen() == 0 {
+		return nil
+	}
+
+	selected, ok := m.selectedNotification()
+	if !ok {
+		return nil
+	}
+	selectedID := selected.ID
+
+	me, err := currentUser()
+	if err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to claim notification: cannot determine current user: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	owner := me
+	message := fmt.Sprintf("Claimed notification %d", selectedID)
+	if selected.Owner == me {
+		owner = ""
+		message = fmt.Sprintf("Unassigned notification %d", selectedID)
+	}
+
+	id := strconv.Itoa(selectedID)
+	if err := m.ensureInteractionController().AssignNotification(id, owner); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to claim notification: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.restoreCursor(fmt.Sprintf("notif:%d", selectedID))
+	m.updateViewportContent()
+	m.errorHandler.Success(message)
+	return errorMsgAfter(errorClearDuration)
+}
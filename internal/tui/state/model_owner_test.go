@@ -0,0 +1,122 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stubCurrentUser(t *testing.T, name string) {
+	t.Helper()
+	original := currentUser
+	currentUser = func() (string, error) { return name, nil }
+	t.Cleanup(func() { currentUser = original })
+}
+
+func TestClaimKeyAssignsAndUnassignsCurrentUser(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+	stubCurrentUser(t, "alice")
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id, err := storage.AddNotification("deploy failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.uiState.SetSearchMode(false)
+	model.switchActiveTab(settings.TabAll)
+	model.resetCursor()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	model = updated.(*Model)
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.Equal(t, "alice", selected.Owner)
+	assert.Contains(t, model.View(), "[alice] ")
+
+	require.NoError(t, storage.DismissNotification(id))
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	stored, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", stored.Owner, "dismiss preserves the owner")
+	require.NoError(t, storage.UndismissNotification(id))
+	require.NoError(t, model.loadNotifications(true))
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	model = updated.(*Model)
+	selected, ok = model.selectedNotification()
+	require.True(t, ok)
+	assert.Empty(t, selected.Owner)
+}
+
+func TestClaimKeyTakesOverOtherOwner(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+	stubCurrentUser(t, "alice")
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id, err := storage.AddNotification("deploy failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NoError(t, storage.AssignNotification(id, "bob"))
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.uiState.SetSearchMode(false)
+	model.switchActiveTab(settings.TabAll)
+	model.resetCursor()
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	model = updated.(*Model)
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.Equal(t, "alice", selected.Owner)
+}
+
+func TestSearchFiltersByOwner(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "deploy failed", Owner: "alice"},
+		{ID: 2, Message: "build passed", Owner: "bob"},
+		{ID: 3, Message: "disk full"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+
+	model.uiState.SetSearchQuery("owner:alice")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 1, model.filtered[0].ID)
+
+	model.uiState.SetSearchQuery("owner:")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 3, model.filtered[0].ID)
+}
+
+func TestSearchOwnerMeMatchesCurrentUser(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+	stubCurrentUser(t, "bob")
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	_, err := storage.AddNotification("deploy failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	id, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	require.NoError(t, storage.AssignNotification(id, "bob"))
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+
+	model.uiState.SetSearchQuery("owner:me failed")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, "bob", model.filtered[0].Owner)
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"errors"
+
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+)
+
+// errPeekReadOnly is returned for any notification change attempted in peek mode.
+var errPeekReadOnly = errors.New("peek mode is read-only")
+
+// peekController wraps an interaction controller so loads, jumps and copies
+// still work but every notification change is refused.
+type peekController struct {
+	model.InteractionController
+}
+
+func (peekController) DismissNotification(id string) error                { return errPeekReadOnly }
+func (peekController) DismissByFilter(session, window, pane string) error { return errPeekReadOnly }
+func (peekController) DismissNotifications(ids []string) error            { return errPeekReadOnly }
+func (peekController) UndismissNotification(id string) error              { return errPeekReadOnly }
+func (peekController) MarkNotificationRead(id string) error               { return errPeekReadOnly }
+func (peekController) MarkNotificationUnread(id string) error             { return errPeekReadOnly }
+func (peekController) MarkNotificationsRead(ids []string) error           { return errPeekReadOnly }
+func (peekController) MarkNotificationsUnread(ids []string) error         { return errPeekReadOnly }
+func (peekController) SetImportant(id string, important bool) error       { return errPeekReadOnly }
+func (peekController) AssignNotification(id, owner string) error          { return errPeekReadOnly }
+
+// SetPeek turns peek mode on or off. A peek session can navigate and search
+// but never changes notifications or writes settings, so auto-read after
+// dwell, auto-save and the save-on-quit prompt are disabled.
+func (m *Model) SetPeek(peek bool) {
+	m.peek = peek
+	m.applyPeekOverrides()
+}
+
+// applyPeekOverrides disables the settings that would write on their own.
+// It runs again after settings load so they cannot turn them back on.
+func (m *Model) applyPeekOverrides() {
+	if !m.peek {
+		return
+	}
+	m.autoReadDwell = 0
+	m.dwell = dwellState{}
+	m.autoSave = 0
+	m.confirmSaveOnQuit = false
+}
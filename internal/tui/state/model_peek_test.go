@@ -0,0 +1,100 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPeekTestModel(t *testing.T, configDir string, loaded *settings.Settings) (*Model, string) {
+	t.Helper()
+	setupConfig(t, configDir)
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+	stubCurrentUser(t, "alice")
+
+	id, err := storage.AddNotification("deploy failed", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.SetPeek(true)
+	model.SetLoadedSettings(loaded)
+	require.NoError(t, model.FromState(settings.FromSettings(loaded)))
+	model.uiState.SetSearchMode(false)
+	model.switchActiveTab(settings.TabAll)
+	model.resetCursor()
+	return model, id
+}
+
+func TestPeekLeavesStorageUntouched(t *testing.T) {
+	model, id := newPeekTestModel(t, t.TempDir(), settings.DefaultSettings())
+
+	before, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+
+	for _, key := range []rune{'R', '*', 'C', 'u', 'd'} {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		model = updated.(*Model)
+	}
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	model.markJumpedRead(selected.ID)
+
+	after, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Contains(t, msg.Text, "read-only")
+}
+
+func TestPeekDisablesAutoReadDwell(t *testing.T) {
+	loaded := settings.DefaultSettings()
+	loaded.AutoReadDwellSeconds = 1
+	model, id := newPeekTestModel(t, t.TempDir(), loaded)
+
+	before, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+
+	assert.Nil(t, model.scheduleDwellTick(), "peek schedules no dwell ticks")
+	start := time.Now()
+	model.now = func() time.Time { return start }
+	model.handleDwellTick()
+	model.now = func() time.Time { return start.Add(time.Minute) }
+	model.handleDwellTick()
+
+	after, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+}
+
+func TestPeekNeverWritesSettings(t *testing.T) {
+	configDir := t.TempDir()
+	loaded := settings.DefaultSettings()
+	loaded.AutoSaveSeconds = 30
+	loaded.ConfirmSaveOnQuit = true
+	model, _ := newPeekTestModel(t, configDir, loaded)
+
+	assert.Nil(t, model.scheduleAutoSaveTick(), "peek schedules no auto-save")
+	model.sortByColumn(settings.SortByLevel)
+	model.uiState.SetActiveTab(settings.TabRecents)
+	model.Update(autoSaveTickMsg{})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	require.NotNil(t, cmd)
+	assert.False(t, model.uiState.IsConfirmationMode(), "peek does not ask to save on quit")
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	require.NotNil(t, cmd)
+
+	_, err := os.Stat(filepath.Join(configDir, "tui.toml"))
+	assert.True(t, os.IsNotExist(err), "peek writes no settings")
+}
@@ -0,0 +1,103 @@
+package state
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newNotificationFlashDuration is how long the header stays highlighted after
+// a refresh brings in new notifications.
+const newNotificationFlashDuration = 1500 * time.Millisecond
+
+// refreshTickMsg triggers a reload of notifications from storage.
+type refreshTickMsg struct{}
+
+// flashClearMsg ends the header flash with the given sequence number. Older
+// clears are ignored so a newer flash keeps its full duration.
+type flashClearMsg struct {
+	seq int
+}
+
+// flashState tracks the header highlight shown for new notifications.
+type flashState struct {
+	active bool
+	seq    int
+}
+
+// scheduleRefreshTick returns the next refresh tick, or nil when auto-refresh is disabled.
+func (m *Model) scheduleRefreshTick() tea.Cmd {
+	if m.autoRefresh <= 0 {
+		return nil
+	}
+	return tea.Tick(m.autoRefresh, func(time.Time) tea.Msg {
+		return refreshTickMsg{}
+	})
+}
+
+// handleRefreshTick wakes notifications whose snooze session is now attached,
+// reloads notifications and, when flashing is enabled, highlights the header
+// if the reload brought in notifications newer than any seen before. Reloads
+// are skipped while a command or confirmation is being typed so the list does
+// not shift under the user. A failed snooze sweep is reported but the reload
+// still runs.
+func (m *Model) handleRefreshTick() tea.Cmd {
+	if m.autoRefresh <= 0 {
+		return nil
+	}
+	if m.uiState.IsCommandMode() || m.uiState.IsConfirmationMode() {
+		return m.scheduleRefreshTick()
+	}
+
+	var cmds []tea.Cmd
+	if m.wakeSnoozed != nil {
+		if _, err := m.wakeSnoozed(); err != nil {
+			m.errorHandler.Warning(fmt.Sprintf("Failed to wake snoozed notifications: %v", err))
+			cmds = append(cmds, errorMsgAfter(errorClearDuration))
+		}
+	}
+
+	newest := m.newestNotificationID()
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Warning(fmt.Sprintf("Failed to refresh notifications: %v", err))
+		return tea.Batch(errorMsgAfter(errorClearDuration), m.scheduleRefreshTick())
+	}
+	m.updateViewportContent()
+
+	if m.flashNew && m.newestNotificationID() > newest {
+		cmds = append(cmds, m.startFlash())
+	}
+	if len(cmds) == 0 {
+		return m.scheduleRefreshTick()
+	}
+	return tea.Batch(append(cmds, m.scheduleRefreshTick())...)
+}
+
+// startFlash highlights the header and schedules its clear.
+func (m *Model) startFlash() tea.Cmd {
+	m.flash.seq++
+	m.flash.active = true
+	seq := m.flash.seq
+	return tea.Tick(newNotificationFlashDuration, func(time.Time) tea.Msg {
+		return flashClearMsg{seq: seq}
+	})
+}
+
+// handleFlashClear ends the header flash unless a newer one has started.
+func (m *Model) handleFlashClear(msg flashClearMsg) {
+	if msg.seq == m.flash.seq {
+		m.flash.active = false
+	}
+}
+
+// newestNotificationID returns the highest loaded notification ID, or zero.
+func (m *Model) newestNotificationID() int {
+	newest := 0
+	for _, notif := range m.notifications {
+		if notif.ID > newest {
+			newest = notif.ID
+		}
+	}
+	return newest
+}
@@ -0,0 +1,160 @@
+package state
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRefreshTestModel(t *testing.T, flash bool) *Model {
+	t.Helper()
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	_, err := storage.AddNotification("first", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	loaded := settings.DefaultSettings()
+	loaded.AutoRefreshSeconds = 5
+	loaded.FlashNewNotifications = flash
+	model.SetLoadedSettings(loaded)
+	model.switchActiveTab(settings.TabAll)
+	require.Len(t, model.filtered, 1)
+	return model
+}
+
+func TestRefreshWithNewNotificationFlashesHeaderUntilCleared(t *testing.T) {
+	model := newRefreshTestModel(t, true)
+	require.NotNil(t, model.Init(), "auto-refresh schedules a tick")
+
+	_, err := storage.AddNotification("second", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+
+	_, cmd := model.Update(refreshTickMsg{})
+	require.NotNil(t, cmd)
+	assert.Len(t, model.filtered, 2, "refresh loads the new notification")
+	assert.True(t, model.flash.active)
+	firstSeq := model.flash.seq
+
+	// A second arrival restarts the flash; the first clear must not end it.
+	_, err = storage.AddNotification("third", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, _ = model.Update(refreshTickMsg{})
+	_, _ = model.Update(flashClearMsg{seq: firstSeq})
+	assert.True(t, model.flash.active, "stale clear is ignored")
+
+	_, _ = model.Update(flashClearMsg{seq: model.flash.seq})
+	assert.False(t, model.flash.active)
+}
+
+func TestRefreshWithoutNewNotificationsDoesNotFlash(t *testing.T) {
+	model := newRefreshTestModel(t, true)
+
+	_, cmd := model.Update(refreshTickMsg{})
+	require.NotNil(t, cmd, "the next refresh is still scheduled")
+	assert.False(t, model.flash.active)
+}
+
+func TestRefreshFlashDisabledBySetting(t *testing.T) {
+	model := newRefreshTestModel(t, false)
+
+	_, err := storage.AddNotification("second", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, _ = model.Update(refreshTickMsg{})
+	assert.Len(t, model.filtered, 2)
+	assert.False(t, model.flash.active)
+}
+
+func TestRefreshDisabledByDefault(t *testing.T) {
+	model := newRefreshTestModel(t, true)
+	model.SetLoadedSettings(settings.DefaultSettings())
+
+	assert.Nil(t, model.Init())
+	_, cmd := model.Update(refreshTickMsg{})
+	assert.Nil(t, cmd)
+}
+
+func newAutoSaveTestModel(t *testing.T, configDir string) *Model {
+	t.Helper()
+	setupConfig(t, configDir)
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	loaded := settings.DefaultSettings()
+	loaded.AutoSaveSeconds = 30
+	model.SetLoadedSettings(loaded)
+	require.NoError(t, model.FromState(settings.FromSettings(loaded)))
+	return model
+}
+
+func TestAutoSaveTickSkipsUnchangedView(t *testing.T) {
+	configDir := t.TempDir()
+	model := newAutoSaveTestModel(t, configDir)
+	require.NotNil(t, model.Init(), "auto-save schedules a tick")
+
+	_, cmd := model.Update(autoSaveTickMsg{})
+	require.NotNil(t, cmd, "the next auto-save is still scheduled")
+	_, err := os.Stat(filepath.Join(configDir, "tui.toml"))
+	assert.True(t, os.IsNotExist(err), "an unchanged view is not written")
+}
+
+func TestAutoSaveTickSavesChangedView(t *testing.T) {
+	model := newAutoSaveTestModel(t, t.TempDir())
+
+	model.uiState.SetActiveTab(settings.TabAll)
+	_, cmd := model.Update(autoSaveTickMsg{})
+	require.NotNil(t, cmd)
+
+	loaded, err := settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.TabAll, loaded.ActiveTab)
+	assert.Equal(t, 30, loaded.AutoSaveSeconds)
+}
+
+func TestAutoSaveDisabledByDefault(t *testing.T) {
+	model := newAutoSaveTestModel(t, t.TempDir())
+	model.SetLoadedSettings(settings.DefaultSettings())
+
+	_, cmd := model.Update(autoSaveTickMsg{})
+	assert.Nil(t, cmd)
+}
+
+func TestRefreshWakesSnoozedNotificationsBeforeReloading(t *testing.T) {
+	model := newRefreshTestModel(t, false)
+	sweeps := 0
+	model.wakeSnoozed = func() (int, error) {
+		sweeps++
+		_, err := storage.AddNotification("woken", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+		return 1, err
+	}
+
+	_, cmd := model.Update(refreshTickMsg{})
+	require.NotNil(t, cmd)
+	assert.Equal(t, 1, sweeps)
+	assert.Len(t, model.filtered, 2, "the reload sees what the sweep resurfaced")
+}
+
+func TestRefreshReportsFailedSnoozeSweepAndStillReloads(t *testing.T) {
+	model := newRefreshTestModel(t, false)
+	model.wakeSnoozed = func() (int, error) {
+		return 0, errors.New("tmux unavailable")
+	}
+	_, err := storage.AddNotification("second", time.Now().UTC().Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+
+	_, cmd := model.Update(refreshTickMsg{})
+	require.NotNil(t, cmd)
+	assert.Contains(t, model.statusMessage, "Failed to wake snoozed notifications: tmux unavailable")
+	assert.Len(t, model.filtered, 2)
+}
@@ -57,13 +57,35 @@ func (m *Model) View() string {
 		return m.renderConfirmationDialog()
 	}
 
+	if m.detail != nil {
+		return m.renderDetailView()
+	}
+
+	chromeHidden := m.uiState.IsChromeHidden()
+
 	// Header
-	s.WriteString(render.Tabs(m.uiState.GetActiveTab(), m.uiState.GetWidth()))
-	s.WriteString("\n")
-	s.WriteString(render.Header(m.uiState.GetWidth()))
+	if !chromeHidden && !m.uiState.IsHeaderHidden() {
+		s.WriteString(render.Tabs(m.uiState.GetActiveTab(), m.uiState.GetWidth()))
+		s.WriteString("\n")
+		if banner := m.countWarningBanner(); banner != "" {
+			s.WriteString(banner)
+			s.WriteString("\n")
+		}
+		if m.uiState.IsTabLabelVisible() {
+			s.WriteString(render.TabLabel(m.uiState.GetActiveTab(), len(m.filtered), m.uiState.GetWidth()))
+			s.WriteString("\n")
+		}
+		s.WriteString(render.SourceGutter(m.sourceGutter, ""))
+		s.WriteString(render.Gutter(false, m.selection))
+		if m.flash.active {
+			s.WriteString(render.FlashHeader(m.uiState.GetWidth()))
+		} else {
+			s.WriteString(render.Header(m.uiState.GetWidth()))
+		}
+		s.WriteString("\n")
+	}
 
 	// Viewport with table rows
-	s.WriteString("\n")
 	s.WriteString(m.uiState.GetViewport().View())
 
 	// Status message above footer
@@ -89,23 +111,45 @@ func (m *Model) View() string {
 		s.WriteString(style.Render(prefix + m.statusMessage))
 	}
 
+	if chromeHidden || m.uiState.IsFooterHidden() {
+		return s.String()
+	}
+
 	// Footer
 	s.WriteString("\n")
 	s.WriteString(render.Footer(render.FooterState{
-		SearchMode:   m.uiState.IsSearchMode(),
-		SearchQuery:  m.uiState.GetSearchQuery(),
-		Grouped:      m.isGroupedView(),
-		ViewMode:     string(m.uiState.GetViewMode()),
-		ActiveTab:    m.uiState.GetActiveTab(),
-		Width:        m.uiState.GetWidth(),
-		ErrorMessage: m.statusMessage,
-		ReadFilter:   m.filters.Read,
-		ShowHelp:     m.uiState.ShowHelp(),
+		SearchMode:    m.uiState.IsSearchMode(),
+		SearchQuery:   m.uiState.GetSearchQuery(),
+		SearchMatches: len(m.filtered),
+		SearchTotal:   len(m.notifications),
+		CommandMode:   m.uiState.IsCommandMode(),
+		CommandQuery:  m.uiState.GetCommandQuery(),
+		Grouped:       m.isGroupedView(),
+		ViewMode:      string(m.uiState.GetViewMode()),
+		ActiveTab:     m.uiState.GetActiveTab(),
+		Width:         m.uiState.GetWidth(),
+		ErrorMessage:  m.statusMessage,
+		ReadFilter:    m.filters.Read,
+		ShowHelp:      m.uiState.ShowHelp(),
+		GroupCounts:   m.footerGroupCounts(),
 	}))
 
 	return s.String()
 }
 
+// footerGroupCounts converts the tree's group counts for the footer summary.
+func (m *Model) footerGroupCounts() []render.GroupCount {
+	if !m.isGroupedView() {
+		return nil
+	}
+	counts := m.ensureTreeService().GroupCounts()
+	footer := make([]render.GroupCount, 0, len(counts))
+	for _, count := range counts {
+		footer = append(footer, render.GroupCount{Kind: string(count.Kind), Count: count.Count})
+	}
+	return footer
+}
+
 // renderConfirmationDialog renders the confirmation dialog.
 func (m *Model) renderConfirmationDialog() string {
 	action := m.uiState.GetPendingAction()
@@ -178,6 +222,7 @@ func (m *Model) updateViewportContent() {
 	var content strings.Builder
 	width := m.uiState.GetWidth()
 	cursor := m.uiState.GetCursor()
+	m.uiState.SetCursorLineOffset(0)
 
 	if m.isGroupedView() {
 		m.renderGroupedView(&content, width, cursor)
@@ -185,13 +230,19 @@ func (m *Model) updateViewportContent() {
 		return
 	}
 
+	if m.isSummaryView() {
+		m.renderSummaryView(&content, width, cursor)
+		(*m.uiState.GetViewport()).SetContent(content.String())
+		return
+	}
+
 	m.renderFlatView(&content, width, cursor)
 	(*m.uiState.GetViewport()).SetContent(content.String())
 }
 
 // renderGroupedView renders the grouped notification tree view.
 func (m *Model) renderGroupedView(content *strings.Builder, width, cursor int) {
-	visibleNodes := m.treeService.GetVisibleNodes()
+	visibleNodes := m.computeVisibleNodes()
 	if len(visibleNodes) == 0 {
 		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("No notifications found"))
 		return
@@ -238,6 +289,7 @@ func (m *Model) renderGroupNodeRow(content *strings.Builder, node *model.TreeNod
 			Expanded:    node.Expanded,
 			Count:       node.Count,
 			UnreadCount: node.UnreadCount,
+			Stale:       node.Stale,
 		},
 		Selected:          rowIndex == cursor,
 		Level:             m.treeService.GetTreeLevel(node),
@@ -248,9 +300,22 @@ func (m *Model) renderGroupNodeRow(content *strings.Builder, node *model.TreeNod
 		LevelCounts:       node.LevelCounts,
 		Sources:           sources,
 		Options:           options,
+		Selection:         m.selection,
+		SourceGutter:      m.sourceGutter,
 	}))
 }
 
+// paneExists reports whether a pane is still open according to the runtime
+// coordinator's cached pane names. While no pane names are loaded, such as
+// outside tmux, every pane counts as open so nothing is flagged stale.
+func (m *Model) paneExists(sessionID, windowID, paneID string) bool {
+	if m.runtimeCoordinator == nil || len(m.runtimeCoordinator.GetPaneNames()) == 0 {
+		return true
+	}
+	exists, err := m.runtimeCoordinator.ValidatePaneExists(sessionID, windowID, paneID)
+	return err == nil && exists
+}
+
 // renderNotificationRow renders a single notification row.
 func (m *Model) renderNotificationRow(content *strings.Builder, notif domain.Notification, rowIndex, cursor, width int, now time.Time) {
 	notif.Pane = m.getPaneName(notif.Pane)
@@ -260,6 +325,8 @@ func (m *Model) renderNotificationRow(content *strings.Builder, notif domain.Not
 		Width:        width,
 		Selected:     rowIndex == cursor,
 		Now:          now,
+		Selection:    m.selection,
+		SourceGutter: m.sourceGutter,
 	}))
 }
 
@@ -336,21 +403,42 @@ func (m *Model) renderFlatView(content *strings.Builder, width, cursor int) {
 		return
 	}
 
-	now := time.Now()
+	now := m.clock()
+	dividers := m.showsAgeDividers()
+	lastBucket := ""
+	lineCount := 0
+	dividersAboveCursor := 0
 	for i, notif := range filtered {
 		notifCopy := notif
 		notifCopy.Pane = m.getPaneName(notifCopy.Pane)
-		if i > 0 {
+		if dividers {
+			if bucket := ageBucket(notif.Timestamp, now); bucket != lastBucket {
+				lastBucket = bucket
+				if lineCount > 0 {
+					content.WriteString("\n")
+				}
+				content.WriteString(renderAgeDivider(bucket, width))
+				lineCount++
+				if i <= cursor {
+					dividersAboveCursor++
+				}
+			}
+		}
+		if lineCount > 0 {
 			content.WriteString("\n")
 		}
+		lineCount++
 		content.WriteString(render.Row(render.RowState{
 			Notification: notifCopy,
 			SessionName:  m.getSessionName(notifCopy.Session),
 			Width:        width,
 			Selected:     i == cursor,
 			Now:          now,
+			Selection:    m.selection,
+			SourceGutter: m.sourceGutter,
 		}))
 	}
+	m.uiState.SetCursorLineOffset(dividersAboveCursor)
 }
 
 // ensureCursorVisible ensures the cursor is visible in the viewport.
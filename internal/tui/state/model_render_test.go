@@ -1,8 +1,12 @@
 package state
 
 import (
+	"slices"
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/cristianoliveira/tmux-intray/internal/domain"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	tuimodel "github.com/cristianoliveira/tmux-intray/internal/tui/model"
@@ -58,3 +62,140 @@ func TestViewRendersTabControlsAndActiveIndicator(t *testing.T) {
 	assert.Contains(t, view, "Recents")
 	assert.Contains(t, view, "[All]")
 }
+
+func TestSourceGutterSettingDrawsBarAndKeepsHeaderAligned(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Session: "$1", Message: "First", State: domain.StateActive}})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 20})
+	assert.NotContains(t, model.View(), "▌")
+
+	loaded := settings.DefaultSettings()
+	loaded.SourceGutter = true
+	model.SetLoadedSettings(loaded)
+	model.applySearchFilter()
+
+	lines := strings.Split(model.View(), "\n")
+	header := slices.IndexFunc(lines, func(line string) bool { return strings.Contains(line, "MESSAGE") })
+	require.GreaterOrEqual(t, header, 0)
+	require.Greater(t, len(lines), header+1)
+	assert.True(t, strings.HasPrefix(lines[header], " RD"), "the header gets a blank gutter column")
+	assert.True(t, strings.HasPrefix(lines[header+1], "▌"))
+	column := func(line, text string) int { return lipgloss.Width(line[:strings.Index(line, text)]) }
+	assert.Equal(t, column(lines[header], "MESSAGE"), column(lines[header+1], "First"))
+}
+
+func TestFooterGroupCountsFollowFilteredTree(t *testing.T) {
+	setupConfig(t, t.TempDir())
+	m := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "build failed"},
+		{ID: 2, Session: "$1", Window: "@2", Pane: "%2", Message: "build passed"},
+		{ID: 3, Session: "$2", Window: "@3", Pane: "%3", Message: "deploy done"},
+	})
+	m.uiState.SetWidth(200)
+	m.uiState.SetActiveTab(settings.TabAll)
+	m.uiState.SetViewMode(settings.ViewModeGrouped)
+	m.uiState.SetGroupBy(settings.GroupByWindow)
+	m.applySearchFilter()
+
+	assert.Contains(t, m.View(), "2 sessions · 3 windows · 3 notifications")
+
+	m.uiState.SetSearchQuery("build")
+	m.applySearchFilter()
+	assert.Contains(t, m.View(), "1 session · 2 windows · 2 notifications")
+}
+
+func TestFooterSearchMatchCountFollowsQuery(t *testing.T) {
+	setupConfig(t, t.TempDir())
+	m := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "build failed"},
+		{ID: 2, Message: "build passed"},
+		{ID: 3, Message: "deploy done"},
+	})
+	m.uiState.SetWidth(200)
+	m.uiState.SetActiveTab(settings.TabAll)
+	m.uiState.SetSearchMode(true)
+	m.applySearchFilter()
+	assert.NotContains(t, m.View(), "match")
+
+	m.uiState.SetSearchQuery("b")
+	m.applySearchFilter()
+	assert.Contains(t, m.View(), "2/3 match")
+
+	m.uiState.SetSearchQuery("build f")
+	m.applySearchFilter()
+	assert.Contains(t, m.View(), "1/3 match")
+
+	m.uiState.SetSearchQuery("")
+	m.applySearchFilter()
+	assert.NotContains(t, m.View(), "/3 match")
+}
+
+func TestGroupedViewRendersMoreRowBeyondMaxTreeDepth(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "deploy finished"},
+	})
+	model.ensureTreeService().SetMaxDepth(2)
+	model.uiState.SetWidth(120)
+	model.uiState.GetViewport().Width = 120
+	model.uiState.GetViewport().Height = 20
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByPane)
+	disableModelGroupOptions(model)
+	model.applySearchFilter()
+	model.updateViewportContent()
+
+	content := model.uiState.GetViewport().View()
+	assert.Contains(t, content, "+1 more")
+	assert.NotContains(t, content, "deploy finished", "levels past the cap are hidden")
+
+	visible := model.treeService.GetVisibleNodes()
+	require.Len(t, visible, 3)
+	require.Equal(t, tuimodel.NodeKindMore, visible[2].Kind)
+	model.uiState.SetCursor(2)
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+
+	content = model.uiState.GetViewport().View()
+	assert.NotContains(t, content, "+1 more")
+	assert.Contains(t, content, "deploy finished", "expanding the summary reveals the hidden levels")
+}
+
+func TestGroupedViewMarksSessionsWithClosedPanesStale(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "deploy finished"},
+		{ID: 2, Session: "$2", Window: "@2", Pane: "%9", Message: "build failed"},
+	})
+	model.treeService.SetPaneValidator(model.paneExists)
+	model.runtimeCoordinator.SetSessionNames(map[string]string{"$1": "api", "$2": "web"})
+	model.runtimeCoordinator.SetPaneNames(map[string]string{"%1": "shell"})
+	model.uiState.SetWidth(120)
+	model.uiState.GetViewport().Width = 120
+	model.uiState.GetViewport().Height = 20
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupBySession)
+	disableModelGroupOptions(model)
+	model.applySearchFilter()
+	model.updateViewportContent()
+
+	lines := strings.Split(model.uiState.GetViewport().View(), "\n")
+	var apiHeader, webHeader string
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line, "api ("):
+			apiHeader = line
+		case strings.Contains(line, "web ("):
+			webHeader = line
+		}
+	}
+	require.NotEmpty(t, apiHeader)
+	assert.NotContains(t, apiHeader, "⚠ stale")
+	assert.Contains(t, webHeader, "⚠ stale")
+
+	model.runtimeCoordinator.SetPaneNames(map[string]string{})
+	model.applySearchFilter()
+	model.updateViewportContent()
+	assert.NotContains(t, model.uiState.GetViewport().View(), "⚠ stale", "nothing is stale while pane names are unknown")
+}
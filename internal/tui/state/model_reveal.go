@@ -0,0 +1,50 @@
+package state
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+)
+
+// openPath opens path with the system file opener. Tests replace it.
+var openPath = openWithSystemOpener
+
+// openWithSystemOpener starts "open" on macOS and "xdg-open" elsewhere without
+// waiting for it. It fails when the opener is not installed.
+func openWithSystemOpener(path string) error {
+	name := "xdg-open"
+	if runtime.GOOS == "darwin" {
+		name = "open"
+	}
+	bin, err := exec.LookPath(name)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(bin, path)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+// handleReveal shows the resolved state directory and opens it with the
+// system opener. The path is reported even when nothing can open it.
+func (m *Model) handleReveal() tea.Cmd {
+	dir := storage.GetStateDir()
+	if dir == "" {
+		m.errorHandler.Error("reveal: state directory is not configured")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := openPath(dir); err != nil {
+		m.errorHandler.Info(fmt.Sprintf("State directory: %s", dir))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	m.errorHandler.Success(fmt.Sprintf("Opened state directory: %s", dir))
+	return errorMsgAfter(errorClearDuration)
+}
@@ -0,0 +1,44 @@
+package state
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	uimodel "github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchEnterJumps(t *testing.T) {
+	tests := []struct {
+		name           string
+		searchEnter    string
+		wantSearchMode bool
+		wantQuery      string
+	}{
+		{name: "stay", searchEnter: settings.SearchEnterStay, wantSearchMode: true, wantQuery: "build"},
+		{name: "exit", searchEnter: settings.SearchEnterExit, wantSearchMode: false, wantQuery: ""},
+		{name: "unset", searchEnter: "", wantSearchMode: true, wantQuery: "build"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jumps := 0
+			model := newJumpConfirmTestModel(t, "$2", &jumps)
+			loaded := settings.DefaultSettings()
+			loaded.SearchEnter = tt.searchEnter
+			model.SetLoadedSettings(loaded)
+			model.uiState.SetViewMode(uimodel.ViewModeSearch)
+			model.uiState.SetSearchMode(true)
+			model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("build")})
+			require.Len(t, model.filtered, 1)
+
+			_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+			assert.NotNil(t, cmd, "jump returns a command")
+			assert.Equal(t, 1, jumps, "the selected notification is jumped to")
+			assert.Equal(t, tt.wantSearchMode, model.uiState.IsSearchMode())
+			assert.Equal(t, tt.wantQuery, model.uiState.GetSearchQuery())
+		})
+	}
+}
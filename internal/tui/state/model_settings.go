@@ -3,6 +3,9 @@ package state
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/cristianoliveira/tmux-intray/internal/colors"
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
@@ -10,7 +13,11 @@ import (
 )
 
 // saveSettings extracts current settings from model and saves to disk.
+// In peek mode nothing is written.
 func (m *Model) saveSettings() error {
+	if m.peek {
+		return nil
+	}
 	// Extract current settings state
 	state := m.ToState()
 	colors.Debug("Saving settings from TUI state")
@@ -21,6 +28,42 @@ func (m *Model) saveSettings() error {
 	return nil
 }
 
+// autoSaveSettings persists a view change as it happens. With
+// confirm_save_on_quit, changes wait for the prompt on quit instead.
+func (m *Model) autoSaveSettings() {
+	if m.confirmSaveOnQuit {
+		return
+	}
+	if err := m.saveSettings(); err != nil {
+		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
+	}
+}
+
+// autoSaveTickMsg triggers a periodic save of view changes.
+type autoSaveTickMsg struct{}
+
+// scheduleAutoSaveTick returns the next auto-save tick, or nil when auto-save is disabled.
+func (m *Model) scheduleAutoSaveTick() tea.Cmd {
+	if m.autoSave <= 0 {
+		return nil
+	}
+	return tea.Tick(m.autoSave, func(time.Time) tea.Msg {
+		return autoSaveTickMsg{}
+	})
+}
+
+// handleAutoSaveTick saves the view when it differs from the last saved
+// settings, then schedules the next tick. An unchanged view is not written.
+func (m *Model) handleAutoSaveTick() tea.Cmd {
+	if m.autoSave <= 0 {
+		return nil
+	}
+	if m.ensureSettingsService().hasChanges(m.ToState()) {
+		m.autoSaveSettings()
+	}
+	return m.scheduleAutoSaveTick()
+}
+
 // SaveSettings is the public version of saveSettings.
 func (m *Model) SaveSettings() error {
 	return m.saveSettings()
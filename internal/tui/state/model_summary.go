@@ -0,0 +1,130 @@
+package state
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
+	"github.com/cristianoliveira/tmux-intray/internal/tui/render"
+)
+
+// summaryRow aggregates the filtered notifications of a single session.
+type summaryRow struct {
+	session     string
+	count       int
+	unreadCount int
+	levelCounts map[string]int
+	earliest    string
+	latest      string
+}
+
+func (m *Model) isSummaryView() bool {
+	return m.uiState.GetViewMode() == model.ViewModeSummary
+}
+
+// summaryRows groups the filtered notifications by session.
+// Rows keep the order in which each session first appears in the filtered list.
+func (m *Model) summaryRows() []summaryRow {
+	rows := make([]summaryRow, 0)
+	indexBySession := make(map[string]int)
+	for _, notif := range m.filtered {
+		idx, ok := indexBySession[notif.Session]
+		if !ok {
+			idx = len(rows)
+			indexBySession[notif.Session] = idx
+			rows = append(rows, summaryRow{
+				session:     notif.Session,
+				levelCounts: make(map[string]int),
+				earliest:    notif.Timestamp,
+				latest:      notif.Timestamp,
+			})
+		}
+		row := &rows[idx]
+		row.count++
+		if !notif.IsRead() {
+			row.unreadCount++
+		}
+		level := notif.Level.String()
+		if level == "" {
+			level = settings.LevelFilterInfo
+		}
+		row.levelCounts[level]++
+		if notif.Timestamp < row.earliest {
+			row.earliest = notif.Timestamp
+		}
+		if notif.Timestamp > row.latest {
+			row.latest = notif.Timestamp
+		}
+	}
+	return rows
+}
+
+// renderSummaryView renders one row per session with counts by level.
+func (m *Model) renderSummaryView(content *strings.Builder, width, cursor int) {
+	rows := m.summaryRows()
+	if len(rows) == 0 {
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("No notifications found"))
+		return
+	}
+
+	options := m.getGroupHeaderOptions()
+	// Level counts are the point of the summary, so badges are always shown.
+	options.ShowLevelBadges = true
+	now := time.Now()
+	for i, row := range rows {
+		if i > 0 {
+			content.WriteString("\n")
+		}
+		display := m.getSessionName(row.session)
+		if display == "" {
+			display = "(no session)"
+		}
+		content.WriteString(render.RenderGroupRow(render.GroupRow{
+			Node: &render.GroupNode{
+				Title:       row.session,
+				Display:     display,
+				Count:       row.count,
+				UnreadCount: row.unreadCount,
+			},
+			Selected:          i == cursor,
+			Width:             width,
+			Now:               now,
+			EarliestTimestamp: row.earliest,
+			LatestTimestamp:   row.latest,
+			LevelCounts:       row.levelCounts,
+			Options:           options,
+			Selection:         m.selection,
+		}))
+	}
+}
+
+// drillIntoSummaryRow leaves search mode and switches to detailed view with the
+// cursor on the selected summary session's first notification in the current
+// sort order. The cursor stays at the top when that notification is filtered
+// out.
+func (m *Model) drillIntoSummaryRow() {
+	rows := m.summaryRows()
+	cursor := m.uiState.GetCursor()
+	if cursor < 0 || cursor >= len(rows) {
+		return
+	}
+	session := rows[cursor].session
+
+	m.uiState.SetViewMode(model.ViewModeDetailed)
+	m.uiState.SetSearchMode(false)
+	m.applySearchFilter()
+
+	m.resetCursor()
+	for i, notif := range m.filtered {
+		if notif.Session == session {
+			m.uiState.SetCursor(i)
+			break
+		}
+	}
+	m.ensureCursorVisible()
+	m.updateViewportContent()
+
+	m.autoSaveSettings()
+}
@@ -0,0 +1,88 @@
+package state
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSummaryTestModel(t *testing.T) *Model {
+	t.Helper()
+
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "build failed", Level: domain.LevelError, Timestamp: "2024-01-03T10:00:00Z"},
+		{ID: 2, Session: "$1", Window: "@1", Pane: "%1", Message: "lint warning", Level: domain.LevelWarning, Timestamp: "2024-01-02T10:00:00Z"},
+		{ID: 3, Session: "$1", Window: "@1", Pane: "%2", Message: "tests done", Level: domain.LevelInfo, Timestamp: "2024-01-01T10:00:00Z"},
+		{ID: 4, Session: "$2", Window: "@2", Pane: "%3", Message: "deploy done", Level: domain.LevelInfo, Timestamp: "2024-01-02T12:00:00Z"},
+	})
+	model.uiState.SetWidth(120)
+	model.uiState.GetViewport().Width = 120
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeSummary)
+	model.applySearchFilter()
+	model.resetCursor()
+	return model
+}
+
+func TestSummaryViewShowsPerSessionLevelCounts(t *testing.T) {
+	model := newSummaryTestModel(t)
+
+	rows := model.summaryRows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, 2, model.currentListLen())
+
+	bySession := map[string]summaryRow{}
+	for _, row := range rows {
+		bySession[row.session] = row
+	}
+	assert.Equal(t, 3, bySession["$1"].count)
+	assert.Equal(t, map[string]int{"error": 1, "warning": 1, "info": 1}, bySession["$1"].levelCounts)
+	assert.Equal(t, 1, bySession["$2"].count)
+	assert.Equal(t, map[string]int{"info": 1}, bySession["$2"].levelCounts)
+
+	model.updateViewportContent()
+	content := model.uiState.GetViewport().View()
+	lines := strings.Split(strings.TrimRight(content, "\n "), "\n")
+	nonEmpty := 0
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			nonEmpty++
+		}
+	}
+	assert.Equal(t, 2, nonEmpty)
+	assert.NotContains(t, content, "build failed")
+	assert.Contains(t, content, "❌1")
+	assert.Contains(t, content, "⚠1")
+}
+
+func TestSummaryViewEnterDrillsIntoDetailedView(t *testing.T) {
+	setupConfig(t, t.TempDir())
+	model := newSummaryTestModel(t)
+
+	rows := model.summaryRows()
+	require.Len(t, rows, 2)
+	targetSession := rows[1].session
+	model.uiState.SetCursor(1)
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(*Model)
+
+	assert.Nil(t, cmd)
+	assert.Equal(t, settings.ViewModeDetailed, string(model.uiState.GetViewMode()))
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.Equal(t, targetSession, selected.Session)
+}
+
+func TestSummaryViewHasNoSelectedNotification(t *testing.T) {
+	model := newSummaryTestModel(t)
+
+	_, ok := model.selectedNotification()
+	assert.False(t, ok)
+	assert.Nil(t, model.handleDismiss())
+}
@@ -0,0 +1,48 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTabLabelShowsActiveTabAndCountOnSwitch(t *testing.T) {
+	setupConfig(t, t.TempDir())
+	model := newTestModelWithCurrentTimestamps(t, []domain.Notification{
+		{ID: 1, Message: "first"},
+		{ID: 2, Message: "second"},
+		{ID: 3, Message: "third", State: domain.StateDismissed},
+	})
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetViewportDimensions(120, 20)
+	model.uiState.SetTabLabelVisible(true)
+
+	assert.Equal(t, 20-headerFooterLines-1, model.uiState.GetViewport().Height)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	model = updated.(*Model)
+	require.Equal(t, settings.TabAll, model.uiState.GetActiveTab())
+	assert.Contains(t, model.View(), fmt.Sprintf("── All (%d) ", len(model.filtered)))
+	allCount := len(model.filtered)
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	model = updated.(*Model)
+	require.Equal(t, settings.TabRecents, model.uiState.GetActiveTab())
+	view := model.View()
+	assert.Contains(t, view, fmt.Sprintf("── Recents (%d) ", len(model.filtered)))
+	assert.NotContains(t, view, "── All (")
+	assert.NotEqual(t, allCount, len(model.filtered), "the count follows the tab's own list")
+}
+
+func TestTabLabelHiddenByDefault(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{{ID: 1, Message: "first"}})
+	model.SetLoadedSettings(settings.DefaultSettings())
+
+	assert.False(t, model.uiState.IsTabLabelVisible())
+	assert.NotContains(t, model.View(), "── ")
+}
@@ -1,6 +1,8 @@
 package state
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -80,9 +82,7 @@ func newTestModel(t *testing.T, notifications []domain.Notification) *Model {
 	// Initialize notification service with default search provider
 	searchProvider := search.NewTokenProvider(
 		search.WithCaseInsensitive(true),
-		search.WithSessionNames(runtimeCoordinator.GetSessionNames()),
-		search.WithWindowNames(runtimeCoordinator.GetWindowNames()),
-		search.WithPaneNames(runtimeCoordinator.GetPaneNames()),
+		search.WithNameSource(runtimeCoordinator),
 	)
 	notificationService := service.NewNotificationService(searchProvider, runtimeCoordinator)
 	notificationService.SetShowStale(true)
@@ -247,6 +247,11 @@ type testRuntimeCoordinator struct {
 	ensureTmuxRunningFn func() bool
 	jumpToPaneFn        func(sessionID, windowID, paneID string) bool
 	jumpToWindowFn      func(sessionID, windowID string) bool
+	copyToClipboardFn   func(text string) error
+	currentContext      *uimodel.TmuxContext
+	sessionNames        map[string]string
+	windowNames         map[string]string
+	paneNames           map[string]string
 }
 
 type spyNotificationService struct {
@@ -284,12 +289,19 @@ func (t *testRuntimeCoordinator) JumpToWindow(sessionID, windowID string) bool {
 	return false
 }
 
+func (t *testRuntimeCoordinator) CopyToClipboard(text string) error {
+	if t.copyToClipboardFn != nil {
+		return t.copyToClipboardFn(text)
+	}
+	return nil
+}
+
 func (t *testRuntimeCoordinator) ValidatePaneExists(sessionID, windowID, paneID string) (bool, error) {
 	return true, nil
 }
 
 func (t *testRuntimeCoordinator) GetCurrentContext() (*uimodel.TmuxContext, error) {
-	return nil, nil
+	return t.currentContext, nil
 }
 
 func (t *testRuntimeCoordinator) ListSessions() (map[string]string, error) {
@@ -341,15 +353,15 @@ func (t *testRuntimeCoordinator) ResolvePaneName(paneID string) string {
 }
 
 func (t *testRuntimeCoordinator) GetSessionNames() map[string]string {
-	return nil
+	return t.sessionNames
 }
 
 func (t *testRuntimeCoordinator) GetWindowNames() map[string]string {
-	return nil
+	return t.windowNames
 }
 
 func (t *testRuntimeCoordinator) GetPaneNames() map[string]string {
-	return nil
+	return t.paneNames
 }
 
 func (t *testRuntimeCoordinator) SetSessionNames(names map[string]string) {}
@@ -440,6 +452,47 @@ func TestModelGroupedModeBuildsVisibleNodes(t *testing.T) {
 	assert.Equal(t, uimodel.NodeKindNotification, model.getVisibleNodesForTest()[7].Kind)
 }
 
+func TestModelWrapNavigation(t *testing.T) {
+	for _, grouped := range []bool{false, true} {
+		for _, wrap := range []bool{false, true} {
+			name := fmt.Sprintf("grouped=%t/wrap=%t", grouped, wrap)
+			t.Run(name, func(t *testing.T) {
+				model := newTestModel(t, []domain.Notification{
+					{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "One"},
+					{ID: 2, Session: "$2", Window: "@1", Pane: "%2", Message: "Two"},
+				})
+				if grouped {
+					model.uiState.SetViewMode(viewModeGrouped)
+					model.uiState.SetGroupBy(settings.GroupByPane)
+					disableModelGroupOptions(model)
+				}
+				model.uiState.SetActiveTab(settings.TabAll)
+				model.uiState.SetWrapNavigation(wrap)
+				model.applySearchFilter()
+				model.resetCursor()
+
+				last := model.currentListLen() - 1
+				require.Positive(t, last)
+
+				model.handleMoveUp()
+				if wrap {
+					assert.Equal(t, last, model.uiState.GetCursor())
+				} else {
+					assert.Equal(t, 0, model.uiState.GetCursor())
+				}
+
+				model.uiState.SetCursor(last)
+				model.handleMoveDown()
+				if wrap {
+					assert.Equal(t, 0, model.uiState.GetCursor())
+				} else {
+					assert.Equal(t, last, model.uiState.GetCursor())
+				}
+			})
+		}
+	}
+}
+
 func TestModelGroupedModeRespectsGroupByDepth(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -1069,6 +1122,130 @@ func TestModelUpdateHandlesJumpToTopWithGG(t *testing.T) {
 	assert.Equal(t, "", model.uiState.GetPendingKey())
 }
 
+func TestModelUpdateJumpsToNextAndPreviousErrorWithBracketE(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "First", Level: domain.LevelInfo},
+		{ID: 2, Message: "Second", Level: domain.LevelWarning},
+		{ID: 3, Message: "Third", Level: domain.LevelError},
+		{ID: 4, Message: "Fourth", Level: domain.LevelInfo},
+		{ID: 5, Message: "Fifth", Level: domain.LevelCritical},
+		{ID: 6, Message: "Sixth", Level: domain.LevelWarning},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.uiState.SetCursor(0)
+	require.Len(t, model.filtered, 6)
+
+	press := func(keys ...rune) {
+		for _, key := range keys {
+			updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+			model = updated.(*Model)
+		}
+	}
+
+	press(']', 'e')
+	assert.Equal(t, 2, model.uiState.GetCursor())
+	assert.Equal(t, "", model.uiState.GetPendingKey())
+
+	press(']', 'e')
+	assert.Equal(t, 4, model.uiState.GetCursor())
+
+	// Wraps past the end back to the first error.
+	press(']', 'e')
+	assert.Equal(t, 2, model.uiState.GetCursor())
+
+	// Wraps past the start back to the last error.
+	press('[', 'e')
+	assert.Equal(t, 4, model.uiState.GetCursor())
+	press('[', 'e')
+	assert.Equal(t, 2, model.uiState.GetCursor())
+}
+
+func TestModelUpdateBracketEWithoutErrorsKeepsCursor(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "First", Level: domain.LevelInfo},
+		{ID: 2, Message: "Second", Level: domain.LevelWarning},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.uiState.SetCursor(1)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	model = updated.(*Model)
+
+	assert.Equal(t, 1, model.uiState.GetCursor())
+}
+
+func TestModelUpdateGIMovesToFirstUnreadInList(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "First", Timestamp: "2024-01-01T12:03:00Z", ReadTimestamp: "2024-01-01T12:10:00Z"},
+		{ID: 2, Message: "Second", Timestamp: "2024-01-01T12:02:00Z", ReadTimestamp: "2024-01-01T12:10:00Z"},
+		{ID: 3, Message: "Third", Timestamp: "2024-01-01T12:01:00Z"},
+		{ID: 4, Message: "Fourth", Timestamp: "2024-01-01T12:00:00Z"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.SetLoadedSettings(&settings.Settings{UnreadFirst: false})
+	model.applySearchFilter()
+	model.uiState.SetCursor(0)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	model = updated.(*Model)
+
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.Equal(t, 3, selected.ID)
+	assert.Equal(t, "", model.uiState.GetPendingKey())
+}
+
+func TestModelUpdateGIExpandsGroupsToFirstUnread(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "build done", Session: "$1", Window: "@1", Pane: "%1", Timestamp: "2024-01-01T12:02:00Z", ReadTimestamp: "2024-01-01T12:10:00Z"},
+		{ID: 2, Message: "deploy failed", Session: "$2", Window: "@2", Pane: "%2", Timestamp: "2024-01-01T12:01:00Z"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByPane)
+	model.applySearchFilter()
+	for _, node := range model.getTreeRootForTest().Children {
+		model.treeService.CollapseNode(node)
+	}
+	model.invalidateCache()
+	model.uiState.SetCursor(0)
+	_, ok := model.selectedNotification()
+	require.False(t, ok, "collapsed groups hide every notification")
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	model = updated.(*Model)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	model = updated.(*Model)
+
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	assert.Equal(t, 2, selected.ID)
+}
+
+func TestModelUpdateGIWithoutUnreadShowsMessage(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "First", ReadTimestamp: "2024-01-01T12:10:00Z"},
+		{ID: 2, Message: "Second", ReadTimestamp: "2024-01-01T12:10:00Z"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.uiState.SetCursor(1)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	assert.Equal(t, 1, model.uiState.GetCursor())
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "No unread notifications in view", msg.Text)
+}
+
 func TestModelUpdateNavigationJKRemainsAfterPendingG(t *testing.T) {
 	model := newTestModel(t, []domain.Notification{
 		{ID: 1, Message: "First"},
@@ -1218,6 +1395,52 @@ func TestModelUpdateSearchViewModeEnterJumpsWhileSearchActive(t *testing.T) {
 	assert.True(t, model.uiState.IsSearchMode())
 }
 
+func TestModelSearchViewEmptyQueryHonorsSearchEmptyShowsAll(t *testing.T) {
+	notifications := []domain.Notification{
+		{ID: 1, Message: "build failed", Session: "$1", Window: "@1", Pane: "%1"},
+		{ID: 2, Message: "deploy done", Session: "$1", Window: "@1", Pane: "%2"},
+	}
+
+	newSearchModel := func(showAll bool) *Model {
+		m := newTestModel(t, notifications)
+		loaded := settings.DefaultSettings()
+		loaded.SearchEmptyShowsAll = showAll
+		m.SetLoadedSettings(loaded)
+		m.uiState.SetActiveTab(settings.TabAll)
+		m.uiState.SetViewMode(settings.ViewModeSearch)
+		m.uiState.SetSearchQuery("")
+		m.applySearchFilter()
+		return m
+	}
+
+	showAll := newSearchModel(true)
+	assert.Len(t, showAll.filtered, 2)
+
+	showNone := newSearchModel(false)
+	assert.Empty(t, showNone.filtered)
+
+	showAll.uiState.SetSearchQuery("build")
+	showAll.applySearchFilter()
+	showNone.uiState.SetSearchQuery("build")
+	showNone.applySearchFilter()
+	require.Len(t, showAll.filtered, 1)
+	assert.Equal(t, showAll.filtered, showNone.filtered)
+}
+
+func TestModelEmptyQueryOutsideSearchViewIgnoresSearchEmptyShowsAll(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Message: "First", Session: "$1", Window: "@1", Pane: "%1"},
+	})
+	loaded := settings.DefaultSettings()
+	loaded.SearchEmptyShowsAll = false
+	model.SetLoadedSettings(loaded)
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+	model.applySearchFilter()
+
+	assert.Len(t, model.filtered, 1)
+}
+
 func TestModelUpdateCyclesViewModesWithPersistence(t *testing.T) {
 	tmpDir := t.TempDir()
 	setupConfig(t, tmpDir)
@@ -1248,7 +1471,15 @@ func TestModelUpdateCyclesViewModesWithPersistence(t *testing.T) {
 
 	updated, _ = model.Update(msg)
 	model = updated.(*Model)
-	// After search, cycle back to detailed (3-mode cycle: detailed -> grouped -> search -> detailed)
+	assert.Equal(t, settings.ViewModeSummary, string(model.uiState.GetViewMode()))
+	assert.False(t, model.uiState.IsSearchMode())
+	loaded, err = settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.ViewModeSummary, loaded.ViewMode)
+
+	updated, _ = model.Update(msg)
+	model = updated.(*Model)
+	// After summary, cycle back to detailed (detailed -> grouped -> search -> summary -> detailed)
 	assert.Equal(t, settings.ViewModeDetailed, string(model.uiState.GetViewMode()))
 	assert.False(t, model.uiState.IsSearchMode())
 	loaded, err = settings.Load()
@@ -1256,7 +1487,7 @@ func TestModelUpdateCyclesViewModesWithPersistence(t *testing.T) {
 	assert.Equal(t, settings.ViewModeDetailed, loaded.ViewMode)
 }
 
-func TestModelViewModeCycleThreeModeBehavior(t *testing.T) {
+func TestModelViewModeCycleFourModeBehavior(t *testing.T) {
 	tests := []struct {
 		name           string
 		startMode      uimodel.ViewMode
@@ -1268,8 +1499,9 @@ func TestModelViewModeCycleThreeModeBehavior(t *testing.T) {
 			expectedCycles: []uimodel.ViewMode{
 				uimodel.ViewModeGrouped,  // 1st press: detailed -> grouped
 				uimodel.ViewModeSearch,   // 2nd press: grouped -> search
-				uimodel.ViewModeDetailed, // 3rd press: search -> detailed
-				uimodel.ViewModeGrouped,  // 4th press: detailed -> grouped
+				uimodel.ViewModeSummary,  // 3rd press: search -> summary
+				uimodel.ViewModeDetailed, // 4th press: summary -> detailed
+				uimodel.ViewModeGrouped,  // 5th press: detailed -> grouped
 			},
 		},
 		{
@@ -1277,19 +1509,29 @@ func TestModelViewModeCycleThreeModeBehavior(t *testing.T) {
 			startMode: uimodel.ViewModeGrouped,
 			expectedCycles: []uimodel.ViewMode{
 				uimodel.ViewModeSearch,   // 1st press: grouped -> search
-				uimodel.ViewModeDetailed, // 2nd press: search -> detailed
-				uimodel.ViewModeGrouped,  // 3rd press: detailed -> grouped
-				uimodel.ViewModeSearch,   // 4th press: grouped -> search
+				uimodel.ViewModeSummary,  // 2nd press: search -> summary
+				uimodel.ViewModeDetailed, // 3rd press: summary -> detailed
+				uimodel.ViewModeGrouped,  // 4th press: detailed -> grouped
 			},
 		},
 		{
 			name:      "cycle from search mode",
 			startMode: uimodel.ViewModeSearch,
 			expectedCycles: []uimodel.ViewMode{
-				uimodel.ViewModeDetailed, // 1st press: search -> detailed
+				uimodel.ViewModeSummary,  // 1st press: search -> summary
+				uimodel.ViewModeDetailed, // 2nd press: summary -> detailed
+				uimodel.ViewModeGrouped,  // 3rd press: detailed -> grouped
+				uimodel.ViewModeSearch,   // 4th press: grouped -> search
+			},
+		},
+		{
+			name:      "cycle from summary mode",
+			startMode: uimodel.ViewModeSummary,
+			expectedCycles: []uimodel.ViewMode{
+				uimodel.ViewModeDetailed, // 1st press: summary -> detailed
 				uimodel.ViewModeGrouped,  // 2nd press: detailed -> grouped
 				uimodel.ViewModeSearch,   // 3rd press: grouped -> search
-				uimodel.ViewModeDetailed, // 4th press: search -> detailed
+				uimodel.ViewModeSummary,  // 4th press: search -> summary
 			},
 		},
 	}
@@ -1469,6 +1711,151 @@ func TestModelUpdateHandlesReadUnreadKeys(t *testing.T) {
 	assert.False(t, model.filtered[0].IsRead())
 }
 
+func TestModelUpdateToggleReadKey(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC()
+	_, err := storage.AddNotification("first", now.Add(-time.Minute).Format(time.RFC3339), "", "", "", "", "info")
+	require.NoError(t, err)
+	id, err := storage.AddNotification("second", now.Format(time.RFC3339), "", "", "", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	require.Len(t, model.filtered, 2)
+	for cursor, notif := range model.filtered {
+		if strconv.Itoa(notif.ID) == id {
+			model.uiState.SetCursor(cursor)
+		}
+	}
+
+	isRead := func() bool {
+		t.Helper()
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		return loaded.IsRead()
+	}
+	assertSelected := func() {
+		t.Helper()
+		selected, ok := model.selectedNotification()
+		require.True(t, ok)
+		assert.Equal(t, id, strconv.Itoa(selected.ID), "the cursor follows the toggled notification")
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	model = updated.(*Model)
+	assert.True(t, isRead(), "t reads an unread notification")
+	assertSelected()
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	model = updated.(*Model)
+	assert.False(t, isRead(), "t unreads a read notification")
+	assertSelected()
+}
+
+func TestMarkAllReadKeyMarksOnlyFilteredNotifications(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	buildA, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	buildB, err := storage.AddNotification("build passed", now, "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	deploy, err := storage.AddNotification("deploy done", now, "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetSearchQuery("build")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 2)
+
+	isRead := func(id string) bool {
+		t.Helper()
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		return loaded.IsRead()
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	model = updated.(*Model)
+	assert.True(t, isRead(buildA))
+	assert.True(t, isRead(buildB))
+	assert.False(t, isRead(deploy), "notifications outside the view stay unread")
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Marked 2 notifications read", msg.Text)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	msg, ok = model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Nothing unread in view", msg.Text)
+
+	model.handleUndo()
+	assert.False(t, isRead(buildA))
+	assert.False(t, isRead(buildB))
+}
+
+func TestMarkAllUnreadKeyMarksOnlyFilteredNotifications(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	buildA, err := storage.AddNotification("build failed", now, "$1", "@1", "%1", "", "error")
+	require.NoError(t, err)
+	buildB, err := storage.AddNotification("build passed", now, "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	deploy, err := storage.AddNotification("deploy done", now, "$2", "@2", "%3", "", "info")
+	require.NoError(t, err)
+	marked, err := storage.MarkReadByIDs([]string{buildA, buildB, deploy})
+	require.NoError(t, err)
+	require.Equal(t, 3, marked)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	model.uiState.SetSearchMode(false)
+	model.uiState.SetSearchQuery("build")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 2)
+
+	isRead := func(id string) bool {
+		t.Helper()
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		return loaded.IsRead()
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	model = updated.(*Model)
+	assert.False(t, isRead(buildA))
+	assert.False(t, isRead(buildB))
+	assert.True(t, isRead(deploy), "notifications outside the view stay read")
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Marked 2 notifications unread", msg.Text)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	msg, ok = model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Nothing read in view", msg.Text)
+
+	model.handleUndo()
+	assert.True(t, isRead(buildA))
+	assert.True(t, isRead(buildB))
+}
+
 func TestApplySearchFilterReadStatus(t *testing.T) {
 	model := newTestModel(t, []domain.Notification{
 		{ID: 1, Message: "Alpha", ReadTimestamp: "2024-01-01T12:00:00Z"},
@@ -1503,6 +1890,47 @@ func TestApplySearchFilterReadStatus(t *testing.T) {
 	assert.Equal(t, "Alpha", model.filtered[0].Message)
 }
 
+func TestApplySearchFilterMatchesDisplayNames(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@1", Pane: "%1", Message: "Build finished"},
+		{ID: 2, Session: "$2", Window: "@2", Pane: "%2", Message: "Tests passed"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+
+	// Names resolved after the search provider was created must be searchable.
+	model.runtimeCoordinator.SetSessionNames(map[string]string{"$1": "api", "$2": "web"})
+	model.runtimeCoordinator.SetWindowNames(map[string]string{"@1": "server", "@2": "client"})
+	model.runtimeCoordinator.SetPaneNames(map[string]string{"%1": "logs", "%2": "shell"})
+
+	for _, query := range []string{"api", "server", "logs"} {
+		model.uiState.SetSearchQuery(query)
+		model.applySearchFilter()
+		model.resetCursor()
+		require.Len(t, model.filtered, 1, "query %q", query)
+		assert.Equal(t, 1, model.filtered[0].ID, "query %q", query)
+	}
+}
+
+func TestApplySearchFilterExpandsSearchAliases(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Window: "@1", Message: "deploy failed", Important: true},
+		{ID: 2, Window: "@2", Message: "deploy failed", Important: true, ReadTimestamp: "2024-01-01T12:00:00Z"},
+		{ID: 3, Window: "@2", Message: "deploy done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.SetLoadedSettings(&settings.Settings{SearchAliases: map[string]string{"urgent": "important:true unread"}})
+
+	model.uiState.SetSearchQuery("@urgent deploy")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, 1, model.filtered[0].ID)
+
+	model.uiState.SetSearchQuery("@2")
+	model.applySearchFilter()
+	require.Len(t, model.filtered, 2, "an undefined alias is searched as typed")
+	assert.Equal(t, "@2", model.uiState.GetSearchQuery(), "the typed query is kept")
+}
+
 // TestApplySearchFilterWithMockProvider tests that applySearchFilter correctly
 // uses a custom mock search provider when set.
 func TestApplySearchFilterWithMockProvider(t *testing.T) {
@@ -2786,6 +3214,205 @@ func TestHandleJumpGroupedWindowNodeUsesWindowJump(t *testing.T) {
 	assert.True(t, windowJumpCalled)
 }
 
+func TestJumpNewestInGroupKeyJumpsToNewestNotificationPane(t *testing.T) {
+	setupStorage(t)
+
+	now := time.Now().UTC()
+	olderID, err := storage.AddNotification("older", now.Add(-2*time.Hour).Format(time.RFC3339), "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	newestID, err := storage.AddNotification("newest", now.Add(-time.Minute).Format(time.RFC3339), "$1", "@2", "%4", "", "info")
+	require.NoError(t, err)
+	_, err = storage.AddNotification("middle", now.Add(-time.Hour).Format(time.RFC3339), "$1", "@1", "%2", "", "info")
+	require.NoError(t, err)
+	_, err = storage.AddNotification("other session", now.Format(time.RFC3339), "$2", "@9", "%9", "", "info")
+	require.NoError(t, err)
+
+	mockClient := stubSessionFetchers(t)
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByPane)
+	model.applySearchFilter()
+
+	sessionIndex := -1
+	for idx, node := range model.getVisibleNodesForTest() {
+		if node != nil && node.Kind == uimodel.NodeKindSession && node.Title == "$1" {
+			sessionIndex = idx
+			break
+		}
+	}
+	require.NotEqual(t, -1, sessionIndex)
+	model.uiState.SetCursor(sessionIndex)
+
+	var gotSession, gotWindow, gotPane string
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		ensureTmuxRunningFn: func() bool { return true },
+		jumpToPaneFn: func(sessionID, windowID, paneID string) bool {
+			gotSession, gotWindow, gotPane = sessionID, windowID, paneID
+			return true
+		},
+	}
+	model.interactionCtrl = nil
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "$1", gotSession)
+	assert.Equal(t, "@2", gotWindow)
+	assert.Equal(t, "%4", gotPane)
+
+	for id, wantRead := range map[string]bool{newestID: true, olderID: false} {
+		line, err := storage.GetNotificationByID(id)
+		require.NoError(t, err)
+		loaded, err := domain.ParseNotificationLine(line)
+		require.NoError(t, err)
+		assert.Equal(t, wantRead, loaded.IsRead(), "notification %s", id)
+	}
+}
+
+func TestJumpNewestInGroupKeyIgnoresNotificationRows(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@2", Pane: "%3", Message: "only"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		ensureTmuxRunningFn: func() bool { return true },
+		jumpToPaneFn: func(sessionID, windowID, paneID string) bool {
+			t.Fatalf("jump should only run on group rows, got %s:%s.%s", sessionID, windowID, paneID)
+			return false
+		},
+	}
+	model.interactionCtrl = nil
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	assert.Nil(t, cmd)
+}
+
+func TestCopyJumpCommandForNotification(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 7, Session: "$1", Window: "@2", Pane: "%3", Message: "build done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	var copied string
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		copyToClipboardFn: func(text string) error {
+			copied = text
+			return nil
+		},
+	}
+	model.interactionCtrl = nil
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	model = updated.(*Model)
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "tmux-intray jump 7", copied)
+}
+
+func TestCopyLocationUsesResolvedNames(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 7, Session: "$1", Window: "@2", Pane: "%3", Message: "build done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	var copied string
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		copyToClipboardFn: func(text string) error {
+			copied = text
+			return nil
+		},
+		sessionNames: map[string]string{"$1": "api"},
+		windowNames:  map[string]string{"@2": "editor"},
+		paneNames:    map[string]string{"%3": "0"},
+	}
+	model.interactionCtrl = nil
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "api:editor.0", copied)
+}
+
+func TestCopyLocationFallsBackToRawIDs(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 7, Session: "$1", Window: "@2", Pane: "%3", Message: "build done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	var copied string
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		copyToClipboardFn: func(text string) error {
+			copied = text
+			return nil
+		},
+		sessionNames: map[string]string{"$1": "api"},
+	}
+	model.interactionCtrl = nil
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "api:@2.%3", copied)
+}
+
+func TestCopyJumpCommandGroupedWindowNodeUsesTmuxTargets(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@2", Pane: "%3", Message: "window grouped"},
+	})
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByWindow)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	windowIndex := -1
+	for idx, node := range model.getVisibleNodesForTest() {
+		if node != nil && node.Kind == uimodel.NodeKindWindow {
+			windowIndex = idx
+			break
+		}
+	}
+	require.NotEqual(t, -1, windowIndex)
+	model.uiState.SetCursor(windowIndex)
+
+	command, ok := model.jumpCommandForSelection()
+	require.True(t, ok)
+	assert.Equal(t, `tmux switch-client -t '$1' \; select-window -t '$1:@2'`, command)
+}
+
+func TestTmuxJumpCommandIncludesPaneTarget(t *testing.T) {
+	command := tmuxJumpCommand(jumpTarget{Session: "$1", Window: "@2", Pane: "%3"})
+	assert.Equal(t, `tmux switch-client -t '$1' \; select-window -t '$1:@2' \; select-pane -t '$1:@2.%3'`, command)
+}
+
+func TestCopyJumpCommandReportsClipboardFailure(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{
+		{ID: 1, Session: "$1", Window: "@2", Pane: "%3", Message: "build done"},
+	})
+	model.uiState.SetActiveTab(settings.TabAll)
+	model.applySearchFilter()
+	model.resetCursor()
+	model.runtimeCoordinator = &testRuntimeCoordinator{
+		copyToClipboardFn: func(text string) error { return stderrors.New("no tmux") },
+	}
+	model.interactionCtrl = nil
+
+	cmd := model.handleCopyJumpCommand()
+
+	assert.NotNil(t, cmd)
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Contains(t, msg.Text, "copy: failed to copy jump command")
+}
+
 func TestHandleJumpWithEmptyList(t *testing.T) {
 	model := newTestModel(t, []domain.Notification{})
 	model.uiState.SetCursor(0)
@@ -3762,6 +4389,41 @@ func TestHandleDismissGroup_Success(t *testing.T) {
 	assert.Equal(t, ActionDismissGroup, model.uiState.GetPendingAction().Type)
 }
 
+func TestHandleDismissGroup_UnassignedDismissesOnlyLocationless(t *testing.T) {
+	setupStorage(t)
+
+	located := domain.Notification{ID: 1, State: domain.StateActive, Session: "$1", Window: "@1", Pane: "%1", Message: "located"}
+	loose := domain.Notification{ID: 2, State: domain.StateActive, Message: "loose"}
+
+	model := newTestModel(t, []domain.Notification{located, loose})
+	model.uiState.SetWidth(80)
+	model.uiState.GetViewport().Width = 80
+	model.uiState.SetViewMode(viewModeGrouped)
+	model.uiState.SetGroupBy(settings.GroupByPane)
+	disableModelGroupOptions(model)
+	model.applySearchFilter()
+	model.resetCursor()
+
+	cursor := -1
+	for i, node := range model.treeService.GetVisibleNodes() {
+		if node.Kind == uimodel.NodeKindUnassigned {
+			cursor = i
+			break
+		}
+	}
+	require.NotEqual(t, -1, cursor, "location-less notification should form an unassigned group")
+	model.uiState.SetCursor(cursor)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	model = updated.(*Model)
+
+	require.True(t, model.uiState.IsConfirmationMode())
+	action := model.uiState.GetPendingAction()
+	assert.Equal(t, ActionDismissFiltered, action.Type)
+	assert.Equal(t, []int{2}, action.IDs)
+	assert.Equal(t, "Dismiss 1 notifications in this unassigned group?", action.Message)
+}
+
 func TestHandleDismissGroup_EmptyGroup(t *testing.T) {
 	model := newTestModel(t, []domain.Notification{})
 	model.uiState.SetWidth(80)
@@ -4332,3 +4994,68 @@ func TestArrowKeyNavigationInNormalMode(t *testing.T) {
 	model = updated.(*Model)
 	assert.Equal(t, 0, model.uiState.GetCursor())
 }
+
+func newConfirmSaveOnQuitModel(t *testing.T) *Model {
+	t.Helper()
+	setupConfig(t, t.TempDir())
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	loaded := settings.DefaultSettings()
+	loaded.ConfirmSaveOnQuit = true
+	model.SetLoadedSettings(loaded)
+	require.NoError(t, model.FromState(settings.FromSettings(loaded)))
+	model.uiState.SetSearchMode(false)
+	return model
+}
+
+func TestQuitWithoutViewChangesSkipsSavePrompt(t *testing.T) {
+	model := newConfirmSaveOnQuitModel(t)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+	assert.False(t, model.uiState.IsConfirmationMode())
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+func TestQuitWithViewChangesPromptsAndDeclineDoesNotPersist(t *testing.T) {
+	model := newConfirmSaveOnQuitModel(t)
+
+	model.switchActiveTab(settings.TabAll)
+	loaded, err := settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.TabRecents, loaded.ActiveTab, "changes wait for the quit prompt")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, cmd)
+	require.True(t, model.uiState.IsConfirmationMode())
+	assert.Equal(t, ActionSaveOnQuit, model.uiState.GetPendingAction().Type)
+	assert.Equal(t, "Save view changes?", model.uiState.GetPendingAction().Message)
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	assert.False(t, model.uiState.IsConfirmationMode())
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+
+	loaded, err = settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.TabRecents, loaded.ActiveTab)
+}
+
+func TestQuitWithViewChangesSavesWhenConfirmed(t *testing.T) {
+	model := newConfirmSaveOnQuitModel(t)
+	model.switchActiveTab(settings.TabAll)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	require.True(t, model.uiState.IsConfirmationMode())
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	require.NotNil(t, cmd)
+
+	loaded, err := settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.TabAll, loaded.ActiveTab)
+	assert.True(t, loaded.ConfirmSaveOnQuit)
+}
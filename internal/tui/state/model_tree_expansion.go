@@ -27,7 +27,7 @@ func (m *Model) collapseNode(node *model.TreeNode) {
 
 	m.treeService.CollapseNode(node)
 	m.updateExpansionState(node, false)
-	visibleNodes := m.treeService.GetVisibleNodes()
+	visibleNodes := m.computeVisibleNodes()
 
 	// If selected node was inside the collapsed node, move cursor to the collapsed node
 	m.moveCursorToCollapsedNodeIfNeeded(selectedID, nodeID, visibleNodes)
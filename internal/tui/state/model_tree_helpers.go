@@ -11,7 +11,7 @@ func (m *Model) getTreeRootForTest() *model.TreeNode {
 
 // getVisibleNodesForTest returns the visible nodes for testing purposes.
 func (m *Model) getVisibleNodesForTest() []*model.TreeNode {
-	return m.treeService.GetVisibleNodes()
+	return m.computeVisibleNodes()
 }
 
 // collectNotificationsInGroup collects all notifications under a group node.
@@ -48,6 +48,12 @@ func getGroupTypeLabel(kind model.NodeKind) string {
 		return "pane"
 	case model.NodeKindMessage:
 		return "message"
+	case model.NodeKindDay:
+		return "day"
+	case model.NodeKindWeek:
+		return "week"
+	case model.NodeKindUnassigned:
+		return "unassigned group"
 	default:
 		return "group"
 	}
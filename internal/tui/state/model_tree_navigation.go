@@ -1,6 +1,8 @@
 package state
 
 import (
+	"fmt"
+
 	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
 )
 
@@ -13,7 +15,7 @@ func (m *Model) getNodeIdentifier(node *model.TreeNode) string {
 
 // findNodeByIdentifier finds a node by its identifier in the visible nodes list.
 func (m *Model) findNodeByIdentifier(identifier string) *model.TreeNode {
-	for _, node := range m.treeService.GetVisibleNodes() {
+	for _, node := range m.computeVisibleNodes() {
 		if m.treeService.GetNodeIdentifier(node) == identifier {
 			return node
 		}
@@ -22,6 +24,7 @@ func (m *Model) findNodeByIdentifier(identifier string) *model.TreeNode {
 }
 
 // restoreCursor restores the cursor to the node with the given identifier.
+// In the flat list the identifier names a notification row.
 // If the node is not found, it adjusts the cursor to be within bounds.
 func (m *Model) restoreCursor(identifier string) {
 	if identifier == "" {
@@ -29,9 +32,21 @@ func (m *Model) restoreCursor(identifier string) {
 		return
 	}
 
+	if !m.isGroupedView() && !m.isSummaryView() {
+		for i, notif := range m.filtered {
+			if fmt.Sprintf("notif:%d", notif.ID) == identifier {
+				m.uiState.SetCursor(i)
+				m.uiState.EnsureCursorVisible(len(m.filtered))
+				return
+			}
+		}
+		m.adjustCursorBounds()
+		return
+	}
+
 	targetNode := m.findNodeByIdentifier(identifier)
 	if targetNode != nil {
-		visibleNodes := m.ensureTreeService().GetVisibleNodes()
+		visibleNodes := m.computeVisibleNodes()
 		for i, node := range visibleNodes {
 			if node == targetNode {
 				m.uiState.SetCursor(i)
@@ -1,8 +1,6 @@
 package state
 
 import (
-	"fmt"
-
 	"github.com/cristianoliveira/tmux-intray/internal/settings"
 	"github.com/cristianoliveira/tmux-intray/internal/tui/model"
 )
@@ -16,7 +14,7 @@ func (m *Model) IsGroupedView() bool {
 	return m.isGroupedView()
 }
 
-// cycleViewMode cycles through available view modes (detailed → grouped → search).
+// cycleViewMode cycles through available view modes (detailed → grouped → search → summary).
 func (m *Model) cycleViewMode() {
 	prevMode := m.uiState.GetViewMode()
 	m.uiState.CycleViewMode()
@@ -32,9 +30,7 @@ func (m *Model) cycleViewMode() {
 	m.applySearchFilter()
 	m.resetCursor()
 
-	if err := m.saveSettings(); err != nil {
-		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
-	}
+	m.autoSaveSettings()
 }
 
 func (m *Model) cycleActiveTab() {
@@ -48,13 +44,13 @@ func (m *Model) cycleActiveTab() {
 	m.applySearchFilter()
 	m.resetCursor()
 
-	if err := m.saveSettings(); err != nil {
-		m.errorHandler.Warning(fmt.Sprintf("Failed to save settings: %v", err))
-	}
+	m.autoSaveSettings()
 }
 
+// computeVisibleNodes returns the rows of grouped view: the tree's visible
+// nodes, narrowed to the focused group when one is set.
 func (m *Model) computeVisibleNodes() []*model.TreeNode {
-	return m.treeService.GetVisibleNodes()
+	return m.filterFocusGroup(m.treeService.GetVisibleNodes())
 }
 
 func (m *Model) invalidateCache() {
@@ -92,7 +88,10 @@ func getTreeLevel(node *model.TreeNode) int {
 
 func (m *Model) currentListLen() int {
 	if m.isGroupedView() {
-		return len(m.treeService.GetVisibleNodes())
+		return len(m.computeVisibleNodes())
+	}
+	if m.isSummaryView() {
+		return len(m.summaryRows())
 	}
 	return len(m.filtered)
 }
@@ -102,7 +101,7 @@ func (m *Model) selectedVisibleNode() *model.TreeNode {
 		return nil
 	}
 	cursor := m.uiState.GetCursor()
-	visibleNodes := m.treeService.GetVisibleNodes()
+	visibleNodes := m.computeVisibleNodes()
 	if cursor < 0 || cursor >= len(visibleNodes) {
 		return nil
 	}
@@ -221,7 +220,7 @@ func (m *Model) applyDefaultExpansion() {
 	}
 
 	// Ensure cursor is within bounds
-	visibleNodes := m.treeService.GetVisibleNodes()
+	visibleNodes := m.computeVisibleNodes()
 	if m.uiState.GetCursor() >= len(visibleNodes) {
 		m.uiState.SetCursor(len(visibleNodes) - 1)
 	}
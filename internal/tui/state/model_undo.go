@@ -0,0 +1,120 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+)
+
+// maxUndoEntries bounds how many mutating actions can be undone.
+const maxUndoEntries = 10
+
+// undoKind identifies the mutating action recorded in an undo entry.
+type undoKind string
+
+const (
+	undoDismiss    undoKind = "dismiss"
+	undoMarkRead   undoKind = "mark read"
+	undoMarkUnread undoKind = "mark unread"
+)
+
+// undoEntry captures the data needed to reverse a mutating action.
+type undoEntry struct {
+	kind undoKind
+	ids  []int
+}
+
+// pushUndo records a reversible action, dropping the oldest entry when full.
+func (m *Model) pushUndo(kind undoKind, ids ...int) {
+	if len(ids) == 0 {
+		return
+	}
+	m.undoStack = append(m.undoStack, undoEntry{kind: kind, ids: ids})
+	if len(m.undoStack) > maxUndoEntries {
+		m.undoStack = m.undoStack[len(m.undoStack)-maxUndoEntries:]
+	}
+}
+
+// handleUndo reverses the most recent mutating action.
+func (m *Model) handleUndo() tea.Cmd {
+	if len(m.undoStack) == 0 {
+		m.errorHandler.Info("Nothing to undo")
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	entry := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+
+	var err error
+	switch entry.kind {
+	case undoDismiss:
+		ctrl := m.ensureInteractionController()
+		for _, notifID := range entry.ids {
+			if err = ctrl.UndismissNotification(strconv.Itoa(notifID)); err != nil {
+				break
+			}
+		}
+	case undoMarkRead:
+		err = m.setReadState(entry.ids, false)
+	case undoMarkUnread:
+		err = m.setReadState(entry.ids, true)
+	}
+	if err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to undo %s: %v", entry.kind, err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	if err := m.loadNotifications(true); err != nil {
+		m.errorHandler.Error(fmt.Sprintf("Failed to reload notifications: %v", err))
+		return errorMsgAfter(errorClearDuration)
+	}
+
+	// Restore cursor to the first notification affected by the undone action
+	identifier := fmt.Sprintf("notif:%d", entry.ids[0])
+	m.restoreCursor(identifier)
+
+	m.updateViewportContent()
+
+	m.errorHandler.Success(fmt.Sprintf("Undid %s", entry.kind))
+	return errorMsgAfter(errorClearDuration)
+}
+
+// activeNotificationIDsByFilter returns IDs of loaded active notifications
+// matching the provided filters. Empty string in a field means "match any value".
+func (m *Model) activeNotificationIDsByFilter(session, window, pane string) []int {
+	ids := make([]int, 0)
+	for _, notif := range m.notifications {
+		if notif.State != domain.StateActive {
+			continue
+		}
+		if session != "" && notif.Session != session {
+			continue
+		}
+		if window != "" && notif.Window != window {
+			continue
+		}
+		if pane != "" && notif.Pane != pane {
+			continue
+		}
+		ids = append(ids, notif.ID)
+	}
+	return ids
+}
+
+// activeUnassignedNotificationIDs returns the IDs of active notifications
+// that have no session, window or pane.
+func (m *Model) activeUnassignedNotificationIDs() []int {
+	ids := make([]int, 0)
+	for _, notif := range m.notifications {
+		if notif.State != domain.StateActive {
+			continue
+		}
+		if notif.Session != "" || notif.Window != "" || notif.Pane != "" {
+			continue
+		}
+		ids = append(ids, notif.ID)
+	}
+	return ids
+}
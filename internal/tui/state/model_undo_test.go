@@ -0,0 +1,150 @@
+package state
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cristianoliveira/tmux-intray/internal/domain"
+	"github.com/cristianoliveira/tmux-intray/internal/settings"
+	"github.com/cristianoliveira/tmux-intray/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoRestoresDismissedNotification(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	id, err := storage.AddNotification("Test message", time.Now().UTC().Format(time.RFC3339), "", "", "", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	require.Len(t, model.filtered, 1)
+
+	model.handleDismiss()
+	require.Empty(t, model.filtered)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	model = updated.(*Model)
+
+	require.Len(t, model.filtered, 1)
+	assert.Equal(t, id, strconv.Itoa(model.filtered[0].ID))
+	assert.Empty(t, model.undoStack)
+
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	loaded, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateActive, loaded.State)
+}
+
+func TestRestoreKeyUndismissesSelectedNotification(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id, err := storage.AddNotification("deploy done", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	require.NoError(t, storage.DismissNotification(id))
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.uiState.SetViewMode(settings.ViewModeDetailed)
+	model.switchActiveTab(settings.TabSessions)
+	selected, ok := model.selectedNotification()
+	require.True(t, ok)
+	require.Equal(t, domain.StateDismissed, selected.State)
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	model = updated.(*Model)
+
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	loaded, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateActive, loaded.State)
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Notification restored", msg.Text)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	msg, ok = model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Notification is not dismissed", msg.Text)
+}
+
+func TestUndoRestoresGroupDismiss(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	_, err := storage.AddNotification("one", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = storage.AddNotification("two", now, "$1", "@1", "%1", "", "info")
+	require.NoError(t, err)
+	_, err = storage.AddNotification("other", now, "$2", "@2", "%2", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	model.switchActiveTab(settings.TabAll)
+	require.Len(t, model.filtered, 3)
+
+	model.handleDismissByFilter("$1", "", "")
+	require.Len(t, model.filtered, 1)
+
+	model.handleUndo()
+	assert.Len(t, model.filtered, 3)
+}
+
+func TestUndoReversesMarkRead(t *testing.T) {
+	setupStorage(t)
+	mockClient := stubSessionFetchers(t)
+
+	id, err := storage.AddNotification("Test message", time.Now().UTC().Format(time.RFC3339), "", "", "", "", "info")
+	require.NoError(t, err)
+
+	model, err := NewModel(mockClient)
+	require.NoError(t, err)
+	require.Len(t, model.filtered, 1)
+
+	model.markSelectedRead()
+	require.Len(t, model.undoStack, 1)
+
+	model.handleUndo()
+
+	line, err := storage.GetNotificationByID(id)
+	require.NoError(t, err)
+	loaded, err := domain.ParseNotificationLine(line)
+	require.NoError(t, err)
+	assert.False(t, loaded.IsRead())
+}
+
+func TestUndoWithEmptyStackShowsInfo(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{})
+
+	cmd := model.handleUndo()
+
+	assert.NotNil(t, cmd)
+	msg, ok := model.errorHandler.GetLatest()
+	require.True(t, ok)
+	assert.Equal(t, "Nothing to undo", msg.Text)
+}
+
+func TestPushUndoIsBounded(t *testing.T) {
+	model := newTestModel(t, []domain.Notification{})
+
+	for i := 1; i <= maxUndoEntries+5; i++ {
+		model.pushUndo(undoDismiss, i)
+	}
+
+	require.Len(t, model.undoStack, maxUndoEntries)
+	assert.Equal(t, []int{6}, model.undoStack[0].ids)
+	assert.Equal(t, []int{maxUndoEntries + 5}, model.undoStack[maxUndoEntries-1].ids)
+
+	model.pushUndo(undoMarkRead)
+	assert.Len(t, model.undoStack, maxUndoEntries)
+}
@@ -86,6 +86,40 @@ func (s *settingsService) fromState(state settings.TUIState, uiState *UIState, c
 	return nil
 }
 
+// applyFileOnlySettings copies settings that are only configured in tui.toml
+// (and not tracked by TUIState) so saving UI state does not reset them.
+func applyFileOnlySettings(dest *settings.Settings, loaded *settings.Settings) {
+	source := loaded
+	if source == nil {
+		source = settings.DefaultSettings()
+	}
+	dest.GroupHeader = source.GroupHeader.Clone()
+	dest.Selection = source.Selection
+	dest.SearchEmptyShowsAll = source.SearchEmptyShowsAll
+	dest.AutoReadDwellSeconds = source.AutoReadDwellSeconds
+	dest.AgeDividers = source.AgeDividers
+	dest.WrapNavigation = source.WrapNavigation
+	dest.DefaultLevel = source.DefaultLevel
+	dest.ActiveCountWarning = source.ActiveCountWarning
+	dest.ConfirmDismissAll = source.ConfirmDismissAll
+	dest.WeekStart = source.WeekStart
+	dest.AutoRefreshSeconds = source.AutoRefreshSeconds
+	dest.AutoSaveSeconds = source.AutoSaveSeconds
+	dest.FlashNewNotifications = source.FlashNewNotifications
+	dest.NotifyMinLevel = source.NotifyMinLevel
+	dest.LevelRouting = source.LevelRouting
+	dest.ConfirmSaveOnQuit = source.ConfirmSaveOnQuit
+	dest.GroupUnassigned = source.GroupUnassigned
+	dest.MaxTreeDepth = source.MaxTreeDepth
+	dest.ShowTabLabel = source.ShowTabLabel
+	dest.ConfirmCrossSessionJump = source.ConfirmCrossSessionJump
+	dest.SearchAliases = source.SearchAliases
+	dest.ShowHeader = source.ShowHeader
+	dest.ShowFooter = source.ShowFooter
+	dest.SourceGutter = source.SourceGutter
+	dest.SearchEnter = source.SearchEnter
+}
+
 // applyNonEmptyFilters copies non-empty filter values from source to dest.
 func applyNonEmptyFilters(src settings.Filter, dest *settings.Filter) {
 	if src.Level != "" {
@@ -108,17 +142,19 @@ func applyNonEmptyFilters(src settings.Filter, dest *settings.Filter) {
 	}
 }
 
-func (s *settingsService) save(state settings.TUIState) error {
+// hasChanges reports whether saving state would change the settings file.
+func (s *settingsService) hasChanges(state settings.TUIState) bool {
 	nextSettings := state.ToSettings()
-	if s.loadedSettings != nil {
-		nextSettings.GroupHeader = s.loadedSettings.GroupHeader.Clone()
-	} else {
-		defaults := settings.DefaultGroupHeaderOptions()
-		nextSettings.GroupHeader = defaults
-	}
-	if s.loadedSettings != nil && reflect.DeepEqual(*s.loadedSettings, *nextSettings) {
+	applyFileOnlySettings(nextSettings, s.loadedSettings)
+	return s.loadedSettings == nil || !reflect.DeepEqual(*s.loadedSettings, *nextSettings)
+}
+
+func (s *settingsService) save(state settings.TUIState) error {
+	if !s.hasChanges(state) {
 		return nil
 	}
+	nextSettings := state.ToSettings()
+	applyFileOnlySettings(nextSettings, s.loadedSettings)
 
 	if err := settings.Save(nextSettings); err != nil {
 		return fmt.Errorf("failed to save settings: %w", err)
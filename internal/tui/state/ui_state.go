@@ -17,12 +17,21 @@ type PendingAction struct {
 	Pane     string
 	Count    int
 	NodeKind model.NodeKind
+	IDs      []int
 }
 
 // ActionType represents the type of action requiring confirmation.
 type ActionType string
 
-const ActionDismissGroup ActionType = "dismiss_group"
+const (
+	ActionDismissGroup    ActionType = "dismiss_group"
+	ActionDismissFiltered ActionType = "dismiss_filtered"
+	ActionDismissAll      ActionType = "dismiss_all"
+	ActionDismissOlder    ActionType = "dismiss_older"
+	ActionSaveOnQuit      ActionType = "save_on_quit"
+	ActionJump            ActionType = "jump"
+)
+
 const defaultExpandLevel = 1
 
 // UIState manages all UI-specific state for the TUI.
@@ -41,6 +50,10 @@ type UIState struct {
 	searchMode  bool
 	searchQuery string
 
+	// Command line state (":" commands)
+	commandMode  bool
+	commandQuery string
+
 	// Error state
 	errorMessage string
 
@@ -64,6 +77,31 @@ type UIState struct {
 
 	// Show help setting
 	showHelp bool
+
+	// chromeHidden hides the tabs, header, and footer (focus mode).
+	chromeHidden bool
+
+	// headerHidden hides the tabs and table header; footerHidden hides the
+	// footer. Unlike chromeHidden they are user settings, not focus mode.
+	headerHidden bool
+	footerHidden bool
+
+	// focusGroup is the identifier of the group whose subtree is the only
+	// one shown in grouped view. Empty shows every group.
+	focusGroup string
+
+	// cursorLineOffset counts non-selectable lines (such as dividers)
+	// rendered above the cursor row.
+	cursorLineOffset int
+
+	// wrapNavigation moves the cursor from the last row to the first and back.
+	wrapNavigation bool
+
+	// bannerVisible reserves a header line for the active count warning.
+	bannerVisible bool
+
+	// tabLabelVisible reserves a header line naming the active tab.
+	tabLabelVisible bool
 }
 
 // NewUIState creates a new UIState instance with default values.
@@ -128,9 +166,137 @@ func (u *UIState) SetHeight(height int) {
 	}
 }
 
+// GetCursorLineOffset returns the number of non-selectable lines rendered above the cursor row.
+func (u *UIState) GetCursorLineOffset() int {
+	return u.cursorLineOffset
+}
+
+// SetCursorLineOffset sets the number of non-selectable lines rendered above the cursor row.
+func (u *UIState) SetCursorLineOffset(offset int) {
+	u.cursorLineOffset = offset
+}
+
+// IsChromeHidden returns whether the tabs, header, and footer are hidden.
+func (u *UIState) IsChromeHidden() bool {
+	return u.chromeHidden
+}
+
+// SetChromeHidden shows or hides the tabs, header, and footer and resizes the viewport.
+func (u *UIState) SetChromeHidden(hidden bool) {
+	u.chromeHidden = hidden
+	u.UpdateViewportSize()
+}
+
+// GetFocusGroup returns the identifier of the focused group, or "" when no
+// group is focused.
+func (u *UIState) GetFocusGroup() string {
+	return u.focusGroup
+}
+
+// SetFocusGroup limits grouped view to the subtree of the group with the
+// given identifier.
+func (u *UIState) SetFocusGroup(identifier string) {
+	u.focusGroup = identifier
+}
+
+// ClearFocusGroup shows every group again.
+func (u *UIState) ClearFocusGroup() {
+	u.focusGroup = ""
+}
+
+// IsHeaderHidden returns whether the tabs and table header are hidden.
+func (u *UIState) IsHeaderHidden() bool {
+	return u.headerHidden
+}
+
+// SetHeaderHidden shows or hides the tabs and table header and resizes the
+// viewport to match, keeping its content.
+func (u *UIState) SetHeaderHidden(hidden bool) {
+	if u.headerHidden == hidden {
+		return
+	}
+	u.headerHidden = hidden
+	if u.height > 0 {
+		u.viewport.Height = u.height - u.chromeLines()
+	}
+}
+
+// IsFooterHidden returns whether the footer is hidden.
+func (u *UIState) IsFooterHidden() bool {
+	return u.footerHidden
+}
+
+// SetFooterHidden shows or hides the footer and resizes the viewport to
+// match, keeping its content.
+func (u *UIState) SetFooterHidden(hidden bool) {
+	if u.footerHidden == hidden {
+		return
+	}
+	u.footerHidden = hidden
+	if u.height > 0 {
+		u.viewport.Height = u.height - u.chromeLines()
+	}
+}
+
+// chromeLines returns the number of lines reserved for the tabs, header, and
+// footer. The banner and tab label belong to the header and hide with it.
+func (u *UIState) chromeLines() int {
+	if u.chromeHidden {
+		return 0
+	}
+	lines := 0
+	if !u.headerHidden {
+		lines += headerLines
+		if u.bannerVisible {
+			lines++
+		}
+		if u.tabLabelVisible {
+			lines++
+		}
+	}
+	if !u.footerHidden {
+		lines += footerLines
+	}
+	return lines
+}
+
+// IsBannerVisible returns whether the header banner line is shown.
+func (u *UIState) IsBannerVisible() bool {
+	return u.bannerVisible
+}
+
+// SetBannerVisible shows or hides the header banner line and shrinks or grows
+// the viewport to match, keeping its content.
+func (u *UIState) SetBannerVisible(visible bool) {
+	if u.bannerVisible == visible {
+		return
+	}
+	u.bannerVisible = visible
+	if u.height > 0 {
+		u.viewport.Height = u.height - u.chromeLines()
+	}
+}
+
+// IsTabLabelVisible returns whether the active tab label line is shown.
+func (u *UIState) IsTabLabelVisible() bool {
+	return u.tabLabelVisible
+}
+
+// SetTabLabelVisible shows or hides the active tab label line and resizes
+// the viewport to match, keeping its content.
+func (u *UIState) SetTabLabelVisible(visible bool) {
+	if u.tabLabelVisible == visible {
+		return
+	}
+	u.tabLabelVisible = visible
+	if u.height > 0 {
+		u.viewport.Height = u.height - u.chromeLines()
+	}
+}
+
 // UpdateViewportSize updates the viewport dimensions based on the current width and height.
 func (u *UIState) UpdateViewportSize() {
-	viewportHeight := u.height - headerFooterLines
+	viewportHeight := u.height - u.chromeLines()
 	u.viewport = viewport.New(u.width, viewportHeight)
 }
 
@@ -182,6 +348,36 @@ func (u *UIState) BackspaceSearchQuery() {
 	}
 }
 
+// IsCommandMode returns whether the ":" command line is active.
+func (u *UIState) IsCommandMode() bool {
+	return u.commandMode
+}
+
+// SetCommandMode activates or deactivates the command line.
+func (u *UIState) SetCommandMode(active bool) {
+	u.commandMode = active
+	if !active {
+		u.commandQuery = ""
+	}
+}
+
+// GetCommandQuery returns the text typed after ":".
+func (u *UIState) GetCommandQuery() string {
+	return u.commandQuery
+}
+
+// AppendToCommandQuery appends a rune to the command line.
+func (u *UIState) AppendToCommandQuery(r rune) {
+	u.commandQuery += string(r)
+}
+
+// BackspaceCommandQuery removes the last character from the command line.
+func (u *UIState) BackspaceCommandQuery() {
+	if len(u.commandQuery) > 0 {
+		u.commandQuery = u.commandQuery[:len(u.commandQuery)-1]
+	}
+}
+
 // GetPendingKey returns the current pending key.
 func (u *UIState) GetPendingKey() string {
 	return u.pendingKey
@@ -242,19 +438,39 @@ func (u *UIState) HasError() bool {
 }
 
 // MoveCursorUp moves the cursor up one position if possible.
+// With wrap navigation enabled, moving up from the first row selects the last.
 func (u *UIState) MoveCursorUp(listLen int) {
 	if u.cursor > 0 {
 		u.cursor--
+		return
+	}
+	if u.wrapNavigation && listLen > 0 {
+		u.cursor = listLen - 1
 	}
 }
 
 // MoveCursorDown moves the cursor down one position if possible.
+// With wrap navigation enabled, moving down from the last row selects the first.
 func (u *UIState) MoveCursorDown(listLen int) {
 	if u.cursor < listLen-1 {
 		u.cursor++
+		return
+	}
+	if u.wrapNavigation && listLen > 0 {
+		u.cursor = 0
 	}
 }
 
+// IsWrapNavigation returns whether cursor movement wraps around the list ends.
+func (u *UIState) IsWrapNavigation() bool {
+	return u.wrapNavigation
+}
+
+// SetWrapNavigation enables or disables wrap-around cursor movement.
+func (u *UIState) SetWrapNavigation(enabled bool) {
+	u.wrapNavigation = enabled
+}
+
 // EnsureCursorVisible adjusts the viewport to ensure the cursor is visible.
 func (u *UIState) EnsureCursorVisible(listLen int) {
 	if listLen == 0 {
@@ -267,14 +483,17 @@ func (u *UIState) EnsureCursorVisible(listLen int) {
 	// Calculate the viewport height
 	viewportHeight := u.viewport.Height
 
+	// Rendered line of the cursor row, including dividers above it
+	cursorLine := u.cursor + u.cursorLineOffset
+
 	// If cursor is above viewport, scroll up
-	if u.cursor < lineOffset {
-		u.viewport.ScrollUp(lineOffset - u.cursor)
+	if cursorLine < lineOffset {
+		u.viewport.ScrollUp(lineOffset - cursorLine)
 	}
 
 	// If cursor is below viewport, scroll down
-	if u.cursor >= lineOffset+viewportHeight {
-		u.viewport.ScrollDown(u.cursor - (lineOffset + viewportHeight) + 1)
+	if cursorLine >= lineOffset+viewportHeight {
+		u.viewport.ScrollDown(cursorLine - (lineOffset + viewportHeight) + 1)
 	}
 }
 
@@ -309,7 +528,7 @@ func (u *UIState) SetViewMode(mode model.ViewMode) {
 func (u *UIState) CycleViewMode() {
 	oldMode := u.viewMode
 
-	// Cycle through modes: detailed -> grouped -> search -> detailed
+	// Cycle through modes: detailed -> grouped -> search -> summary -> detailed
 	// Note: ViewModeCompact is deprecated and not part of the active cycle
 	// It is only kept for backward compatibility (migration logic)
 	switch u.viewMode {
@@ -318,6 +537,8 @@ func (u *UIState) CycleViewMode() {
 	case model.ViewModeGrouped:
 		u.viewMode = model.ViewModeSearch
 	case model.ViewModeSearch:
+		u.viewMode = model.ViewModeSummary
+	case model.ViewModeSummary:
 		u.viewMode = model.ViewModeDetailed
 	default:
 		u.viewMode = model.ViewModeDetailed
@@ -415,7 +636,7 @@ func (u *UIState) SetViewportDimensions(width, height int) {
 		u.height = defaultViewportHeight
 	}
 	// Update viewport size
-	viewportHeight := u.height - headerFooterLines
+	viewportHeight := u.height - u.chromeLines()
 	u.viewport = viewport.New(u.width, viewportHeight)
 }
 
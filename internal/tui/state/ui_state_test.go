@@ -105,3 +105,24 @@ func TestSetConfirmationModeResetsState(t *testing.T) {
 	assert.False(t, uiState.IsConfirmationMode())
 	assert.Equal(t, PendingAction{}, uiState.GetPendingAction())
 }
+
+func TestUIStateWrapNavigation(t *testing.T) {
+	uiState := NewUIState()
+	assert.False(t, uiState.IsWrapNavigation())
+
+	uiState.SetCursor(2)
+	uiState.MoveCursorDown(3)
+	assert.Equal(t, 2, uiState.GetCursor(), "cursor clamps at the bottom without wrap")
+	uiState.SetCursor(0)
+	uiState.MoveCursorUp(3)
+	assert.Equal(t, 0, uiState.GetCursor(), "cursor clamps at the top without wrap")
+
+	uiState.SetWrapNavigation(true)
+	uiState.MoveCursorUp(3)
+	assert.Equal(t, 2, uiState.GetCursor())
+	uiState.MoveCursorDown(3)
+	assert.Equal(t, 0, uiState.GetCursor())
+
+	uiState.MoveCursorDown(0)
+	assert.Equal(t, 0, uiState.GetCursor(), "empty lists never wrap")
+}
@@ -715,3 +715,24 @@ echo \"shebang-test\" > \"$HOOK_OUTPUT_DIR/shebang.log\""
     [[ "$aliases" == *"NOTIFICATION_LEVEL=warning"* ]]
     [[ "$aliases" == *"LEVEL=warning"* ]]
 }
+
+@test "example pre-add delivery hook delivers with CHANNELS unset and skips with it empty" {
+    local bin_dir
+    bin_dir="$(mktemp -d)"
+    cat >"$bin_dir/notify-send" <<FAKE
+#!/bin/bash
+echo "\$*" >>"$HOOK_OUTPUT_DIR/notify-send.log"
+FAKE
+    chmod +x "$bin_dir/notify-send"
+
+    run env -u CHANNELS PATH="$bin_dir:$PATH" MESSAGE="unset" "$PWD/examples/hooks/pre-add/04-linux-notification.sh"
+    [ "$status" -eq 0 ]
+    run env CHANNELS="" PATH="$bin_dir:$PATH" MESSAGE="empty" "$PWD/examples/hooks/pre-add/04-linux-notification.sh"
+    [ "$status" -eq 0 ]
+
+    local log
+    log=$(_read_hook_output "notify-send.log")
+    [[ "$log" == *"unset"* ]]
+    [[ "$log" != *"empty"* ]]
+    rm -rf "$bin_dir"
+}